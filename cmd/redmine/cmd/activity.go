@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kqns91/redmine-go/cmd/redmine/internal/formatter"
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+var activityCmd = &cobra.Command{
+	Use:   "activity",
+	Short: "Show recent activity",
+	Long: `Redmine の活動 (チケット、リビジョン、ニュース、文書、Wiki 編集、作業時間など) を新しい順に表示します。
+Atom フィードから取得するため、非公開プロジェクトの活動を見るには --feed-key (環境変数 REDMINE_FEED_KEY) に
+個人設定の「Atomアクセスキー」を指定してください。フィードには最新の活動のみ (既定で 15 件) が含まれます。`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectID, _ := cmd.Flags().GetString("project-id")
+		userID, _ := cmd.Flags().GetInt("user-id")
+		types, _ := cmd.Flags().GetStringSlice("type")
+		format, _ := cmd.Flags().GetString("format")
+
+		from, err := dateFlag(cmd, "from")
+		if err != nil {
+			return err
+		}
+		to, err := dateFlag(cmd, "to")
+		if err != nil {
+			return err
+		}
+
+		opts := &redmine.ListActivityOptions{
+			ProjectID: projectID,
+			UserID:    userID,
+			Types:     types,
+		}
+		// 日付はローカル時刻の 1 日として扱う
+		if !from.IsZero() {
+			opts.From = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.Local)
+		}
+		if !to.IsZero() {
+			opts.To = time.Date(to.Year(), to.Month(), to.Day()+1, 0, 0, 0, -1, time.Local)
+		}
+
+		events, err := client.ListActivity(context.Background(), opts)
+		if err != nil {
+			return fmt.Errorf("活動の取得に失敗しました: %w", err)
+		}
+
+		// Format output based on --format flag
+		switch format {
+		case formatJSON:
+			return formatter.OutputJSON(events)
+		case formatTable:
+			return formatActivityTable(events)
+		case formatText:
+			return formatActivityText(events)
+		default:
+			return fmt.Errorf("不明な出力フォーマット: %s", format)
+		}
+	},
+}
+
+// formatActivityTable formats activity events in table format.
+func formatActivityTable(events []redmine.ActivityEvent) error {
+	if len(events) == 0 {
+		fmt.Println("活動が見つかりませんでした。")
+		return nil
+	}
+
+	headers := []string{"Updated", "Type", "Project", "Author", "Title"}
+	rows := make([][]string, 0, len(events))
+	for _, e := range events {
+		rows = append(rows, []string{
+			e.Updated.Local().Format("2006-01-02 15:04"),
+			e.Type,
+			e.Project,
+			e.Author,
+			formatter.TruncateString(e.Title, 60),
+		})
+	}
+
+	formatter.RenderTable(headers, rows)
+	return nil
+}
+
+// formatActivityText formats activity events in simple text format.
+func formatActivityText(events []redmine.ActivityEvent) error {
+	if len(events) == 0 {
+		fmt.Println("活動が見つかりませんでした。")
+		return nil
+	}
+
+	for _, e := range events {
+		fmt.Println(formatter.FormatKeyValue("Updated", e.Updated.Local().Format("2006-01-02 15:04")))
+		fmt.Println(formatter.FormatKeyValue("Type", e.Type))
+		if e.Project != "" {
+			fmt.Println(formatter.FormatKeyValue("Project", e.Project))
+		}
+		fmt.Println(formatter.FormatKeyValue("Title", e.Title))
+		if e.Author != "" {
+			fmt.Println(formatter.FormatKeyValue("Author", e.Author))
+		}
+		fmt.Println(formatter.FormatKeyValue("URL", e.URL))
+		fmt.Println()
+	}
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(activityCmd)
+
+	// Flags
+	activityCmd.Flags().String("project-id", "", "プロジェクトID または識別子 (既定: 全プロジェクト)")
+	activityCmd.Flags().Int("user-id", 0, "ユーザーID")
+	activityCmd.Flags().StringSlice("type", nil, "活動の種類 (issues, changesets, news, documents, files, wiki_edits, messages, time_entries)")
+	activityCmd.Flags().String("from", "", "この日以降の活動 (YYYY-MM-DD)")
+	activityCmd.Flags().String("to", "", "この日までの活動 (YYYY-MM-DD)")
+	activityCmd.Flags().StringP("format", "f", formatTable, "出力フォーマット (json, table, text)")
+}
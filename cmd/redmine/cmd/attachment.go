@@ -3,6 +3,9 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -97,6 +100,103 @@ var attachmentDeleteCmd = &cobra.Command{
 	},
 }
 
+var attachmentUploadCmd = &cobra.Command{
+	Use:   "upload [file_path]",
+	Short: "Upload a file and get an upload token",
+	Long: `ファイルをアップロードし、チケットやWikiページに添付するためのトークンを取得します。
+出力されたJSONは issue create/update の --uploads フラグにそのまま指定できます。`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		description, _ := cmd.Flags().GetString("description")
+		contentType, _ := cmd.Flags().GetString("content-type")
+
+		upload, err := uploadLocalFile(args[0], &redmine.UploadOptions{
+			ContentType: contentType,
+			Description: description,
+		})
+		if err != nil {
+			return err
+		}
+
+		return formatter.OutputJSON([]redmine.Upload{*upload})
+	},
+}
+
+var attachmentDownloadCmd = &cobra.Command{
+	Use:   "download [id]",
+	Short: "Download an attachment",
+	Long: `添付ファイルの内容をダウンロードします。
+--output を省略した場合は元のファイル名で保存し、"-" を指定すると標準出力に書き出します。`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("無効な添付ファイルID: %w", err)
+		}
+
+		output, _ := cmd.Flags().GetString("output")
+		thumbnail, _ := cmd.Flags().GetBool("thumbnail")
+		size, _ := cmd.Flags().GetInt("size")
+
+		ctx := context.Background()
+		if output == "" {
+			attachment, err := client.ShowAttachment(ctx, id)
+			if err != nil {
+				return fmt.Errorf("添付ファイルの取得に失敗しました: %w", err)
+			}
+			output = filepath.Base(attachment.Attachment.Filename)
+		}
+
+		var w io.Writer = os.Stdout
+		if output != "-" {
+			//nolint:gosec // The file path is explicitly provided by the user
+			f, err := os.Create(output)
+			if err != nil {
+				return fmt.Errorf("ファイルを作成できませんでした: %w", err)
+			}
+			//nolint:errcheck
+			defer f.Close()
+			w = f
+		}
+
+		if thumbnail {
+			_, err = client.DownloadThumbnail(ctx, id, size, w)
+		} else {
+			_, err = client.DownloadAttachment(ctx, id, w)
+		}
+		if err != nil {
+			return fmt.Errorf("添付ファイルのダウンロードに失敗しました: %w", err)
+		}
+
+		if output != "-" {
+			fmt.Fprintf(os.Stderr, "%s に保存しました\n", output)
+		}
+		return nil
+	},
+}
+
+// uploadLocalFile streams a local file to Redmine and returns its upload token.
+func uploadLocalFile(path string, opts *redmine.UploadOptions) (*redmine.Upload, error) {
+	//nolint:gosec // The file path is explicitly provided by the user
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("ファイルを開けませんでした: %w", err)
+	}
+	//nolint:errcheck
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("ファイル情報の取得に失敗しました: %w", err)
+	}
+
+	upload, err := client.UploadWithOptions(context.Background(), filepath.Base(path), f, info.Size(), opts)
+	if err != nil {
+		return nil, fmt.Errorf("ファイルのアップロードに失敗しました: %w", err)
+	}
+	return upload, nil
+}
+
 // formatAttachmentDetail formats a single attachment in detailed text format.
 func formatAttachmentDetail(a *redmine.Attachment) error {
 	// Title
@@ -115,7 +215,7 @@ func formatAttachmentDetail(a *redmine.Attachment) error {
 	if a.ContentURL != "" {
 		fmt.Println(formatter.FormatKeyValue("Content URL", a.ContentURL))
 	}
-	fmt.Println(formatter.FormatKeyValue("Created", a.CreatedOn))
+	fmt.Println(formatter.FormatKeyValue("Created", a.CreatedOn.String()))
 
 	// Author Info
 	if a.Author.ID != 0 {
@@ -134,6 +234,8 @@ func init() {
 	attachmentCmd.AddCommand(attachmentShowCmd)
 	attachmentCmd.AddCommand(attachmentUpdateCmd)
 	attachmentCmd.AddCommand(attachmentDeleteCmd)
+	attachmentCmd.AddCommand(attachmentUploadCmd)
+	attachmentCmd.AddCommand(attachmentDownloadCmd)
 
 	// Flags for show command
 	attachmentShowCmd.Flags().StringP("format", "f", formatText, "出力フォーマット (json, text)")
@@ -141,4 +243,13 @@ func init() {
 	// Flags for update command
 	attachmentUpdateCmd.Flags().String("filename", "", "ファイル名")
 	attachmentUpdateCmd.Flags().String("description", "", "説明")
+
+	// Flags for upload command
+	attachmentUploadCmd.Flags().String("description", "", "説明")
+	attachmentUploadCmd.Flags().String("content-type", "", "Content-Type (省略時は拡張子から推定)")
+
+	// Flags for download command
+	attachmentDownloadCmd.Flags().StringP("output", "o", "", "保存先のパス (\"-\" で標準出力)")
+	attachmentDownloadCmd.Flags().Bool("thumbnail", false, "サムネイルをダウンロード")
+	attachmentDownloadCmd.Flags().Int("size", 0, "サムネイルのサイズ (ピクセル)")
 }
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// cacheResources はキャッシュ対象のリソースです
+var cacheResources = []string{
+	redmine.CacheTrackers,
+	redmine.CacheIssueStatuses,
+	redmine.CacheIssuePriorities,
+	redmine.CacheTimeEntryActivities,
+	redmine.CacheDocumentCategories,
+	redmine.CacheRoles,
+	redmine.CacheCustomFields,
+	redmine.CacheVersions,
+	redmine.CacheIssueCategories,
+}
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the metadata cache",
+	Long: `--cache (環境変数 REDMINE_CACHE) で有効にするメタデータのキャッシュを管理します。
+トラッカー、ステータス、優先度、作業分類、ロール、カスタムフィールド、バージョン、カテゴリの一覧を
+--cache-ttl (既定 10m) の間再利用し、期限切れ後は ETag で更新を確認します。`,
+}
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear [resource...]",
+	Short: "Clear the metadata cache",
+	Long: `キャッシュを削除します。リソースを指定するとそのリソースのみ削除します。
+リソース: trackers, issue_statuses, issue_priorities, time_entry_activities, document_categories,
+roles, custom_fields, versions, issue_categories`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, resource := range args {
+			if !slices.Contains(cacheResources, resource) {
+				return fmt.Errorf("不明なリソース: %s", resource)
+			}
+		}
+
+		dir, err := cacheDir()
+		if err != nil {
+			return err
+		}
+		redmine.NewCache(&redmine.CacheOptions{Dir: dir}).Invalidate(args...)
+
+		fmt.Printf("キャッシュを削除しました: %s\n", dir)
+		return nil
+	},
+}
+
+// cacheDir はキャッシュを保存するディレクトリを返します
+func cacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("キャッシュディレクトリの取得に失敗しました: %w", err)
+	}
+	return filepath.Join(dir, "redmine"), nil
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+	cacheCmd.AddCommand(cacheClearCmd)
+}
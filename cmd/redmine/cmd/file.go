@@ -3,6 +3,8 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"slices"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -44,6 +46,74 @@ var fileListCmd = &cobra.Command{
 	},
 }
 
+var fileUploadCmd = &cobra.Command{
+	Use:   "upload [project_id_or_identifier] [file_path]",
+	Short: "Upload a file to a project",
+	Long:  `ローカルのファイルをアップロードし、プロジェクトのファイルとして登録します。`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		versionID, _ := cmd.Flags().GetInt("version-id")
+		description, _ := cmd.Flags().GetString("description")
+
+		upload, err := uploadLocalFile(args[1], nil)
+		if err != nil {
+			return err
+		}
+
+		err = client.UploadFile(context.Background(), args[0], redmine.FileUpload{
+			Token:       upload.Token,
+			VersionID:   versionID,
+			Filename:    upload.Filename,
+			Description: description,
+		})
+		if err != nil {
+			return fmt.Errorf("ファイルの登録に失敗しました: %w", err)
+		}
+
+		fmt.Println("ファイルをアップロードしました")
+		return nil
+	},
+}
+
+var fileDownloadCmd = &cobra.Command{
+	Use:   "download [project_id_or_identifier] [file_id]",
+	Short: "Download a project file and verify its digest",
+	Long: `プロジェクトのファイルをダウンロードし、ダイジェスト (MD5/SHA256) を検証します。
+保存先に途中までダウンロードされたファイルがある場合は続きから再開します。`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("無効なファイルID: %w", err)
+		}
+
+		output, _ := cmd.Flags().GetString("output")
+
+		ctx := context.Background()
+		result, err := client.ListFiles(ctx, args[0])
+		if err != nil {
+			return fmt.Errorf("ファイルの取得に失敗しました: %w", err)
+		}
+
+		idx := slices.IndexFunc(result.Files, func(f redmine.File) bool { return f.ID == id })
+		if idx < 0 {
+			return fmt.Errorf("ファイルが見つかりませんでした: %d", id)
+		}
+		file := result.Files[idx]
+
+		if output == "" {
+			output = filepath.Base(file.Filename)
+		}
+
+		if err := client.DownloadFileToPath(ctx, file, output); err != nil {
+			return fmt.Errorf("ファイルのダウンロードに失敗しました: %w", err)
+		}
+
+		fmt.Printf("%s に保存しました\n", output)
+		return nil
+	},
+}
+
 // formatFilesTable formats files in table format.
 func formatFilesTable(files []redmine.File) error {
 	if len(files) == 0 {
@@ -60,7 +130,7 @@ func formatFilesTable(files []redmine.File) error {
 			formatter.TruncateString(f.Filename, 40),
 			strconv.Itoa(f.Filesize),
 			strconv.Itoa(f.Downloads),
-			f.CreatedOn,
+			f.CreatedOn.String(),
 		})
 	}
 
@@ -84,7 +154,7 @@ func formatFilesText(files []redmine.File) error {
 			fmt.Println(formatter.FormatKeyValue("Description", formatter.TruncateString(f.Description, 80)))
 		}
 		fmt.Println(formatter.FormatKeyValue("Downloads", strconv.Itoa(f.Downloads)))
-		fmt.Println(formatter.FormatKeyValue("Created", f.CreatedOn))
+		fmt.Println(formatter.FormatKeyValue("Created", f.CreatedOn.String()))
 		if f.Author.Name != "" {
 			fmt.Println(formatter.FormatKeyValue("Author", f.Author.Name))
 		}
@@ -99,7 +169,16 @@ func init() {
 
 	// Subcommands
 	fileCmd.AddCommand(fileListCmd)
+	fileCmd.AddCommand(fileUploadCmd)
+	fileCmd.AddCommand(fileDownloadCmd)
 
 	// Flags for list command
 	fileListCmd.Flags().StringP("format", "f", formatTable, "出力フォーマット (json, table, text)")
+
+	// Flags for upload command
+	fileUploadCmd.Flags().Int("version-id", 0, "バージョンID")
+	fileUploadCmd.Flags().String("description", "", "説明")
+
+	// Flags for download command
+	fileDownloadCmd.Flags().StringP("output", "o", "", "保存先のパス (省略時は元のファイル名)")
 }
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+	"github.com/kqns91/redmine-go/pkg/webhook"
+)
+
+var hooksCmd = &cobra.Command{
+	Use:   "hooks",
+	Short: "Relay Redmine changes to webhooks",
+	Long:  `Redmine の変更をポーリングで検出し、Webhook として送信します。`,
+}
+
+var hooksServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Poll Redmine and post matching changes to webhooks",
+	Long: `設定ファイルに従って Redmine の変更を定期的に取得し、ルールに一致した変更を Webhook に POST します。
+送信に失敗した Webhook はリトライし、最終的に失敗したものはデッドレターログに追記します。
+Ctrl+C または SIGTERM で終了します。`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configPath, _ := cmd.Flags().GetString("config")
+
+		cfg, err := webhook.LoadConfig(configPath)
+		if err != nil {
+			return fmt.Errorf("Webhook 設定の読み込みに失敗しました: %w", err)
+		}
+
+		logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+		opts := &webhook.Options{
+			Rules:       cfg.Rules,
+			MaxAttempts: cfg.MaxAttempts,
+			Backoff:     time.Duration(cfg.Backoff),
+			RedmineURL:  apiURL,
+			Resolver:    redmine.NewChangelogResolver(client),
+			Logger:      logger,
+		}
+		if cfg.DeadLetter != "" {
+			f, err := os.OpenFile(cfg.DeadLetter, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+			if err != nil {
+				return fmt.Errorf("デッドレターログを開けませんでした: %w", err)
+			}
+			//nolint:errcheck
+			defer f.Close()
+			opts.DeadLetter = f
+		}
+		relay, err := webhook.New(opts)
+		if err != nil {
+			return fmt.Errorf("Webhook 設定が不正です: %w", err)
+		}
+
+		// チェックポイントの既定値は設定ファイルと同じディレクトリに置く
+		checkpoint := cfg.Checkpoint
+		if checkpoint == "" {
+			checkpoint = strings.TrimSuffix(configPath, filepath.Ext(configPath)) + ".checkpoint.json"
+		}
+		feed := redmine.NewChangeFeed(client, &redmine.ChangeFeedOptions{
+			Interval: time.Duration(cfg.Interval),
+			Store:    redmine.NewFileCheckpointStore(checkpoint),
+			OnError: func(err error) {
+				logger.Error("poll failed", slog.String("error", err.Error()))
+			},
+		}, cfg.Sources()...)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		logger.Info("relaying Redmine changes", slog.Int("rules", len(cfg.Rules)), slog.String("checkpoint", checkpoint))
+		if err := relay.RunFeed(ctx, feed); err != nil && !errors.Is(err, context.Canceled) {
+			return err
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(hooksCmd)
+	hooksCmd.AddCommand(hooksServeCmd)
+
+	// Flags for serve command
+	hooksServeCmd.Flags().StringP("config", "c", "redmine-hooks.json", "Webhook 設定ファイル (JSON)")
+}
@@ -25,14 +25,8 @@ var issueListCmd = &cobra.Command{
 	Short: "List issues",
 	Long:  `チケットをリスト表示します。`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		projectID, _ := cmd.Flags().GetInt("project-id")
 		subprojectID, _ := cmd.Flags().GetString("subproject-id")
-		trackerID, _ := cmd.Flags().GetInt("tracker-id")
-		statusID, _ := cmd.Flags().GetString("status-id")
-		assignedToID, _ := cmd.Flags().GetString("assigned-to-id")
-		priorityID, _ := cmd.Flags().GetInt("priority-id")
 		categoryID, _ := cmd.Flags().GetInt("category-id")
-		fixedVersionID, _ := cmd.Flags().GetInt("fixed-version-id")
 		issueID, _ := cmd.Flags().GetString("issue-id")
 		parentID, _ := cmd.Flags().GetInt("parent-id")
 		subject, _ := cmd.Flags().GetString("subject")
@@ -44,18 +38,62 @@ var issueListCmd = &cobra.Command{
 		dueDate, _ := cmd.Flags().GetString("due-date")
 		estimatedHours, _ := cmd.Flags().GetString("estimated-hours")
 		doneRatio, _ := cmd.Flags().GetString("done-ratio")
+		filterExprs, _ := cmd.Flags().GetStringArray("filter")
+		queryID, _ := cmd.Flags().GetInt("query-id")
 		include, _ := cmd.Flags().GetString("include")
 		limit, _ := cmd.Flags().GetInt("limit")
 		offset, _ := cmd.Flags().GetInt("offset")
 		sort, _ := cmd.Flags().GetString("sort")
 		format, _ := cmd.Flags().GetString("format")
 
+		filter, err := parseIssueFilters(filterExprs)
+		if err != nil {
+			return err
+		}
+
+		projectID, err := idFlag(cmd, "project-id", resolver.ProjectID)
+		if err != nil {
+			return err
+		}
+		trackerID, err := idFlag(cmd, "tracker-id", resolver.TrackerID)
+		if err != nil {
+			return err
+		}
+		priorityID, err := idFlag(cmd, "priority-id", resolver.PriorityID)
+		if err != nil {
+			return err
+		}
+		fixedVersionID, err := versionFlag(cmd, "fixed-version-id", func(context.Context) (int, error) {
+			return projectID, nil
+		})
+		if err != nil {
+			return err
+		}
+		statusID, err := filterIDFlag(cmd, "status-id", resolver.StatusID)
+		if err != nil {
+			return err
+		}
+		assignedToID, err := filterIDFlag(cmd, "assigned-to-id", resolver.UserID)
+		if err != nil {
+			return err
+		}
+		authorID, err := filterIDFlag(cmd, "author-id", resolver.UserID)
+		if err != nil {
+			return err
+		}
+		watcherID, err := filterIDFlag(cmd, "watcher-id", resolver.UserID)
+		if err != nil {
+			return err
+		}
+
 		opts := &redmine.ListIssuesOptions{
 			ProjectID:      projectID,
 			SubprojectID:   subprojectID,
 			TrackerID:      trackerID,
 			StatusID:       statusID,
 			AssignedToID:   assignedToID,
+			AuthorID:       authorID,
+			WatcherID:      watcherID,
 			PriorityID:     priorityID,
 			CategoryID:     categoryID,
 			FixedVersionID: fixedVersionID,
@@ -70,6 +108,8 @@ var issueListCmd = &cobra.Command{
 			DueDate:        dueDate,
 			EstimatedHours: estimatedHours,
 			DoneRatio:      doneRatio,
+			Filter:         filter,
+			QueryID:        queryID,
 			Include:        include,
 			Limit:          limit,
 			Offset:         offset,
@@ -135,18 +175,44 @@ var issueCreateCmd = &cobra.Command{
 	Short: "Create a new issue",
 	Long:  `新しいチケットを作成します。`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		projectID, _ := cmd.Flags().GetInt("project-id")
-		trackerID, _ := cmd.Flags().GetInt("tracker-id")
-		statusID, _ := cmd.Flags().GetInt("status-id")
-		priorityID, _ := cmd.Flags().GetInt("priority-id")
+		projectID, err := idFlag(cmd, "project-id", resolver.ProjectID)
+		if err != nil {
+			return err
+		}
+		trackerID, err := idFlag(cmd, "tracker-id", resolver.TrackerID)
+		if err != nil {
+			return err
+		}
+		statusID, err := idFlag(cmd, "status-id", resolver.StatusID)
+		if err != nil {
+			return err
+		}
+		priorityID, err := idFlag(cmd, "priority-id", resolver.PriorityID)
+		if err != nil {
+			return err
+		}
 		categoryID, _ := cmd.Flags().GetInt("category-id")
-		fixedVersionID, _ := cmd.Flags().GetInt("fixed-version-id")
+		fixedVersionID, err := versionFlag(cmd, "fixed-version-id", func(context.Context) (int, error) {
+			return projectID, nil
+		})
+		if err != nil {
+			return err
+		}
 		parentIssueID, _ := cmd.Flags().GetInt("parent-issue-id")
 		subject, _ := cmd.Flags().GetString("subject")
 		description, _ := cmd.Flags().GetString("description")
-		assignedToID, _ := cmd.Flags().GetInt("assigned-to-id")
-		startDate, _ := cmd.Flags().GetString("start-date")
-		dueDate, _ := cmd.Flags().GetString("due-date")
+		assignedToID, err := idFlag(cmd, "assigned-to-id", resolver.UserID)
+		if err != nil {
+			return err
+		}
+		startDate, err := dateFlag(cmd, "start-date")
+		if err != nil {
+			return err
+		}
+		dueDate, err := dateFlag(cmd, "due-date")
+		if err != nil {
+			return err
+		}
 		doneRatio, _ := cmd.Flags().GetInt("done-ratio")
 		estimatedHours, _ := cmd.Flags().GetFloat64("estimated-hours")
 		isPrivate, _ := cmd.Flags().GetBool("is-private")
@@ -235,14 +301,39 @@ var issueUpdateCmd = &cobra.Command{
 
 		subject, _ := cmd.Flags().GetString("subject")
 		description, _ := cmd.Flags().GetString("description")
-		statusID, _ := cmd.Flags().GetInt("status-id")
-		priorityID, _ := cmd.Flags().GetInt("priority-id")
+		statusID, err := idFlag(cmd, "status-id", resolver.StatusID)
+		if err != nil {
+			return err
+		}
+		priorityID, err := idFlag(cmd, "priority-id", resolver.PriorityID)
+		if err != nil {
+			return err
+		}
 		categoryID, _ := cmd.Flags().GetInt("category-id")
-		fixedVersionID, _ := cmd.Flags().GetInt("fixed-version-id")
+		fixedVersionID, err := versionFlag(cmd, "fixed-version-id", func(ctx context.Context) (int, error) {
+			// バージョン名はチケットのプロジェクトで解決する
+			issue, err := client.ShowIssue(ctx, id, nil)
+			if err != nil {
+				return 0, err
+			}
+			return issue.Issue.Project.ID, nil
+		})
+		if err != nil {
+			return err
+		}
 		parentIssueID, _ := cmd.Flags().GetInt("parent-issue-id")
-		assignedToID, _ := cmd.Flags().GetInt("assigned-to-id")
-		startDate, _ := cmd.Flags().GetString("start-date")
-		dueDate, _ := cmd.Flags().GetString("due-date")
+		assignedToID, err := idFlag(cmd, "assigned-to-id", resolver.UserID)
+		if err != nil {
+			return err
+		}
+		startDate, err := dateFlag(cmd, "start-date")
+		if err != nil {
+			return err
+		}
+		dueDate, err := dateFlag(cmd, "due-date")
+		if err != nil {
+			return err
+		}
 		doneRatio, _ := cmd.Flags().GetInt("done-ratio")
 		estimatedHours, _ := cmd.Flags().GetFloat64("estimated-hours")
 		isPrivate, _ := cmd.Flags().GetBool("is-private")
@@ -285,7 +376,24 @@ var issueUpdateCmd = &cobra.Command{
 		}
 		req.CustomFields = customFields
 
-		err = client.UpdateIssue(context.Background(), id, req)
+		req.ForceSendFields, req.ClearFields, err = patchFields(cmd, issueUpdateFields)
+		if err != nil {
+			return err
+		}
+
+		updatedOnStr, _ := cmd.Flags().GetString("if-updated-on")
+		if updatedOnStr != "" {
+			updatedOn, parseErr := redmine.ParseTimestamp(updatedOnStr)
+			if parseErr != nil {
+				return fmt.Errorf("--if-updated-on の日時が不正です: %w", parseErr)
+			}
+			err = client.UpdateIssueIfUnmodified(context.Background(), id, updatedOn, req)
+		} else {
+			err = client.UpdateIssue(context.Background(), id, req)
+		}
+		if errors.Is(err, redmine.ErrConflict) {
+			return fmt.Errorf("チケットは他のユーザーによって更新されています。再取得してからやり直してください: %w", err)
+		}
 		if err != nil {
 			return fmt.Errorf("チケットの更新に失敗しました: %w", err)
 		}
@@ -295,6 +403,21 @@ var issueUpdateCmd = &cobra.Command{
 	},
 }
 
+// issueUpdateFields は issue update で明示的に送信・削除できるフラグと JSON キーの対応です
+var issueUpdateFields = map[string]string{
+	"description":      "description",
+	"category-id":      "category_id",
+	"fixed-version-id": "fixed_version_id",
+	"parent-issue-id":  "parent_issue_id",
+	"assigned-to-id":   "assigned_to_id",
+	"start-date":       "start_date",
+	"due-date":         "due_date",
+	"done-ratio":       "done_ratio",
+	"estimated-hours":  "estimated_hours",
+	"is-private":       "is_private",
+	"private-notes":    "private_notes",
+}
+
 var issueDeleteCmd = &cobra.Command{
 	Use:   "delete [issue_id]",
 	Short: "Delete an issue",
@@ -368,6 +491,41 @@ var issueRemoveWatcherCmd = &cobra.Command{
 	},
 }
 
+var issueLogCmd = &cobra.Command{
+	Use:   "log [issue_id]",
+	Short: "Show the change history of an issue",
+	Long:  `チケットの変更履歴を時系列で表示します。ID は名前に変換されます。`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("無効なissue_id: %w", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+
+		result, err := client.ShowIssue(context.Background(), id, &redmine.ShowIssueOptions{Include: "journals"})
+		if err != nil {
+			return fmt.Errorf("チケットの取得に失敗しました: %w", err)
+		}
+
+		entries, err := redmine.NewChangelogResolver(client).Changelog(context.Background(), result.Issue.Journals)
+		if err != nil {
+			return fmt.Errorf("変更内容の取得に失敗しました: %w", err)
+		}
+
+		// Format output based on --format flag
+		switch format {
+		case formatJSON:
+			return formatter.OutputJSON(entries)
+		case formatText:
+			return formatChangelog(entries)
+		default:
+			return fmt.Errorf("不明な出力フォーマット: %s (利用可能: json, text)", format)
+		}
+	},
+}
+
 // formatIssuesTable formats issues in table format.
 func formatIssuesTable(issues []redmine.Issue) error {
 	if len(issues) == 0 {
@@ -392,7 +550,7 @@ func formatIssuesTable(issues []redmine.Issue) error {
 			issue.Priority.Name,
 			formatter.TruncateString(issue.Subject, 40),
 			formatter.TruncateString(assignedTo, 15),
-			issue.UpdatedOn,
+			issue.UpdatedOn.String(),
 		})
 	}
 
@@ -420,7 +578,7 @@ func formatIssuesText(issues []redmine.Issue) error {
 		if issue.Description != "" {
 			fmt.Println(formatter.FormatKeyValue("Description", formatter.TruncateString(issue.Description, 100)))
 		}
-		fmt.Println(formatter.FormatKeyValue("Updated", issue.UpdatedOn))
+		fmt.Println(formatter.FormatKeyValue("Updated", issue.UpdatedOn.String()))
 		fmt.Println()
 	}
 
@@ -441,6 +599,12 @@ func formatIssueDetail(issue *redmine.Issue) error {
 	fmt.Println(formatter.FormatKeyValue("Status", issue.Status.Name))
 	fmt.Println(formatter.FormatKeyValue("Priority", issue.Priority.Name))
 	fmt.Println(formatter.FormatKeyValue("Subject", issue.Subject))
+	if issue.FixedVersion.Name != "" {
+		fmt.Println(formatter.FormatKeyValue("Target Version", issue.FixedVersion.Name))
+	}
+	if issue.Parent.ID != 0 {
+		fmt.Println(formatter.FormatKeyValue("Parent", "#"+strconv.Itoa(issue.Parent.ID)))
+	}
 
 	if issue.Description != "" {
 		fmt.Println()
@@ -459,11 +623,11 @@ func formatIssueDetail(issue *redmine.Issue) error {
 	if issue.Author.Name != "" {
 		fmt.Println(formatter.FormatKeyValue("Author", issue.Author.Name))
 	}
-	if issue.StartDate != "" {
-		fmt.Println(formatter.FormatKeyValue("Start Date", issue.StartDate))
+	if !issue.StartDate.IsZero() {
+		fmt.Println(formatter.FormatKeyValue("Start Date", issue.StartDate.String()))
 	}
-	if issue.DueDate != "" {
-		fmt.Println(formatter.FormatKeyValue("Due Date", issue.DueDate))
+	if !issue.DueDate.IsZero() {
+		fmt.Println(formatter.FormatKeyValue("Due Date", issue.DueDate.String()))
 	}
 	if issue.DoneRatio > 0 {
 		fmt.Println(formatter.FormatKeyValue("Done Ratio", strconv.Itoa(issue.DoneRatio)+"%"))
@@ -471,14 +635,39 @@ func formatIssueDetail(issue *redmine.Issue) error {
 	if issue.EstimatedHours > 0 {
 		fmt.Println(formatter.FormatKeyValue("Estimated Hours", fmt.Sprintf("%.2f", issue.EstimatedHours)))
 	}
+	if issue.TotalEstimatedHours > issue.EstimatedHours {
+		fmt.Println(formatter.FormatKeyValue("Total Estimated", fmt.Sprintf("%.2f", issue.TotalEstimatedHours)))
+	}
+	if issue.SpentHours > 0 {
+		fmt.Println(formatter.FormatKeyValue("Spent Hours", fmt.Sprintf("%.2f", issue.SpentHours)))
+	}
+	if issue.TotalSpentHours > issue.SpentHours {
+		fmt.Println(formatter.FormatKeyValue("Total Spent", fmt.Sprintf("%.2f", issue.TotalSpentHours)))
+	}
+
+	// Custom fields
+	if len(issue.CustomFields) > 0 {
+		fmt.Println()
+		fmt.Println(formatter.FormatSection("カスタムフィールド"))
+		for _, cf := range issue.CustomFields {
+			fmt.Println(formatter.FormatKeyValue(cf.Name, cf.StringValue()))
+		}
+	}
 
 	// Timestamps
 	fmt.Println()
 	fmt.Println(formatter.FormatSection("作成・更新"))
-	fmt.Println(formatter.FormatKeyValue("Created", issue.CreatedOn))
-	fmt.Println(formatter.FormatKeyValue("Updated", issue.UpdatedOn))
-	if issue.ClosedOn != "" {
-		fmt.Println(formatter.FormatKeyValue("Closed", issue.ClosedOn))
+	fmt.Println(formatter.FormatKeyValue("Created", issue.CreatedOn.String()))
+	fmt.Println(formatter.FormatKeyValue("Updated", issue.UpdatedOn.String()))
+	if !issue.ClosedOn.IsZero() {
+		fmt.Println(formatter.FormatKeyValue("Closed", issue.ClosedOn.String()))
+	}
+
+	// Children (if included)
+	if len(issue.Children) > 0 {
+		fmt.Println()
+		fmt.Println(formatter.FormatSection("子チケット"))
+		formatIssueChildren(issue.Children, 1)
 	}
 
 	// Journals (if included)
@@ -487,6 +676,14 @@ func formatIssueDetail(issue *redmine.Issue) error {
 	return nil
 }
 
+// formatIssueChildren prints subtasks as an indented tree
+func formatIssueChildren(children []redmine.Issue, depth int) {
+	for _, child := range children {
+		fmt.Printf("%s- #%d [%s] %s\n", strings.Repeat("  ", depth), child.ID, child.Tracker.Name, child.Subject)
+		formatIssueChildren(child.Children, depth+1)
+	}
+}
+
 // formatJournals formats journal entries for display
 func formatJournals(journals []redmine.Journal) {
 	if len(journals) == 0 {
@@ -501,7 +698,7 @@ func formatJournals(journals []redmine.Journal) {
 		}
 		fmt.Println(formatter.FormatKeyValue("Journal ID", strconv.Itoa(journal.ID)))
 		fmt.Println(formatter.FormatKeyValue("User", journal.User.Name))
-		fmt.Println(formatter.FormatKeyValue("Created", journal.CreatedOn))
+		fmt.Println(formatter.FormatKeyValue("Created", journal.CreatedOn.String()))
 
 		if journal.Notes != "" {
 			fmt.Println(formatter.FormatKeyValue("Notes", journal.Notes))
@@ -520,6 +717,55 @@ func formatJournals(journals []redmine.Journal) {
 	}
 }
 
+// parseIssueFilters parses --filter values of the form "field operator [value|value...]"
+func parseIssueFilters(exprs []string) (*redmine.Filter, error) {
+	if len(exprs) == 0 {
+		return nil, nil
+	}
+
+	filter := redmine.NewFilter()
+	for _, expr := range exprs {
+		field, rest, _ := strings.Cut(strings.TrimSpace(expr), " ")
+		operator, value, _ := strings.Cut(strings.TrimSpace(rest), " ")
+		if field == "" || operator == "" {
+			return nil, fmt.Errorf("無効なフィルター: %q (例: \"due_date >< 2024-01-01|2024-01-31\")", expr)
+		}
+
+		var values []string
+		if value = strings.TrimSpace(value); value != "" {
+			values = strings.Split(value, "|")
+		}
+		filter.Where(field, operator, values...)
+	}
+	return filter, nil
+}
+
+// versionFlag は対象バージョンのフラグを解釈します。"2025" のような数字だけの
+// バージョン名もあるため、値は project が返すプロジェクトのバージョン名として先に
+// 探し、一致しなければ ID として扱います。プロジェクトが 0 の場合は ID だけを受け付けます。
+func versionFlag(cmd *cobra.Command, name string, project func(context.Context) (int, error)) (int, error) {
+	s, _ := cmd.Flags().GetString(name)
+	if s == "" {
+		return 0, nil
+	}
+	ctx := context.Background()
+	projectID, err := project(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("--%s を解決できません: %w", name, err)
+	}
+	if projectID == 0 {
+		if id, err := strconv.Atoi(s); err == nil {
+			return id, nil
+		}
+		return 0, fmt.Errorf("--%s をバージョン名で指定するには --project-id が必要です", name)
+	}
+	id, err := resolver.VersionID(ctx, strconv.Itoa(projectID), s)
+	if err != nil {
+		return 0, fmt.Errorf("--%s を解決できません: %w", name, err)
+	}
+	return id, nil
+}
+
 // includeOptionsForIssueList returns valid include options for issue list command
 func includeOptionsForIssueList() []string {
 	return []string{"attachments", "relations"}
@@ -571,16 +817,19 @@ func init() {
 	issueCmd.AddCommand(issueDeleteCmd)
 	issueCmd.AddCommand(issueAddWatcherCmd)
 	issueCmd.AddCommand(issueRemoveWatcherCmd)
+	issueCmd.AddCommand(issueLogCmd)
 
 	// Flags for list command
-	issueListCmd.Flags().Int("project-id", 0, "プロジェクトID")
+	issueListCmd.Flags().String("project-id", "", "プロジェクトID、識別子または名前")
 	issueListCmd.Flags().String("subproject-id", "", "サブプロジェクトID")
-	issueListCmd.Flags().Int("tracker-id", 0, "トラッカーID")
-	issueListCmd.Flags().String("status-id", "", "ステータスID")
-	issueListCmd.Flags().String("assigned-to-id", "", "担当者ID")
-	issueListCmd.Flags().Int("priority-id", 0, "優先度ID")
+	issueListCmd.Flags().String("tracker-id", "", "トラッカーIDまたは名前")
+	issueListCmd.Flags().String("status-id", "", "ステータスIDまたは名前 (open, closed, * も指定可)")
+	issueListCmd.Flags().String("assigned-to-id", "", "担当者ID、ログイン名または名前 (me で自分)")
+	issueListCmd.Flags().String("author-id", "", "作成者ID、ログイン名または名前 (me で自分)")
+	issueListCmd.Flags().String("watcher-id", "", "ウォッチャーのユーザーID、ログイン名または名前 (me で自分)")
+	issueListCmd.Flags().String("priority-id", "", "優先度IDまたは名前")
 	issueListCmd.Flags().Int("category-id", 0, "カテゴリID")
-	issueListCmd.Flags().Int("fixed-version-id", 0, "対象バージョンID")
+	issueListCmd.Flags().String("fixed-version-id", "", "対象バージョンIDまたは名前 (名前は --project-id が必要)")
 	issueListCmd.Flags().String("issue-id", "", "特定のissue IDでフィルター")
 	issueListCmd.Flags().Int("parent-id", 0, "親issueでフィルター")
 	issueListCmd.Flags().String("subject", "", "件名でフィルター（部分一致）")
@@ -592,6 +841,8 @@ func init() {
 	issueListCmd.Flags().String("due-date", "", "期日でフィルター (例: >=2024-01-01)")
 	issueListCmd.Flags().String("estimated-hours", "", "予定工数でフィルター (例: >=8)")
 	issueListCmd.Flags().String("done-ratio", "", "進捗率でフィルター (例: >=50)")
+	issueListCmd.Flags().StringArray("filter", nil, "汎用フィルター \"項目 演算子 値|値\" (複数指定可, 例: \"cf_5 ~ foo\", \"updated_on >t- 7\", \"assigned_to_id !*\")")
+	issueListCmd.Flags().Int("query-id", 0, "保存済みクエリID (クエリの条件で絞り込み)")
 	issueListCmd.Flags().String("include", "", "追加で取得する情報 (attachments, relations)")
 	issueListCmd.Flags().Int("limit", 0, "取得する最大件数")
 	issueListCmd.Flags().Int("offset", 0, "取得開始位置のオフセット")
@@ -613,16 +864,16 @@ func init() {
 	})
 
 	// Flags for create command
-	issueCreateCmd.Flags().Int("project-id", 0, "プロジェクトID (必須)")
-	issueCreateCmd.Flags().Int("tracker-id", 0, "トラッカーID (必須)")
-	issueCreateCmd.Flags().Int("status-id", 0, "ステータスID")
-	issueCreateCmd.Flags().Int("priority-id", 0, "優先度ID")
+	issueCreateCmd.Flags().String("project-id", "", "プロジェクトID、識別子または名前 (必須)")
+	issueCreateCmd.Flags().String("tracker-id", "", "トラッカーIDまたは名前 (必須)")
+	issueCreateCmd.Flags().String("status-id", "", "ステータスIDまたは名前")
+	issueCreateCmd.Flags().String("priority-id", "", "優先度IDまたは名前")
 	issueCreateCmd.Flags().Int("category-id", 0, "カテゴリID")
-	issueCreateCmd.Flags().Int("fixed-version-id", 0, "バージョン/マイルストーンID")
+	issueCreateCmd.Flags().String("fixed-version-id", "", "バージョン/マイルストーンIDまたは名前 (名前は --project-id が必要)")
 	issueCreateCmd.Flags().Int("parent-issue-id", 0, "親issue ID")
 	issueCreateCmd.Flags().String("subject", "", "件名 (必須)")
 	issueCreateCmd.Flags().String("description", "", "説明")
-	issueCreateCmd.Flags().Int("assigned-to-id", 0, "担当者ID")
+	issueCreateCmd.Flags().String("assigned-to-id", "", "担当者ID、ログイン名または名前 (me で自分)")
 	issueCreateCmd.Flags().String("start-date", "", "開始日 (YYYY-MM-DD)")
 	issueCreateCmd.Flags().String("due-date", "", "期日 (YYYY-MM-DD)")
 	issueCreateCmd.Flags().Int("done-ratio", 0, "進捗率 (0-100)")
@@ -635,12 +886,12 @@ func init() {
 	// Flags for update command
 	issueUpdateCmd.Flags().String("subject", "", "件名")
 	issueUpdateCmd.Flags().String("description", "", "説明")
-	issueUpdateCmd.Flags().Int("status-id", 0, "ステータスID")
-	issueUpdateCmd.Flags().Int("priority-id", 0, "優先度ID")
+	issueUpdateCmd.Flags().String("status-id", "", "ステータスIDまたは名前")
+	issueUpdateCmd.Flags().String("priority-id", "", "優先度IDまたは名前")
 	issueUpdateCmd.Flags().Int("category-id", 0, "カテゴリID")
-	issueUpdateCmd.Flags().Int("fixed-version-id", 0, "バージョン/マイルストーンID")
+	issueUpdateCmd.Flags().String("fixed-version-id", "", "バージョン/マイルストーンIDまたは名前")
 	issueUpdateCmd.Flags().Int("parent-issue-id", 0, "親issue ID")
-	issueUpdateCmd.Flags().Int("assigned-to-id", 0, "担当者ID")
+	issueUpdateCmd.Flags().String("assigned-to-id", "", "担当者ID、ログイン名または名前 (me で自分)")
 	issueUpdateCmd.Flags().String("start-date", "", "開始日 (YYYY-MM-DD)")
 	issueUpdateCmd.Flags().String("due-date", "", "期日 (YYYY-MM-DD)")
 	issueUpdateCmd.Flags().Int("done-ratio", 0, "進捗率 (0-100)")
@@ -650,4 +901,9 @@ func init() {
 	issueUpdateCmd.Flags().Bool("private-notes", false, "コメントをプライベートにする")
 	issueUpdateCmd.Flags().String("uploads", "", "アップロードファイル情報 (JSON形式, 例: '[{\"token\":\"xxx\",\"filename\":\"file.pdf\"}]')")
 	issueUpdateCmd.Flags().String("custom-fields", "", "カスタムフィールド (JSON形式, 例: '[{\"id\":1,\"value\":\"foo\"}]')")
+	issueUpdateCmd.Flags().StringSlice("clear", nil, "値を削除する項目 (フラグ名のカンマ区切り, 例: assigned-to-id,due-date)")
+	issueUpdateCmd.Flags().String("if-updated-on", "", "取得時の更新日時 (RFC 3339形式)。以降に更新されていた場合は更新を中止します")
+
+	// Flags for log command
+	issueLogCmd.Flags().StringP("format", "f", formatText, "出力フォーマット (json, text)")
 }
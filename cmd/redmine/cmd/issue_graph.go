@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kqns91/redmine-go/cmd/redmine/internal/formatter"
+	"github.com/kqns91/redmine-go/pkg/issuegraph"
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+var issueCriticalPathCmd = &cobra.Command{
+	Use:   "critical-path",
+	Short: "Compute the critical path of a project",
+	Long: `プロジェクトのチケットの依存関係 (先行・後続、ブロック、親子) からクリティカルパスを計算します。
+予定工数と開始日・期日から各チケットの最早・最遅開始日と余裕日数を求めます。`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectID, _ := cmd.Flags().GetInt("project-id")
+		fixedVersionID, _ := cmd.Flags().GetInt("fixed-version-id")
+		statusID, _ := cmd.Flags().GetString("status-id")
+		hoursPerDay, _ := cmd.Flags().GetFloat64("hours-per-day")
+		remaining, _ := cmd.Flags().GetBool("remaining")
+		format, _ := cmd.Flags().GetString("format")
+
+		if projectID == 0 {
+			return errors.New("--project-id は必須です")
+		}
+		start, err := dateFlag(cmd, "start")
+		if err != nil {
+			return err
+		}
+
+		issues, err := client.ListAllIssues(context.Background(), &redmine.ListIssuesOptions{
+			ProjectID:      projectID,
+			FixedVersionID: fixedVersionID,
+			StatusID:       statusID,
+			Include:        "relations",
+		})
+		if err != nil {
+			return fmt.Errorf("チケットの取得に失敗しました: %w", err)
+		}
+
+		schedule, err := issuegraph.New(issues).Schedule(&issuegraph.ScheduleOptions{
+			Start:       start,
+			HoursPerDay: hoursPerDay,
+			Remaining:   remaining,
+		})
+		if err != nil {
+			return fmt.Errorf("依存関係が循環しています: %w", err)
+		}
+
+		// Format output based on --format flag
+		switch format {
+		case formatJSON:
+			return formatter.OutputJSON(schedule)
+		case formatTable:
+			return formatScheduleTable(schedule)
+		case formatText:
+			return formatScheduleText(schedule)
+		default:
+			return fmt.Errorf("不明な出力フォーマット: %s", format)
+		}
+	},
+}
+
+// formatScheduleTable formats the tasks of a schedule in table format.
+func formatScheduleTable(s *issuegraph.Schedule) error {
+	if len(s.Tasks) == 0 {
+		fmt.Println("チケットが見つかりませんでした。")
+		return nil
+	}
+
+	headers := []string{"ID", "Subject", "Days", "Start", "Finish", "Latest Start", "Slack", "Critical"}
+	rows := make([][]string, 0, len(s.Tasks))
+	for _, t := range s.Tasks {
+		critical := ""
+		if t.Critical && !t.Parent {
+			critical = "*"
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(t.IssueID),
+			formatter.TruncateString(t.Subject, 40),
+			strconv.Itoa(t.Duration),
+			t.EarliestStart.String(),
+			t.EarliestFinish.String(),
+			t.LatestStart.String(),
+			strconv.Itoa(t.Slack),
+			critical,
+		})
+	}
+
+	formatter.RenderTable(headers, rows)
+	fmt.Println()
+	fmt.Printf("期間: %s - %s (%d日)\n", s.Start, s.Finish, s.Duration)
+	return nil
+}
+
+// formatScheduleText formats the critical path of a schedule in text format.
+func formatScheduleText(s *issuegraph.Schedule) error {
+	fmt.Println(formatter.FormatTitle("クリティカルパス"))
+	fmt.Println()
+
+	fmt.Println(formatter.FormatSection("期間"))
+	fmt.Println(formatter.FormatKeyValue("Start", s.Start.String()))
+	fmt.Println(formatter.FormatKeyValue("Finish", s.Finish.String()))
+	fmt.Println(formatter.FormatKeyValue("Days", strconv.Itoa(s.Duration)))
+
+	if len(s.CriticalPath) > 0 {
+		ids := make([]string, 0, len(s.CriticalPath))
+		for _, id := range s.CriticalPath {
+			ids = append(ids, "#"+strconv.Itoa(id))
+		}
+		fmt.Println()
+		fmt.Println(formatter.FormatSection("クリティカルパス"))
+		fmt.Println("  " + strings.Join(ids, " → "))
+		for _, id := range s.CriticalPath {
+			t, _ := s.Task(id)
+			fmt.Printf("  - #%d %s (%s - %s, %d日)\n", t.IssueID, t.Subject, t.EarliestStart, t.EarliestFinish, t.Duration)
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	issueCmd.AddCommand(issueCriticalPathCmd)
+
+	// Flags for critical-path command
+	issueCriticalPathCmd.Flags().Int("project-id", 0, "プロジェクトID (必須)")
+	issueCriticalPathCmd.Flags().Int("fixed-version-id", 0, "対象バージョンID")
+	issueCriticalPathCmd.Flags().String("status-id", "", "ステータスID (既定: 未完了のみ, * で全て)")
+	issueCriticalPathCmd.Flags().String("start", "", "計画の開始日 (YYYY-MM-DD, 既定: 最も早い開始日)")
+	issueCriticalPathCmd.Flags().Float64("hours-per-day", 8, "1日あたりの作業時間")
+	issueCriticalPathCmd.Flags().Bool("remaining", false, "進捗率を考慮して残作業のみを計画する (既定の開始日は今日)")
+	issueCriticalPathCmd.Flags().StringP("format", "f", formatTable, "出力フォーマット (json, table, text)")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -40,7 +41,11 @@ var journalGetCmd = &cobra.Command{
 		case formatJSON:
 			return formatter.OutputJSON(result)
 		case formatText:
-			return formatJournalDetail(&result.Journal)
+			changes, err := redmine.NewChangelogResolver(client).DescribeJournal(context.Background(), result.Journal)
+			if err != nil {
+				return fmt.Errorf("変更内容の取得に失敗しました: %w", err)
+			}
+			return formatJournalDetail(&result.Journal, changes)
 		default:
 			return fmt.Errorf("不明な出力フォーマット: %s (利用可能: json, text)", format)
 		}
@@ -48,7 +53,8 @@ var journalGetCmd = &cobra.Command{
 }
 
 // formatJournalDetail formats a single journal in detailed text format.
-func formatJournalDetail(j *redmine.Journal) error {
+// changes are the details of the journal rendered by a ChangelogResolver.
+func formatJournalDetail(j *redmine.Journal, changes []string) error {
 	// Title
 	fmt.Println(formatter.FormatTitle("Journal #" + strconv.Itoa(j.ID)))
 	fmt.Println()
@@ -62,15 +68,38 @@ func formatJournalDetail(j *redmine.Journal) error {
 	if j.Notes != "" {
 		fmt.Println(formatter.FormatKeyValue("Notes", j.Notes))
 	}
-	fmt.Println(formatter.FormatKeyValue("Created", j.CreatedOn))
+	fmt.Println(formatter.FormatKeyValue("Created", j.CreatedOn.String()))
 
 	// Details
-	if len(j.Details) > 0 {
+	if len(changes) > 0 {
 		fmt.Println()
 		fmt.Println(formatter.FormatSection("変更内容"))
-		for _, detail := range j.Details {
-			if detail.Property != "" {
-				fmt.Printf("  %s.%s: %s -> %s\n", detail.Property, detail.Name, detail.OldValue, detail.NewValue)
+		for _, change := range changes {
+			fmt.Printf("  - %s\n", change)
+		}
+	}
+
+	return nil
+}
+
+// formatChangelog formats journals rendered by a ChangelogResolver as a timeline.
+func formatChangelog(entries []redmine.ChangelogEntry) error {
+	if len(entries) == 0 {
+		fmt.Println("履歴がありません")
+		return nil
+	}
+
+	for i, entry := range entries {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s  %s  (Journal #%d)\n", entry.CreatedOn, entry.User.Name, entry.ID)
+		for _, change := range entry.Changes {
+			fmt.Printf("  - %s\n", change)
+		}
+		if entry.Notes != "" {
+			for line := range strings.SplitSeq(entry.Notes, "\n") {
+				fmt.Printf("  > %s\n", strings.TrimRight(line, "\r"))
 			}
 		}
 	}
@@ -82,11 +82,11 @@ func formatMyAccountDetail(u *redmine.User) error {
 	fmt.Println(formatter.FormatKeyValue("Mail", u.Mail))
 	fmt.Println(formatter.FormatKeyValue("Admin", strconv.FormatBool(u.Admin)))
 
-	if u.CreatedOn != "" {
-		fmt.Println(formatter.FormatKeyValue("Created", u.CreatedOn))
+	if !u.CreatedOn.IsZero() {
+		fmt.Println(formatter.FormatKeyValue("Created", u.CreatedOn.String()))
 	}
-	if u.LastLoginOn != "" {
-		fmt.Println(formatter.FormatKeyValue("Last Login", u.LastLoginOn))
+	if !u.LastLoginOn.IsZero() {
+		fmt.Println(formatter.FormatKeyValue("Last Login", u.LastLoginOn.String()))
 	}
 
 	return nil
@@ -77,7 +77,7 @@ func formatNewsTable(news []redmine.News) error {
 			formatter.TruncateString(n.Title, 35),
 			formatter.TruncateString(n.Project.Name, 20),
 			formatter.TruncateString(n.Author.Name, 15),
-			n.CreatedOn,
+			n.CreatedOn.String(),
 		})
 	}
 
@@ -103,7 +103,7 @@ func formatNewsText(news []redmine.News) error {
 		if n.Description != "" {
 			fmt.Println(formatter.FormatKeyValue("Description", formatter.TruncateString(n.Description, 80)))
 		}
-		fmt.Println(formatter.FormatKeyValue("Created", n.CreatedOn))
+		fmt.Println(formatter.FormatKeyValue("Created", n.CreatedOn.String()))
 		fmt.Println()
 	}
 
@@ -238,7 +238,13 @@ var projectUpdateCmd = &cobra.Command{
 			req.CustomFieldValues = customFieldValues
 		}
 
-		err := client.UpdateProject(context.Background(), args[0], req)
+		var err error
+		req.ForceSendFields, req.ClearFields, err = patchFields(cmd, projectUpdateFields)
+		if err != nil {
+			return err
+		}
+
+		err = client.UpdateProject(context.Background(), args[0], req)
 		if err != nil {
 			return fmt.Errorf("プロジェクトの更新に失敗しました: %w", err)
 		}
@@ -248,6 +254,17 @@ var projectUpdateCmd = &cobra.Command{
 	},
 }
 
+// projectUpdateFields は project update で明示的に送信・削除できるフラグと JSON キーの対応です
+var projectUpdateFields = map[string]string{
+	"description":            "description",
+	"homepage":               "homepage",
+	"public":                 "is_public",
+	"inherit-members":        "inherit_members",
+	"parent-id":              "parent_id",
+	"default-assigned-to-id": "default_assigned_to_id",
+	"default-version-id":     "default_version_id",
+}
+
 var projectDeleteCmd = &cobra.Command{
 	Use:   "delete [project_id_or_identifier]",
 	Short: "Delete a project",
@@ -330,8 +347,8 @@ func formatProjectDetail(p *redmine.Project) error {
 		fmt.Println(formatter.FormatKeyValue("Homepage", p.Homepage))
 	}
 	fmt.Println(formatter.FormatKeyValue("Public", strconv.FormatBool(p.IsPublic)))
-	fmt.Println(formatter.FormatKeyValue("Created", p.CreatedOn))
-	fmt.Println(formatter.FormatKeyValue("Updated", p.UpdatedOn))
+	fmt.Println(formatter.FormatKeyValue("Created", p.CreatedOn.String()))
+	fmt.Println(formatter.FormatKeyValue("Updated", p.UpdatedOn.String()))
 
 	// Parent Project
 	if p.Parent.ID != 0 {
@@ -340,9 +357,29 @@ func formatProjectDetail(p *redmine.Project) error {
 		fmt.Println(formatter.FormatKeyValue("Name", p.Parent.Name))
 	}
 
+	// Included data
+	formatProjectResources("トラッカー", p.Trackers)
+	formatProjectResources("カテゴリ", p.IssueCategories)
+	formatProjectResources("有効なモジュール", p.EnabledModules)
+	formatProjectResources("作業分類", p.TimeEntryActivities)
+	formatProjectResources("カスタムフィールド", p.IssueCustomFields)
+
 	return nil
 }
 
+// formatProjectResources prints a section listing resources returned by include=
+func formatProjectResources(title string, resources []redmine.Resource) {
+	if len(resources) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(formatter.FormatSection(title))
+	for _, r := range resources {
+		fmt.Println(formatter.FormatKeyValue(strconv.Itoa(r.ID), r.Name))
+	}
+}
+
 // formatProjectsTable formats projects in table format.
 func formatProjectsTable(projects []redmine.Project) error {
 	if len(projects) == 0 {
@@ -359,7 +396,7 @@ func formatProjectsTable(projects []redmine.Project) error {
 			p.Identifier,
 			formatter.TruncateString(p.Name, 40),
 			getProjectStatus(p.Status),
-			p.CreatedOn,
+			p.CreatedOn.String(),
 		})
 	}
 
@@ -498,4 +535,5 @@ func init() {
 	projectUpdateCmd.Flags().String("enabled-module-names", "", "有効化モジュール (カンマ区切り, 例: issues,wiki,calendar)")
 	projectUpdateCmd.Flags().String("issue-custom-field-ids", "", "カスタムフィールドIDリスト (カンマ区切り, 例: 1,2,3)")
 	projectUpdateCmd.Flags().String("custom-field-values", "", "カスタムフィールド値 (JSON形式, 例: '{\"1\":\"value1\",\"2\":\"value2\"}')")
+	projectUpdateCmd.Flags().StringSlice("clear", nil, "値を削除する項目 (フラグ名のカンマ区切り, 例: parent-id,homepage)")
 }
@@ -1,9 +1,16 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"maps"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -17,10 +24,29 @@ const (
 	formatText  = "text"
 )
 
+// 終了コード
+const (
+	exitError         = 1
+	exitUnauthorized  = 3
+	exitNotFound      = 4
+	exitUnprocessable = 5
+)
+
 var (
-	apiURL string
-	apiKey string
-	client *redmine.Client
+	apiURL    string
+	apiKey    string
+	username  string
+	password  string
+	anonymous bool
+	asUser    string
+	feedKey   string
+	timeout   time.Duration
+	debug     bool
+	useCache  bool
+	cacheTTL  time.Duration
+	cache     *redmine.Cache
+	client    *redmine.Client
+	resolver  *redmine.Resolver
 )
 
 // rootCmd はCLIのルートコマンドを表します
@@ -30,11 +56,11 @@ var rootCmd = &cobra.Command{
 	Long: `redmine は Redmine の REST API を操作するための CLI ツールです。
 すべての Redmine API 操作を CLI から実行できます。`,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-		// Skip config initialization for config commands
-		if cmd.Parent() != nil && cmd.Parent().Name() == "config" {
+		// Skip config initialization for config and cache commands
+		if cmd.Parent() != nil && (cmd.Parent().Name() == "config" || cmd.Parent().Name() == "cache") {
 			return nil
 		}
-		if cmd.Name() == "config" {
+		if cmd.Name() == "config" || cmd.Name() == "cache" {
 			return nil
 		}
 
@@ -45,9 +71,25 @@ var rootCmd = &cobra.Command{
 		if apiKey == "" {
 			apiKey = os.Getenv("REDMINE_API_KEY")
 		}
+		if username == "" {
+			username = os.Getenv("REDMINE_USERNAME")
+		}
+		if password == "" {
+			password = os.Getenv("REDMINE_PASSWORD")
+		} else if cmd.Flags().Changed("password") {
+			fmt.Fprintln(os.Stderr, "警告: --password はプロセス一覧から見えるため非推奨です。REDMINE_PASSWORD 環境変数を使用してください")
+		}
+		if asUser == "" {
+			asUser = os.Getenv("REDMINE_SWITCH_USER")
+		}
+		if feedKey == "" {
+			feedKey = os.Getenv("REDMINE_FEED_KEY")
+		}
+		// Basic 認証・匿名アクセスでは API キーは不要
+		keyRequired := username == "" && !anonymous
 
 		// 設定ファイルから読み込み（フラグと環境変数が未設定の場合）
-		if apiURL == "" || apiKey == "" {
+		if apiURL == "" || (keyRequired && apiKey == "") {
 			cfg, err := cliconfig.Load()
 			if err == nil {
 				if apiURL == "" {
@@ -62,21 +104,182 @@ var rootCmd = &cobra.Command{
 		if apiURL == "" {
 			return errors.New("REDMINE_API_URL が設定されていません。以下のいずれかの方法で設定してください:\n  1. 'redmine config init' で設定ファイルを作成\n  2. --url フラグを指定\n  3. REDMINE_API_URL 環境変数を設定")
 		}
-		if apiKey == "" {
+		if keyRequired && apiKey == "" {
 			return errors.New("REDMINE_API_KEY が設定されていません。以下のいずれかの方法で設定してください:\n  1. 'redmine config init' で設定ファイルを作成\n  2. --key フラグを指定\n  3. REDMINE_API_KEY 環境変数を設定")
 		}
 
+		opts, err := clientOptions(cmd)
+		if err != nil {
+			return err
+		}
+
 		// Redmine クライアントを初期化
-		client = redmine.New(apiURL, apiKey)
+		client = redmine.New(apiURL, apiKey, opts...)
+		resolver = redmine.NewResolver(client)
 		return nil
 	},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+		if cache == nil || !debug {
+			return
+		}
+		stats := cache.Stats()
+		slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})).Debug("redmine cache",
+			slog.Int64("hits", stats.Hits),
+			slog.Int64("revalidations", stats.Revalidations),
+			slog.Int64("misses", stats.Misses),
+			slog.Int64("invalidations", stats.Invalidations))
+	},
+}
+
+// clientOptions はフラグと環境変数から Redmine クライアントのオプションを組み立てます
+func clientOptions(cmd *cobra.Command) ([]redmine.Option, error) {
+	opts := []redmine.Option{redmine.WithUserAgent("redmine-cli")}
+
+	switch {
+	case anonymous:
+		opts = append(opts, redmine.WithAuthenticator(redmine.AnonymousAuth()))
+	case username != "":
+		opts = append(opts, redmine.WithAuthenticator(redmine.BasicAuth(username, password)))
+	}
+	if asUser != "" {
+		opts = append(opts, redmine.WithSwitchUser(asUser))
+	}
+	if feedKey != "" {
+		opts = append(opts, redmine.WithFeedKey(feedKey))
+	}
+
+	if !cmd.Flags().Changed("timeout") {
+		if v := os.Getenv("REDMINE_TIMEOUT"); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf("無効な REDMINE_TIMEOUT: %w", err)
+			}
+			timeout = d
+		}
+	}
+	if timeout > 0 {
+		opts = append(opts, redmine.WithTimeout(timeout))
+	}
+
+	if !useCache {
+		useCache, _ = strconv.ParseBool(os.Getenv("REDMINE_CACHE"))
+	}
+	if useCache {
+		if !cmd.Flags().Changed("cache-ttl") {
+			if v := os.Getenv("REDMINE_CACHE_TTL"); v != "" {
+				d, err := time.ParseDuration(v)
+				if err != nil {
+					return nil, fmt.Errorf("無効な REDMINE_CACHE_TTL: %w", err)
+				}
+				cacheTTL = d
+			}
+		}
+		dir, err := cacheDir()
+		if err != nil {
+			return nil, err
+		}
+		cache = redmine.NewCache(&redmine.CacheOptions{TTL: cacheTTL, Dir: dir})
+		opts = append(opts, redmine.WithCache(cache))
+	}
+
+	if !debug {
+		debug, _ = strconv.ParseBool(os.Getenv("REDMINE_DEBUG"))
+	}
+	if debug {
+		logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		opts = append(opts, redmine.WithLogger(logger))
+	}
+
+	return opts, nil
+}
+
+// patchFields は更新コマンドで明示的に送信・削除する項目の JSON キーを返します。
+// fields はフラグ名と JSON キーの対応です。明示的に指定されたフラグは 0 や false でも送信し、
+// --clear に指定されたフラグの項目は空にします。
+func patchFields(cmd *cobra.Command, fields map[string]string) ([]string, []string, error) {
+	var force, clear []string
+	for _, flag := range slices.Sorted(maps.Keys(fields)) {
+		if cmd.Flags().Changed(flag) {
+			force = append(force, fields[flag])
+		}
+	}
+
+	names, _ := cmd.Flags().GetStringSlice("clear")
+	for _, flag := range names {
+		key, ok := fields[flag]
+		if !ok {
+			return nil, nil, fmt.Errorf("--clear に指定できない項目です: %s", flag)
+		}
+		clear = append(clear, key)
+	}
+
+	return force, clear, nil
+}
+
+// dateFlag は YYYY-MM-DD 形式の日付フラグを解釈します。未指定の場合はゼロ値を返します。
+func dateFlag(cmd *cobra.Command, name string) (redmine.Date, error) {
+	s, _ := cmd.Flags().GetString(name)
+	d, err := redmine.ParseDate(s)
+	if err != nil {
+		return redmine.Date{}, fmt.Errorf("--%s の日付が不正です: %w", name, err)
+	}
+	return d, nil
+}
+
+// idFlag は ID または名前を指定できるフラグを解釈し、名前を resolve で ID に解決します。
+// 未指定の場合は 0 を返します。
+func idFlag(cmd *cobra.Command, name string, resolve func(context.Context, string) (int, error)) (int, error) {
+	s, _ := cmd.Flags().GetString(name)
+	if s == "" {
+		return 0, nil
+	}
+	if id, err := strconv.Atoi(s); err == nil {
+		return id, nil
+	}
+	id, err := resolve(context.Background(), s)
+	if err != nil {
+		return 0, fmt.Errorf("--%s を解決できません: %w", name, err)
+	}
+	return id, nil
+}
+
+// filterIDFlag はチケットの絞り込みに使う ID フラグを解釈します。me, *, open などの
+// 特別な値と ID はそのまま返し、名前は resolve で ID に解決します。
+func filterIDFlag(cmd *cobra.Command, name string, resolve func(context.Context, string) (int, error)) (string, error) {
+	s, _ := cmd.Flags().GetString(name)
+	switch s {
+	case "", "me", "*", "!*", "open", "closed":
+		return s, nil
+	}
+	if _, err := strconv.Atoi(s); err == nil || strings.Contains(s, "|") {
+		return s, nil
+	}
+	id, err := idFlag(cmd, name, resolve)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(id), nil
 }
 
 // Execute はルートコマンドを実行します
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
+	}
+}
+
+// exitCode は Redmine API のエラー種別に応じた終了コードを返します
+func exitCode(err error) int {
+	switch {
+	case errors.Is(err, redmine.ErrUnauthorized), errors.Is(err, redmine.ErrForbidden):
+		return exitUnauthorized
+	case errors.Is(err, redmine.ErrNotFound):
+		return exitNotFound
+	case errors.Is(err, redmine.ErrUnprocessable):
+		return exitUnprocessable
+	default:
+		return exitError
 	}
 }
 
@@ -84,4 +287,13 @@ func init() {
 	// グローバルフラグの定義
 	rootCmd.PersistentFlags().StringVar(&apiURL, "url", "", "Redmine API URL (優先順位: フラグ > 環境変数 > 設定ファイル)")
 	rootCmd.PersistentFlags().StringVar(&apiKey, "key", "", "Redmine API Key (優先順位: フラグ > 環境変数 > 設定ファイル)")
+	rootCmd.PersistentFlags().StringVar(&username, "username", "", "Basic 認証のログイン名 (環境変数 REDMINE_USERNAME)")
+	rootCmd.PersistentFlags().StringVar(&password, "password", "", "Basic 認証のパスワード (非推奨: プロセス一覧から見えるため環境変数 REDMINE_PASSWORD を使用してください)")
+	rootCmd.PersistentFlags().BoolVar(&anonymous, "anonymous", false, "認証情報なしでアクセス")
+	rootCmd.PersistentFlags().StringVar(&asUser, "as-user", "", "指定したログイン名のユーザーとして操作 (管理者のみ, 環境変数 REDMINE_SWITCH_USER)")
+	rootCmd.PersistentFlags().StringVar(&feedKey, "feed-key", "", "Atom フィードのアクセスキー (activity コマンド用, 環境変数 REDMINE_FEED_KEY)")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0, "リクエストのタイムアウト (例: 30s, 環境変数 REDMINE_TIMEOUT)")
+	rootCmd.PersistentFlags().BoolVar(&useCache, "cache", false, "トラッカーやステータスなどのメタデータをキャッシュ (環境変数 REDMINE_CACHE)")
+	rootCmd.PersistentFlags().DurationVar(&cacheTTL, "cache-ttl", 10*time.Minute, "キャッシュの有効期間 (環境変数 REDMINE_CACHE_TTL)")
+	rootCmd.PersistentFlags().BoolVar(&debug, "debug", false, "リクエストのデバッグログを標準エラー出力に表示 (環境変数 REDMINE_DEBUG)")
 }
@@ -75,7 +75,7 @@ func formatSearchResultsTable(results []redmine.SearchResult) error {
 			strconv.Itoa(r.ID),
 			r.Type,
 			formatter.TruncateString(r.Title, 50),
-			r.Datetime,
+			r.Datetime.String(),
 		})
 	}
 
@@ -100,7 +100,7 @@ func formatSearchResultsText(results []redmine.SearchResult) error {
 		if r.URL != "" {
 			fmt.Println(formatter.FormatKeyValue("URL", r.URL))
 		}
-		fmt.Println(formatter.FormatKeyValue("Datetime", r.Datetime))
+		fmt.Println(formatter.FormatKeyValue("Datetime", r.Datetime.String()))
 		fmt.Println()
 	}
 
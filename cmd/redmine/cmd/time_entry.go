@@ -102,7 +102,10 @@ var timeEntryCreateCmd = &cobra.Command{
 		hours, _ := cmd.Flags().GetFloat64("hours")
 		activityID, _ := cmd.Flags().GetInt("activity-id")
 		comments, _ := cmd.Flags().GetString("comments")
-		spentOn, _ := cmd.Flags().GetString("spent-on")
+		spentOn, err := dateFlag(cmd, "spent-on")
+		if err != nil {
+			return err
+		}
 		customFieldsJSON, _ := cmd.Flags().GetString("custom-fields")
 
 		if hours <= 0 {
@@ -164,7 +167,10 @@ var timeEntryUpdateCmd = &cobra.Command{
 		hours, _ := cmd.Flags().GetFloat64("hours")
 		activityID, _ := cmd.Flags().GetInt("activity-id")
 		comments, _ := cmd.Flags().GetString("comments")
-		spentOn, _ := cmd.Flags().GetString("spent-on")
+		spentOn, err := dateFlag(cmd, "spent-on")
+		if err != nil {
+			return err
+		}
 		customFieldsJSON, _ := cmd.Flags().GetString("custom-fields")
 
 		customFields, err := parseCustomFieldsForTimeEntry(customFieldsJSON)
@@ -185,6 +191,11 @@ var timeEntryUpdateCmd = &cobra.Command{
 			req.ActivityID = activityID
 		}
 
+		req.ForceSendFields, req.ClearFields, err = patchFields(cmd, timeEntryUpdateFields)
+		if err != nil {
+			return err
+		}
+
 		err = client.UpdateTimeEntry(context.Background(), id, req)
 		if err != nil {
 			return fmt.Errorf("作業時間の更新に失敗しました: %w", err)
@@ -195,6 +206,11 @@ var timeEntryUpdateCmd = &cobra.Command{
 	},
 }
 
+// timeEntryUpdateFields は time-entry update で明示的に送信・削除できるフラグと JSON キーの対応です
+var timeEntryUpdateFields = map[string]string{
+	"comments": "comments",
+}
+
 var timeEntryDeleteCmd = &cobra.Command{
 	Use:   "delete [time_entry_id]",
 	Short: "Delete a time entry",
@@ -238,7 +254,7 @@ func formatTimeEntryDetail(t *redmine.TimeEntry) error {
 		fmt.Println(formatter.FormatKeyValue("Activity", t.Activity.Name))
 	}
 	fmt.Println(formatter.FormatKeyValue("Hours", fmt.Sprintf("%.2f", t.Hours)))
-	fmt.Println(formatter.FormatKeyValue("Spent On", t.SpentOn))
+	fmt.Println(formatter.FormatKeyValue("Spent On", t.SpentOn.String()))
 
 	// Comments
 	if t.Comments != "" {
@@ -250,8 +266,8 @@ func formatTimeEntryDetail(t *redmine.TimeEntry) error {
 	// Timestamps
 	fmt.Println()
 	fmt.Println(formatter.FormatSection("タイムスタンプ"))
-	fmt.Println(formatter.FormatKeyValue("Created", t.CreatedOn))
-	fmt.Println(formatter.FormatKeyValue("Updated", t.UpdatedOn))
+	fmt.Println(formatter.FormatKeyValue("Created", t.CreatedOn.String()))
+	fmt.Println(formatter.FormatKeyValue("Updated", t.UpdatedOn.String()))
 
 	return nil
 }
@@ -279,7 +295,7 @@ func formatTimeEntriesTable(entries []redmine.TimeEntry) error {
 			issueStr,
 			formatter.TruncateString(t.Activity.Name, 15),
 			fmt.Sprintf("%.2f", t.Hours),
-			t.SpentOn,
+			t.SpentOn.String(),
 		})
 	}
 
@@ -303,7 +319,7 @@ func formatTimeEntriesText(entries []redmine.TimeEntry) error {
 		}
 		fmt.Println(formatter.FormatKeyValue("Activity", t.Activity.Name))
 		fmt.Println(formatter.FormatKeyValue("Hours", fmt.Sprintf("%.2f", t.Hours)))
-		fmt.Println(formatter.FormatKeyValue("Spent On", t.SpentOn))
+		fmt.Println(formatter.FormatKeyValue("Spent On", t.SpentOn.String()))
 		if t.Comments != "" {
 			fmt.Println(formatter.FormatKeyValue("Comments", formatter.TruncateString(t.Comments, 80)))
 		}
@@ -363,4 +379,5 @@ func init() {
 	timeEntryUpdateCmd.Flags().String("comments", "", "コメント")
 	timeEntryUpdateCmd.Flags().String("spent-on", "", "作業日 (YYYY-MM-DD)")
 	timeEntryUpdateCmd.Flags().String("custom-fields", "", "カスタムフィールド (JSON形式, 例: '[{\"id\":1,\"value\":\"foo\"}]')")
+	timeEntryUpdateCmd.Flags().StringSlice("clear", nil, "値を削除する項目 (フラグ名のカンマ区切り, 例: comments)")
 }
@@ -277,14 +277,14 @@ func formatUserDetail(u *redmine.User) error {
 	// Timestamps
 	fmt.Println()
 	fmt.Println(formatter.FormatSection("タイムスタンプ"))
-	if u.CreatedOn != "" {
-		fmt.Println(formatter.FormatKeyValue("Created", u.CreatedOn))
+	if !u.CreatedOn.IsZero() {
+		fmt.Println(formatter.FormatKeyValue("Created", u.CreatedOn.String()))
 	}
-	if u.UpdatedOn != "" {
-		fmt.Println(formatter.FormatKeyValue("Updated", u.UpdatedOn))
+	if !u.UpdatedOn.IsZero() {
+		fmt.Println(formatter.FormatKeyValue("Updated", u.UpdatedOn.String()))
 	}
-	if u.LastLoginOn != "" {
-		fmt.Println(formatter.FormatKeyValue("Last Login", u.LastLoginOn))
+	if !u.LastLoginOn.IsZero() {
+		fmt.Println(formatter.FormatKeyValue("Last Login", u.LastLoginOn.String()))
 	}
 
 	return nil
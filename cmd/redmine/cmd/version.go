@@ -81,7 +81,10 @@ var versionCreateCmd = &cobra.Command{
 		name, _ := cmd.Flags().GetString("name")
 		description, _ := cmd.Flags().GetString("description")
 		status, _ := cmd.Flags().GetString("status")
-		dueDate, _ := cmd.Flags().GetString("due-date")
+		dueDate, err := dateFlag(cmd, "due-date")
+		if err != nil {
+			return err
+		}
 		sharing, _ := cmd.Flags().GetString("sharing")
 		wikiPageTitle, _ := cmd.Flags().GetString("wiki-page-title")
 
@@ -127,7 +130,10 @@ var versionUpdateCmd = &cobra.Command{
 		name, _ := cmd.Flags().GetString("name")
 		description, _ := cmd.Flags().GetString("description")
 		status, _ := cmd.Flags().GetString("status")
-		dueDate, _ := cmd.Flags().GetString("due-date")
+		dueDate, err := dateFlag(cmd, "due-date")
+		if err != nil {
+			return err
+		}
 		sharing, _ := cmd.Flags().GetString("sharing")
 		wikiPageTitle, _ := cmd.Flags().GetString("wiki-page-title")
 
@@ -140,6 +146,11 @@ var versionUpdateCmd = &cobra.Command{
 			WikiPageTitle: wikiPageTitle,
 		}
 
+		version.ForceSendFields, version.ClearFields, err = patchFields(cmd, versionUpdateFields)
+		if err != nil {
+			return err
+		}
+
 		err = client.UpdateVersion(context.Background(), id, version)
 		if err != nil {
 			return fmt.Errorf("バージョンの更新に失敗しました: %w", err)
@@ -150,6 +161,13 @@ var versionUpdateCmd = &cobra.Command{
 	},
 }
 
+// versionUpdateFields は version update で明示的に送信・削除できるフラグと JSON キーの対応です
+var versionUpdateFields = map[string]string{
+	"description":     "description",
+	"due-date":        "due_date",
+	"wiki-page-title": "wiki_page_title",
+}
+
 var versionDeleteCmd = &cobra.Command{
 	Use:   "delete [version_id]",
 	Short: "Delete a version",
@@ -183,8 +201,8 @@ func formatVersionsTable(versions []redmine.Version) error {
 
 	for _, v := range versions {
 		dueDate := "-"
-		if v.DueDate != "" {
-			dueDate = v.DueDate
+		if !v.DueDate.IsZero() {
+			dueDate = v.DueDate.String()
 		}
 		status := v.Status
 		if status == "" {
@@ -196,7 +214,7 @@ func formatVersionsTable(versions []redmine.Version) error {
 			formatter.TruncateString(v.Name, 30),
 			status,
 			dueDate,
-			v.CreatedOn,
+			v.CreatedOn.String(),
 		})
 	}
 
@@ -222,8 +240,8 @@ func formatVersionsText(versions []redmine.Version) error {
 		if v.Description != "" {
 			fmt.Println(formatter.FormatKeyValue("Description", formatter.TruncateString(v.Description, 80)))
 		}
-		if v.DueDate != "" {
-			fmt.Println(formatter.FormatKeyValue("Due Date", v.DueDate))
+		if !v.DueDate.IsZero() {
+			fmt.Println(formatter.FormatKeyValue("Due Date", v.DueDate.String()))
 		}
 		fmt.Println()
 	}
@@ -259,4 +277,5 @@ func init() {
 	versionUpdateCmd.Flags().String("due-date", "", "期日 (YYYY-MM-DD)")
 	versionUpdateCmd.Flags().String("sharing", "", "共有設定 (none, descendants, hierarchy, tree, system)")
 	versionUpdateCmd.Flags().String("wiki-page-title", "", "Wikiページタイトル")
+	versionUpdateCmd.Flags().StringSlice("clear", nil, "値を削除する項目 (フラグ名のカンマ区切り, 例: due-date)")
 }
@@ -87,6 +87,7 @@ var wikiCreateOrUpdateCmd = &cobra.Command{
 		text, _ := cmd.Flags().GetString("text")
 		comments, _ := cmd.Flags().GetString("comments")
 		version, _ := cmd.Flags().GetInt("version")
+		parentTitle, _ := cmd.Flags().GetString("parent-title")
 		uploadsJSON, _ := cmd.Flags().GetString("uploads")
 
 		if text == "" {
@@ -94,11 +95,19 @@ var wikiCreateOrUpdateCmd = &cobra.Command{
 		}
 
 		page := redmine.WikiPageUpdate{
-			Text:     text,
-			Comments: comments,
-			Version:  version,
+			Text:        text,
+			Comments:    comments,
+			Version:     version,
+			ParentTitle: parentTitle,
 		}
 
+		force, clear, err := patchFields(cmd, wikiUpdateFields)
+		if err != nil {
+			return err
+		}
+		page.ForceSendFields = force
+		page.ClearFields = clear
+
 		// Parse uploads if provided
 		if uploadsJSON != "" {
 			var uploads []redmine.Upload
@@ -108,7 +117,10 @@ var wikiCreateOrUpdateCmd = &cobra.Command{
 			page.Uploads = uploads
 		}
 
-		err := client.CreateOrUpdateWikiPage(context.Background(), args[0], args[1], page)
+		err = client.CreateOrUpdateWikiPage(context.Background(), args[0], args[1], page)
+		if errors.Is(err, redmine.ErrConflict) {
+			return fmt.Errorf("wikiページは他のユーザーによって更新されています。最新版を確認してからやり直してください: %w", err)
+		}
 		if err != nil {
 			return fmt.Errorf("wikiページの作成/更新に失敗しました: %w", err)
 		}
@@ -118,6 +130,77 @@ var wikiCreateOrUpdateCmd = &cobra.Command{
 	},
 }
 
+// wikiUpdateFields は wiki create-or-update で明示的に送信・削除できるフラグと JSON キーの対応です
+var wikiUpdateFields = map[string]string{
+	"parent-title": "parent_title",
+}
+
+var wikiHistoryCmd = &cobra.Command{
+	Use:   "history [project_id_or_identifier] [page_name]",
+	Short: "Show the version history of a wiki page",
+	Long:  `Wikiページの更新履歴を新しい順に表示します。各バージョンを順に取得するため、--limit で件数を絞れます。`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		limit, _ := cmd.Flags().GetInt("limit")
+		format, _ := cmd.Flags().GetString("format")
+
+		versions, err := client.ListWikiPageVersions(context.Background(), args[0], args[1], &redmine.ListWikiPageVersionsOptions{Limit: limit})
+		if err != nil {
+			return fmt.Errorf("wikiページの履歴の取得に失敗しました: %w", err)
+		}
+
+		// Format output based on --format flag
+		switch format {
+		case formatJSON:
+			return formatter.OutputJSON(versions)
+		case formatTable:
+			return formatWikiVersionsTable(versions)
+		case formatText:
+			return formatWikiVersionsText(versions)
+		default:
+			return fmt.Errorf("不明な出力フォーマット: %s", format)
+		}
+	},
+}
+
+var wikiDiffCmd = &cobra.Command{
+	Use:   "diff [project_id_or_identifier] [page_name]",
+	Short: "Show differences between two versions of a wiki page",
+	Long: `Wikiページの2つのバージョンの差分を unified diff 形式で表示します。
+--to を省略すると現在のバージョン、--from を省略すると --to の1つ前のバージョンと比較します。`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		from, _ := cmd.Flags().GetInt("from")
+		to, _ := cmd.Flags().GetInt("to")
+
+		if from == 0 {
+			if to == 0 {
+				result, err := client.GetWikiPage(context.Background(), args[0], args[1], nil)
+				if err != nil {
+					return fmt.Errorf("wikiページの取得に失敗しました: %w", err)
+				}
+				to = result.WikiPage.Version
+			}
+			from = to - 1
+		}
+		if from < 1 {
+			return errors.New("比較元のバージョンがありません。--from を指定してください")
+		}
+
+		diff, err := client.DiffWikiPageVersions(context.Background(), args[0], args[1], from, to)
+		if err != nil {
+			return fmt.Errorf("wikiページの差分の取得に失敗しました: %w", err)
+		}
+
+		if diff == "" {
+			fmt.Println("差分はありません")
+			return nil
+		}
+		fmt.Print(diff)
+		return nil
+	},
+}
+
 var wikiDeleteCmd = &cobra.Command{
 	Use:   "delete [project_id_or_identifier] [page_name]",
 	Short: "Delete a wiki page",
@@ -163,11 +246,11 @@ func formatWikiPageDetail(w *redmine.WikiPage) error {
 	// Timestamps
 	fmt.Println()
 	fmt.Println(formatter.FormatSection("タイムスタンプ"))
-	if w.CreatedOn != "" {
-		fmt.Println(formatter.FormatKeyValue("Created", w.CreatedOn))
+	if !w.CreatedOn.IsZero() {
+		fmt.Println(formatter.FormatKeyValue("Created", w.CreatedOn.String()))
 	}
-	if w.UpdatedOn != "" {
-		fmt.Println(formatter.FormatKeyValue("Updated", w.UpdatedOn))
+	if !w.UpdatedOn.IsZero() {
+		fmt.Println(formatter.FormatKeyValue("Updated", w.UpdatedOn.String()))
 	}
 
 	return nil
@@ -193,8 +276,8 @@ func formatWikiPagesTable(pages []redmine.WikiPageIndex) error {
 			formatter.TruncateString(p.Title, 30),
 			strconv.Itoa(p.Version),
 			formatter.TruncateString(parent, 20),
-			p.CreatedOn,
-			p.UpdatedOn,
+			p.CreatedOn.String(),
+			p.UpdatedOn.String(),
 		})
 	}
 
@@ -215,8 +298,41 @@ func formatWikiPagesText(pages []redmine.WikiPageIndex) error {
 		if p.Parent.Name != "" {
 			fmt.Println(formatter.FormatKeyValue("Parent", p.Parent.Name))
 		}
-		fmt.Println(formatter.FormatKeyValue("Created", p.CreatedOn))
-		fmt.Println(formatter.FormatKeyValue("Updated", p.UpdatedOn))
+		fmt.Println(formatter.FormatKeyValue("Created", p.CreatedOn.String()))
+		fmt.Println(formatter.FormatKeyValue("Updated", p.UpdatedOn.String()))
+		fmt.Println()
+	}
+
+	return nil
+}
+
+// formatWikiVersionsTable formats wiki page versions in table format.
+func formatWikiVersionsTable(versions []redmine.WikiPageVersion) error {
+	headers := []string{"Version", "Author", "Updated", "Comments"}
+	rows := make([][]string, 0, len(versions))
+
+	for _, v := range versions {
+		rows = append(rows, []string{
+			strconv.Itoa(v.Version),
+			formatter.TruncateString(v.Author.Name, 20),
+			v.UpdatedOn.String(),
+			formatter.TruncateString(v.Comments, 40),
+		})
+	}
+
+	formatter.RenderTable(headers, rows)
+	return nil
+}
+
+// formatWikiVersionsText formats wiki page versions in simple text format.
+func formatWikiVersionsText(versions []redmine.WikiPageVersion) error {
+	for _, v := range versions {
+		fmt.Println(formatter.FormatKeyValue("Version", strconv.Itoa(v.Version)))
+		fmt.Println(formatter.FormatKeyValue("Author", v.Author.Name))
+		fmt.Println(formatter.FormatKeyValue("Updated", v.UpdatedOn.String()))
+		if v.Comments != "" {
+			fmt.Println(formatter.FormatKeyValue("Comments", v.Comments))
+		}
 		fmt.Println()
 	}
 
@@ -235,6 +351,8 @@ func init() {
 	wikiCmd.AddCommand(wikiListCmd)
 	wikiCmd.AddCommand(wikiGetCmd)
 	wikiCmd.AddCommand(wikiCreateOrUpdateCmd)
+	wikiCmd.AddCommand(wikiHistoryCmd)
+	wikiCmd.AddCommand(wikiDiffCmd)
 	wikiCmd.AddCommand(wikiDeleteCmd)
 
 	// Flags for list command
@@ -254,5 +372,15 @@ func init() {
 	wikiCreateOrUpdateCmd.Flags().String("text", "", "Wikiページの本文 (必須)")
 	wikiCreateOrUpdateCmd.Flags().String("comments", "", "更新コメント")
 	wikiCreateOrUpdateCmd.Flags().Int("version", 0, "更新するバージョン番号（競合チェック用）")
+	wikiCreateOrUpdateCmd.Flags().String("parent-title", "", "親ページのタイトル")
 	wikiCreateOrUpdateCmd.Flags().String("uploads", "", "アップロードファイル情報 (JSON形式, 例: '[{\"token\":\"xxx\",\"filename\":\"file.pdf\"}]')")
+	wikiCreateOrUpdateCmd.Flags().StringSlice("clear", nil, "値を削除する項目 (フラグ名のカンマ区切り, 例: parent-title)")
+
+	// Flags for history command
+	wikiHistoryCmd.Flags().Int("limit", 0, "取得する最大件数 (0は全件)")
+	wikiHistoryCmd.Flags().StringP("format", "f", formatTable, "出力フォーマット (json, table, text)")
+
+	// Flags for diff command
+	wikiDiffCmd.Flags().Int("from", 0, "比較元のバージョン番号")
+	wikiDiffCmd.Flags().Int("to", 0, "比較先のバージョン番号 (省略時は現在のバージョン)")
 }
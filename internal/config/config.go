@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 // Config holds the configuration for the Redmine MCP server.
 type Config struct {
 	// RedmineURL is the base URL of the Redmine instance
@@ -8,6 +10,32 @@ type Config struct {
 	// APIKey is the Redmine API key for authentication
 	APIKey string
 
+	// Username and Password enable HTTP Basic authentication instead of the API key.
+	Username string
+	Password string
+
+	// Anonymous sends requests without credentials.
+	Anonymous bool
+
+	// FeedKey is the Atom access key used for activity feeds.
+	FeedKey string
+
+	// SwitchUser is the login of the user to impersonate (requires an admin account).
+	SwitchUser string
+
+	// Timeout is the HTTP request timeout. Zero means no timeout.
+	Timeout time.Duration
+
+	// UserAgent is sent as the User-Agent header if not empty.
+	UserAgent string
+
+	// CacheTTL enables an in-memory cache of metadata, such as trackers and
+	// statuses, kept for the given duration. Zero disables the cache.
+	CacheTTL time.Duration
+
+	// Debug enables debug logging of Redmine requests to stderr.
+	Debug bool
+
 	// EnabledToolGroups specifies which tool groups to enable.
 	// If empty, all tool groups are enabled by default.
 	// Examples: "projects", "issues", "users", "all"
@@ -2,31 +2,56 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 var (
 	// ErrMissingRedmineURL is returned when REDMINE_URL is not set
 	ErrMissingRedmineURL = errors.New("REDMINE_URL environment variable is required")
 
-	// ErrMissingAPIKey is returned when REDMINE_API_KEY is not set
-	ErrMissingAPIKey = errors.New("REDMINE_API_KEY environment variable is required")
+	// ErrMissingAPIKey is returned when REDMINE_API_KEY is not set and no other
+	// authentication mode is configured
+	ErrMissingAPIKey = errors.New("REDMINE_API_KEY environment variable is required (or set REDMINE_USERNAME or REDMINE_ANONYMOUS)")
 )
 
 // Load reads configuration from environment variables.
 // It returns an error if required environment variables are not set.
 func Load() (*Config, error) {
+	var err error
+
 	redmineURL := os.Getenv("REDMINE_URL")
 	if redmineURL == "" {
 		return nil, ErrMissingRedmineURL
 	}
 
 	apiKey := os.Getenv("REDMINE_API_KEY")
-	if apiKey == "" {
+	username := os.Getenv("REDMINE_USERNAME")
+	anonymous, _ := strconv.ParseBool(os.Getenv("REDMINE_ANONYMOUS"))
+	if apiKey == "" && username == "" && !anonymous {
 		return nil, ErrMissingAPIKey
 	}
 
+	// Parse optional HTTP client environment variables
+	var timeout time.Duration
+	if v := os.Getenv("REDMINE_TIMEOUT"); v != "" {
+		timeout, err = time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid REDMINE_TIMEOUT: %w", err)
+		}
+	}
+	var cacheTTL time.Duration
+	if v := os.Getenv("REDMINE_CACHE_TTL"); v != "" {
+		cacheTTL, err = time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid REDMINE_CACHE_TTL: %w", err)
+		}
+	}
+	debug, _ := strconv.ParseBool(os.Getenv("REDMINE_DEBUG"))
+
 	// Parse optional tool control environment variables
 	enabledToolGroups := parseCommaSeparated(os.Getenv("REDMINE_ENABLED_TOOLS"))
 	disabledTools := parseCommaSeparated(os.Getenv("REDMINE_DISABLED_TOOLS"))
@@ -34,6 +59,15 @@ func Load() (*Config, error) {
 	return &Config{
 		RedmineURL:        redmineURL,
 		APIKey:            apiKey,
+		Username:          username,
+		Password:          os.Getenv("REDMINE_PASSWORD"),
+		Anonymous:         anonymous,
+		FeedKey:           os.Getenv("REDMINE_FEED_KEY"),
+		SwitchUser:        os.Getenv("REDMINE_SWITCH_USER"),
+		Timeout:           timeout,
+		UserAgent:         os.Getenv("REDMINE_USER_AGENT"),
+		CacheTTL:          cacheTTL,
+		Debug:             debug,
 		EnabledToolGroups: enabledToolGroups,
 		DisabledTools:     disabledTools,
 	}, nil
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/kqns91/redmine-go/internal/config"
+	"github.com/kqns91/redmine-go/internal/usecase"
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// RegisterActivityTools registers all activity-related MCP tools.
+// Tools are conditionally registered based on the configuration.
+func RegisterActivityTools(server *mcp.Server, useCases *usecase.UseCases, cfg *config.Config) {
+	const toolGroup = "activity"
+
+	// List Activity tool
+	if cfg.IsToolEnabled(toolGroup, "list_activity") {
+		mcp.AddTool(server, &mcp.Tool{
+			Name:        "list_activity",
+			Description: "List recent activity across issues, changesets, news, documents, wiki edits and time entries, newest first. Reads Redmine's Atom feed, which holds only the latest events (15 by default). Private projects require REDMINE_FEED_KEY.",
+		}, handleListActivity(useCases))
+	}
+}
+
+// ListActivityArgs defines arguments for listing activity
+type ListActivityArgs struct {
+	ProjectID string   `json:"project_id,omitempty" jsonschema:"Project ID or identifier (optional, default: all projects)"`
+	UserID    int      `json:"user_id,omitempty" jsonschema:"Only activity by this user (optional)"`
+	Types     []string `json:"types,omitempty" jsonschema:"Activity types: issues, changesets, news, documents, files, wiki_edits, messages, time_entries (optional, default: Redmine's defaults)"`
+	From      string   `json:"from,omitempty" jsonschema:"Only activity on or after this date in YYYY-MM-DD format (optional)"`
+	To        string   `json:"to,omitempty" jsonschema:"Only activity on or before this date in YYYY-MM-DD format (optional)"`
+}
+
+// ListActivityOutput defines output for listing activity
+type ListActivityOutput struct {
+	Result string `json:"result" jsonschema:"JSON formatted list of activity events"`
+}
+
+func handleListActivity(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args ListActivityArgs) (*mcp.CallToolResult, ListActivityOutput, error) {
+	return func(ctx context.Context, request *mcp.CallToolRequest, args ListActivityArgs) (*mcp.CallToolResult, ListActivityOutput, error) {
+		from, err := redmine.ParseDate(args.From)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, ListActivityOutput{}, fmt.Errorf("invalid from: %w", err)
+		}
+		to, err := redmine.ParseDate(args.To)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, ListActivityOutput{}, fmt.Errorf("invalid to: %w", err)
+		}
+
+		opts := &redmine.ListActivityOptions{
+			ProjectID: args.ProjectID,
+			UserID:    args.UserID,
+			Types:     args.Types,
+			From:      from.Time,
+		}
+		if !to.IsZero() {
+			// Include the whole day
+			opts.To = to.AddDays(1).Add(-1)
+		}
+
+		result, err := useCases.Activity.ListActivity(ctx, opts)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, ListActivityOutput{}, fmt.Errorf("failed to list activity: %w", err)
+		}
+
+		jsonData, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, ListActivityOutput{}, fmt.Errorf("failed to marshal response: %w", err)
+		}
+
+		return nil, ListActivityOutput{Result: string(jsonData)}, nil
+	}
+}
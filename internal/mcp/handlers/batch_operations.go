@@ -22,7 +22,7 @@ func RegisterBatchOperationTools(server *mcp.Server, useCases *usecase.UseCases,
 		mcp.AddTool(server, &mcp.Tool{
 			Name:        "create_task_tree",
 			Description: "Batch create a flat list of tasks with parent-child relationships, dependencies, and estimates. Use 'parent_ref' to reference parent tasks. Ideal for creating 10-30 related tickets for a single feature.",
-		}, handleCreateTaskTree(useCases))
+		}, handleCreateTaskTree(useCases.RedmineClient))
 	}
 }
 
@@ -88,7 +88,13 @@ type TaskTreeCreationSummary struct {
 	LatestDueDate      string  `json:"latest_due_date,omitempty"`
 }
 
-func handleCreateTaskTree(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args CreateTaskTreeArgs) (*mcp.CallToolResult, CreateTaskTreeResult, error) {
+// taskTreeClient is the part of the Redmine API used to create task trees.
+type taskTreeClient interface {
+	redmine.IssuesService
+	redmine.IssueRelationsService
+}
+
+func handleCreateTaskTree(client taskTreeClient) func(ctx context.Context, request *mcp.CallToolRequest, args CreateTaskTreeArgs) (*mcp.CallToolResult, CreateTaskTreeResult, error) {
 	return func(ctx context.Context, request *mcp.CallToolRequest, args CreateTaskTreeArgs) (*mcp.CallToolResult, CreateTaskTreeResult, error) {
 		if args.ProjectID == 0 {
 			return nil, CreateTaskTreeResult{}, errors.New("project_id is required")
@@ -110,10 +116,10 @@ func handleCreateTaskTree(useCases *usecase.UseCases) func(ctx context.Context,
 		}
 
 		// Create all tasks in order (respecting parent references)
-		createTasksFlat(ctx, useCases, args.ProjectID, args.Tasks, result)
+		createTasksFlat(ctx, client, args.ProjectID, args.Tasks, result)
 
 		// Create relations between tasks
-		createTaskRelations(ctx, useCases, args.Tasks, result)
+		createTaskRelations(ctx, client, args.Tasks, result)
 
 		// Calculate summary
 		calculateSummary(result)
@@ -124,7 +130,7 @@ func handleCreateTaskTree(useCases *usecase.UseCases) func(ctx context.Context,
 	}
 }
 
-func createTasksFlat(ctx context.Context, useCases *usecase.UseCases, projectID int, tasks []TaskNode, result *CreateTaskTreeResult) {
+func createTasksFlat(ctx context.Context, issues redmine.IssuesService, projectID int, tasks []TaskNode, result *CreateTaskTreeResult) {
 	// Create tasks in multiple passes to handle parent references
 	// Pass 1: Create tasks without parents
 	// Pass 2+: Create tasks whose parents have been created
@@ -151,6 +157,17 @@ func createTasksFlat(ctx context.Context, useCases *usecase.UseCases, projectID
 				}
 			}
 
+			startDate, err := redmine.ParseDate(task.StartDate)
+			if err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("failed to create issue '%s': invalid start_date: %v", task.Subject, err))
+				continue
+			}
+			dueDate, err := redmine.ParseDate(task.DueDate)
+			if err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("failed to create issue '%s': invalid due_date: %v", task.Subject, err))
+				continue
+			}
+
 			// Create the issue
 			req := redmine.IssueCreateRequest{
 				ProjectID:      projectID,
@@ -163,13 +180,13 @@ func createTasksFlat(ctx context.Context, useCases *usecase.UseCases, projectID
 				ParentIssueID:  parentID,
 				FixedVersionID: task.FixedVersionID,
 				EstimatedHours: task.EstimatedHours,
-				StartDate:      task.StartDate,
-				DueDate:        task.DueDate,
+				StartDate:      startDate,
+				DueDate:        dueDate,
 				DoneRatio:      task.DoneRatio,
 				CustomFields:   task.CustomFields,
 			}
 
-			resp, err := useCases.RedmineClient.CreateIssue(ctx, req)
+			resp, err := issues.CreateIssue(ctx, req)
 			if err != nil {
 				result.Errors = append(result.Errors, fmt.Sprintf("failed to create issue '%s': %v", task.Subject, err))
 				continue
@@ -202,7 +219,7 @@ func createTasksFlat(ctx context.Context, useCases *usecase.UseCases, projectID
 	}
 }
 
-func createTaskRelations(ctx context.Context, useCases *usecase.UseCases, tasks []TaskNode, result *CreateTaskTreeResult) {
+func createTaskRelations(ctx context.Context, relations redmine.IssueRelationsService, tasks []TaskNode, result *CreateTaskTreeResult) {
 	// Create relations for each task
 	for _, task := range tasks {
 		if task.Ref == "" {
@@ -227,7 +244,7 @@ func createTaskRelations(ctx context.Context, useCases *usecase.UseCases, tasks
 				RelationType: "blocks",
 			}
 
-			resp, err := useCases.RedmineClient.CreateIssueRelation(ctx, issueID, relation)
+			resp, err := relations.CreateIssueRelation(ctx, issueID, relation)
 			if err != nil {
 				result.Errors = append(result.Errors, fmt.Sprintf("failed to create relation %d blocks %d: %v", issueID, targetID, err))
 				continue
@@ -254,7 +271,7 @@ func createTaskRelations(ctx context.Context, useCases *usecase.UseCases, tasks
 				RelationType: "precedes",
 			}
 
-			resp, err := useCases.RedmineClient.CreateIssueRelation(ctx, issueID, relation)
+			resp, err := relations.CreateIssueRelation(ctx, issueID, relation)
 			if err != nil {
 				result.Errors = append(result.Errors, fmt.Sprintf("failed to create relation %d precedes %d: %v", issueID, targetID, err))
 				continue
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+	"github.com/kqns91/redmine-go/pkg/redminemock"
+)
+
+// mockTaskTreeClient combines the mocks of the services a task tree needs.
+type mockTaskTreeClient struct {
+	*redminemock.MockIssuesService
+	*redminemock.MockIssueRelationsService
+}
+
+func TestHandleCreateTaskTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockTaskTreeClient{
+		MockIssuesService:         redminemock.NewMockIssuesService(ctrl),
+		MockIssueRelationsService: redminemock.NewMockIssueRelationsService(ctrl),
+	}
+	ctx := context.Background()
+
+	// Issues are numbered from 11 in the order they are created
+	nextID := 11
+	client.MockIssuesService.EXPECT().CreateIssue(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, req redmine.IssueCreateRequest) (*redmine.IssueResponse, error) {
+			if req.ProjectID != 1 {
+				t.Errorf("Expected project 1, got %d", req.ProjectID)
+			}
+			if req.Subject == "Deploy" {
+				return nil, redmine.ErrForbidden
+			}
+			if req.Subject != "Epic" && req.ParentIssueID != 11 {
+				t.Errorf("Expected %q to have parent 11, got %d", req.Subject, req.ParentIssueID)
+			}
+			nextID++
+			return &redmine.IssueResponse{Issue: redmine.Issue{ID: nextID - 1, Subject: req.Subject}}, nil
+		}).Times(4)
+	client.MockIssueRelationsService.EXPECT().
+		CreateIssueRelation(ctx, 12, redmine.IssueRelation{IssueToID: 13, RelationType: "blocks"}).
+		Return(&redmine.IssueRelationResponse{Relation: redmine.IssueRelation{ID: 100}}, nil)
+
+	// Children come before their parent to check that they wait for it
+	args := CreateTaskTreeArgs{
+		ProjectID: 1,
+		Tasks: []TaskNode{
+			{Subject: "Design", Ref: "design", ParentRef: "epic", BlocksRefs: []string{"build"}},
+			{Subject: "Build", Ref: "build", ParentRef: "epic", PrecedesRefs: []string{"deploy"}},
+			{Subject: "Epic", Ref: "epic"},
+			{Subject: "Deploy", Ref: "deploy", ParentRef: "epic"},
+		},
+	}
+	_, result, err := handleCreateTaskTree(client)(ctx, nil, args)
+	if err != nil {
+		t.Fatalf("handleCreateTaskTree failed: %v", err)
+	}
+
+	if result.CreatedCount != 3 {
+		t.Errorf("Expected 3 created tasks, got %d", result.CreatedCount)
+	}
+	if result.TaskMapping["epic"] != 11 || result.TaskMapping["design"] != 12 || result.TaskMapping["build"] != 13 {
+		t.Errorf("Expected epic 11, design 12 and build 13, got %v", result.TaskMapping)
+	}
+	if len(result.Relations) != 1 || result.Relations[0].RelationID != 100 {
+		t.Errorf("Expected relation 100, got %+v", result.Relations)
+	}
+	// The failed creation and the relation to the task it did not create
+	if len(result.Errors) != 2 {
+		t.Errorf("Expected 2 errors, got %v", result.Errors)
+	}
+}
+
+func TestHandleCreateTaskTreeRequiredArgs(t *testing.T) {
+	// A missing argument fails before any request
+	client := mockTaskTreeClient{
+		MockIssuesService:         redminemock.NewMockIssuesService(gomock.NewController(t)),
+		MockIssueRelationsService: redminemock.NewMockIssueRelationsService(gomock.NewController(t)),
+	}
+
+	tests := []struct {
+		name string
+		args CreateTaskTreeArgs
+	}{
+		{name: "project", args: CreateTaskTreeArgs{Tasks: []TaskNode{{Subject: "Task"}}}},
+		{name: "tasks", args: CreateTaskTreeArgs{ProjectID: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := handleCreateTaskTree(client)(context.Background(), nil, tt.args); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
+
+func TestCreateTaskRelationsError(t *testing.T) {
+	relations := redminemock.NewMockIssueRelationsService(gomock.NewController(t))
+	relations.EXPECT().CreateIssueRelation(gomock.Any(), 1, gomock.Any()).Return(nil, errors.New("validation failed"))
+
+	result := &CreateTaskTreeResult{TaskMapping: map[string]int{"a": 1, "b": 2}}
+	createTaskRelations(context.Background(), relations, []TaskNode{{Ref: "a", PrecedesRefs: []string{"b"}}}, result)
+
+	if len(result.Relations) != 0 || len(result.Errors) != 1 {
+		t.Errorf("Expected 1 error and no relation, got %+v", result)
+	}
+}
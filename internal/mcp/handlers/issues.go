@@ -3,7 +3,9 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
@@ -20,7 +22,7 @@ func RegisterIssueTools(server *mcp.Server, useCases *usecase.UseCases, cfg *con
 	if cfg.IsToolEnabled(toolGroup, "list_issues") {
 		mcp.AddTool(server, &mcp.Tool{
 			Name:        "list_issues",
-			Description: "List issues in Redmine. Supports filtering, pagination, and sorting.",
+			Description: "List issues in Redmine. Supports filtering (including generic operators and custom fields), saved queries, pagination, and sorting.",
 		}, handleListIssues(useCases))
 	}
 
@@ -44,7 +46,7 @@ func RegisterIssueTools(server *mcp.Server, useCases *usecase.UseCases, cfg *con
 	if cfg.IsToolEnabled(toolGroup, "update_issue") {
 		mcp.AddTool(server, &mcp.Tool{
 			Name:        "update_issue",
-			Description: "Update an existing issue in Redmine.",
+			Description: "Update an existing issue in Redmine. Pass updated_on from when the issue was read to refuse the update if someone else changed it since.",
 		}, handleUpdateIssue(useCases))
 	}
 
@@ -75,15 +77,30 @@ func RegisterIssueTools(server *mcp.Server, useCases *usecase.UseCases, cfg *con
 
 // ListIssuesArgs defines arguments for listing issues
 type ListIssuesArgs struct {
-	ProjectID    int    `json:"project_id,omitempty" jsonschema:"Filter by project ID"`
-	SubprojectID string `json:"subproject_id,omitempty" jsonschema:"Filter by subproject ID (none, !*, *)"`
-	TrackerID    int    `json:"tracker_id,omitempty" jsonschema:"Filter by tracker ID"`
-	StatusID     string `json:"status_id,omitempty" jsonschema:"Filter by status ID (* for all, open, closed, or specific ID)"`
-	AssignedToID string `json:"assigned_to_id,omitempty" jsonschema:"Filter by assigned user ID (me for current user)"`
-	Include      string `json:"include,omitempty" jsonschema:"Optional comma-separated list of associations to include"`
-	Limit        int    `json:"limit,omitempty" jsonschema:"Maximum number of issues to return (default: 25)"`
-	Offset       int    `json:"offset,omitempty" jsonschema:"Offset for pagination (default: 0)"`
-	Sort         string `json:"sort,omitempty" jsonschema:"Sort order (e.g., id:desc, created_on:asc)"`
+	ProjectID    int           `json:"project_id,omitempty" jsonschema:"Filter by project ID"`
+	SubprojectID string        `json:"subproject_id,omitempty" jsonschema:"Filter by subproject ID (none, !*, *)"`
+	TrackerID    int           `json:"tracker_id,omitempty" jsonschema:"Filter by tracker ID"`
+	StatusID     string        `json:"status_id,omitempty" jsonschema:"Filter by status ID (* for all, open, closed, or specific ID)"`
+	AssignedToID string        `json:"assigned_to_id,omitempty" jsonschema:"Filter by assigned user ID (me for current user)"`
+	AuthorID     string        `json:"author_id,omitempty" jsonschema:"Filter by author user ID (me for current user)"`
+	WatcherID    string        `json:"watcher_id,omitempty" jsonschema:"Filter by watcher user ID (me for current user)"`
+	Project      string        `json:"project,omitempty" jsonschema:"Filter by project identifier or name, instead of project_id"`
+	Tracker      string        `json:"tracker,omitempty" jsonschema:"Filter by tracker name, instead of tracker_id"`
+	Status       string        `json:"status,omitempty" jsonschema:"Filter by status name, instead of status_id"`
+	AssignedTo   string        `json:"assigned_to,omitempty" jsonschema:"Filter by assignee login or name, instead of assigned_to_id"`
+	Filters      []IssueFilter `json:"filters,omitempty" jsonschema:"Generic filter conditions, combined with AND"`
+	QueryID      int           `json:"query_id,omitempty" jsonschema:"Run a saved query by ID (see list_queries); its filters replace the ones above"`
+	Include      string        `json:"include,omitempty" jsonschema:"Optional comma-separated list of associations to include"`
+	Limit        int           `json:"limit,omitempty" jsonschema:"Maximum number of issues to return (default: 25)"`
+	Offset       int           `json:"offset,omitempty" jsonschema:"Offset for pagination (default: 0)"`
+	Sort         string        `json:"sort,omitempty" jsonschema:"Sort order (e.g., id:desc, created_on:asc)"`
+}
+
+// IssueFilter defines a generic issue filter condition
+type IssueFilter struct {
+	Field    string   `json:"field" jsonschema:"Filter name, e.g. status_id, author_id, updated_on, or cf_5 for custom field 5"`
+	Operator string   `json:"operator" jsonschema:"Operator: = ! o c * !* >= <= >< ~ !~ ^ $ t ld w lw m lm y t- >t- <t- ><t- t+ <t+ >t+ ><t+"`
+	Values   []string `json:"values,omitempty" jsonschema:"Values for the operator, e.g. two dates for ><, a number of days for >t-"`
 }
 
 // ListIssuesOutput defines output for listing issues
@@ -93,20 +110,46 @@ type ListIssuesOutput struct {
 
 func handleListIssues(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args ListIssuesArgs) (*mcp.CallToolResult, ListIssuesOutput, error) {
 	return func(ctx context.Context, request *mcp.CallToolRequest, args ListIssuesArgs) (*mcp.CallToolResult, ListIssuesOutput, error) {
-		var opts *redmine.ListIssuesOptions
-		if args.ProjectID > 0 || args.SubprojectID != "" || args.TrackerID > 0 ||
-			args.StatusID != "" || args.AssignedToID != "" || args.Include != "" ||
-			args.Limit > 0 || args.Offset > 0 || args.Sort != "" {
-			opts = &redmine.ListIssuesOptions{
-				ProjectID:    args.ProjectID,
-				SubprojectID: args.SubprojectID,
-				TrackerID:    args.TrackerID,
-				StatusID:     args.StatusID,
-				AssignedToID: args.AssignedToID,
-				Include:      args.Include,
-				Limit:        args.Limit,
-				Offset:       args.Offset,
-				Sort:         args.Sort,
+		names, err := resolveIssueNames(ctx, useCases.Resolver, issueNames{
+			project:    args.Project,
+			tracker:    args.Tracker,
+			status:     args.Status,
+			assignedTo: args.AssignedTo,
+		}, nil)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, ListIssuesOutput{}, err
+		}
+
+		opts := &redmine.ListIssuesOptions{
+			ProjectID:    args.ProjectID,
+			SubprojectID: args.SubprojectID,
+			TrackerID:    args.TrackerID,
+			StatusID:     args.StatusID,
+			AssignedToID: args.AssignedToID,
+			AuthorID:     args.AuthorID,
+			WatcherID:    args.WatcherID,
+			QueryID:      args.QueryID,
+			Include:      args.Include,
+			Limit:        args.Limit,
+			Offset:       args.Offset,
+			Sort:         args.Sort,
+		}
+		if names.project != 0 {
+			opts.ProjectID = names.project
+		}
+		if names.tracker != 0 {
+			opts.TrackerID = names.tracker
+		}
+		if names.status != 0 {
+			opts.StatusID = strconv.Itoa(names.status)
+		}
+		if names.assignedTo != 0 {
+			opts.AssignedToID = strconv.Itoa(names.assignedTo)
+		}
+		if len(args.Filters) > 0 {
+			opts.Filter = redmine.NewFilter()
+			for _, f := range args.Filters {
+				opts.Filter.Where(f.Field, f.Operator, f.Values...)
 			}
 		}
 
@@ -160,7 +203,7 @@ func handleShowIssue(useCases *usecase.UseCases) func(ctx context.Context, reque
 
 // CreateIssueArgs defines arguments for creating an issue
 type CreateIssueArgs struct {
-	ProjectID      int                   `json:"project_id" jsonschema:"Project ID (required)"`
+	ProjectID      int                   `json:"project_id,omitempty" jsonschema:"Project ID (required unless project is given)"`
 	TrackerID      int                   `json:"tracker_id,omitempty" jsonschema:"Tracker ID (optional, uses project default if not specified)"`
 	StatusID       int                   `json:"status_id,omitempty" jsonschema:"Status ID (optional, uses default status if not specified)"`
 	PriorityID     int                   `json:"priority_id,omitempty" jsonschema:"Priority ID (optional)"`
@@ -176,6 +219,12 @@ type CreateIssueArgs struct {
 	IsPrivate      bool                  `json:"is_private,omitempty" jsonschema:"Whether the issue is private (optional)"`
 	EstimatedHours float64               `json:"estimated_hours,omitempty" jsonschema:"Estimated hours (optional)"`
 	WatcherUserIDs []int                 `json:"watcher_user_ids,omitempty" jsonschema:"User IDs to add as watchers (optional)"`
+	Project        string                `json:"project,omitempty" jsonschema:"Project identifier or name, instead of project_id (optional)"`
+	Tracker        string                `json:"tracker,omitempty" jsonschema:"Tracker name, instead of tracker_id (optional)"`
+	Status         string                `json:"status,omitempty" jsonschema:"Status name, instead of status_id (optional)"`
+	Priority       string                `json:"priority,omitempty" jsonschema:"Priority name, instead of priority_id (optional)"`
+	AssignedTo     string                `json:"assigned_to,omitempty" jsonschema:"Assignee login, name or me, instead of assigned_to_id (optional)"`
+	FixedVersion   string                `json:"fixed_version,omitempty" jsonschema:"Target version name, instead of fixed_version_id (optional)"`
 	CustomFields   []redmine.CustomField `json:"custom_fields,omitempty" jsonschema:"Custom field values (optional)"`
 	Uploads        []redmine.Upload      `json:"uploads,omitempty" jsonschema:"Upload tokens for file attachments (optional)"`
 }
@@ -187,6 +236,29 @@ type CreateIssueOutput struct {
 
 func handleCreateIssue(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args CreateIssueArgs) (*mcp.CallToolResult, CreateIssueOutput, error) {
 	return func(ctx context.Context, request *mcp.CallToolRequest, args CreateIssueArgs) (*mcp.CallToolResult, CreateIssueOutput, error) {
+		startDate, err := redmine.ParseDate(args.StartDate)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, CreateIssueOutput{}, fmt.Errorf("invalid start_date: %w", err)
+		}
+		dueDate, err := redmine.ParseDate(args.DueDate)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, CreateIssueOutput{}, fmt.Errorf("invalid due_date: %w", err)
+		}
+
+		names, err := resolveIssueNames(ctx, useCases.Resolver, issueNames{
+			project:      args.Project,
+			tracker:      args.Tracker,
+			status:       args.Status,
+			priority:     args.Priority,
+			assignedTo:   args.AssignedTo,
+			fixedVersion: args.FixedVersion,
+		}, func(context.Context) (int, error) {
+			return args.ProjectID, nil
+		})
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, CreateIssueOutput{}, err
+		}
+
 		req := redmine.IssueCreateRequest{
 			ProjectID:      args.ProjectID,
 			TrackerID:      args.TrackerID,
@@ -198,8 +270,8 @@ func handleCreateIssue(useCases *usecase.UseCases) func(ctx context.Context, req
 			AssignedToID:   args.AssignedToID,
 			ParentIssueID:  args.ParentIssueID,
 			Description:    args.Description,
-			StartDate:      args.StartDate,
-			DueDate:        args.DueDate,
+			StartDate:      startDate,
+			DueDate:        dueDate,
 			DoneRatio:      args.DoneRatio,
 			EstimatedHours: args.EstimatedHours,
 			IsPrivate:      args.IsPrivate,
@@ -207,6 +279,7 @@ func handleCreateIssue(useCases *usecase.UseCases) func(ctx context.Context, req
 			CustomFields:   args.CustomFields,
 			Uploads:        args.Uploads,
 		}
+		names.apply(&req.ProjectID, &req.TrackerID, &req.StatusID, &req.PriorityID, &req.AssignedToID, &req.FixedVersionID)
 
 		result, err := useCases.Issue.CreateIssue(ctx, req)
 		if err != nil {
@@ -237,13 +310,21 @@ type UpdateIssueArgs struct {
 	ParentIssueID  int                   `json:"parent_issue_id,omitempty" jsonschema:"New parent issue ID (optional)"`
 	StartDate      string                `json:"start_date,omitempty" jsonschema:"New start date in YYYY-MM-DD format (optional)"`
 	DueDate        string                `json:"due_date,omitempty" jsonschema:"New due date in YYYY-MM-DD format (optional)"`
-	DoneRatio      int                   `json:"done_ratio,omitempty" jsonschema:"New done ratio 0-100 (optional)"`
-	IsPrivate      bool                  `json:"is_private,omitempty" jsonschema:"Whether the issue is private (optional)"`
+	DoneRatio      *int                  `json:"done_ratio,omitempty" jsonschema:"New done ratio 0-100, 0 is sent as given (optional)"`
+	IsPrivate      *bool                 `json:"is_private,omitempty" jsonschema:"Whether the issue is private, false is sent as given (optional)"`
 	EstimatedHours float64               `json:"estimated_hours,omitempty" jsonschema:"New estimated hours (optional)"`
 	Notes          string                `json:"notes,omitempty" jsonschema:"Update notes/comments (optional)"`
 	PrivateNotes   bool                  `json:"private_notes,omitempty" jsonschema:"Whether notes are private (optional)"`
 	CustomFields   []redmine.CustomField `json:"custom_fields,omitempty" jsonschema:"Custom field values (optional)"`
 	Uploads        []redmine.Upload      `json:"uploads,omitempty" jsonschema:"Upload tokens for file attachments (optional)"`
+	ClearFields    []string              `json:"clear_fields,omitempty" jsonschema:"Fields to unset, e.g. assigned_to_id, due_date, parent_issue_id, fixed_version_id (optional)"`
+	UpdatedOn      string                `json:"updated_on,omitempty" jsonschema:"The issue's updated_on as last read; the update is refused if the issue has changed since (optional)"`
+	Project        string                `json:"project,omitempty" jsonschema:"New project identifier or name, instead of project_id (optional)"`
+	Tracker        string                `json:"tracker,omitempty" jsonschema:"New tracker name, instead of tracker_id (optional)"`
+	Status         string                `json:"status,omitempty" jsonschema:"New status name, instead of status_id (optional)"`
+	Priority       string                `json:"priority,omitempty" jsonschema:"New priority name, instead of priority_id (optional)"`
+	AssignedTo     string                `json:"assigned_to,omitempty" jsonschema:"New assignee login, name or me, instead of assigned_to_id (optional)"`
+	FixedVersion   string                `json:"fixed_version,omitempty" jsonschema:"New target version name, instead of fixed_version_id (optional)"`
 }
 
 // UpdateIssueOutput defines output for updating an issue
@@ -253,6 +334,37 @@ type UpdateIssueOutput struct {
 
 func handleUpdateIssue(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args UpdateIssueArgs) (*mcp.CallToolResult, UpdateIssueOutput, error) {
 	return func(ctx context.Context, request *mcp.CallToolRequest, args UpdateIssueArgs) (*mcp.CallToolResult, UpdateIssueOutput, error) {
+		startDate, err := redmine.ParseDate(args.StartDate)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, UpdateIssueOutput{}, fmt.Errorf("invalid start_date: %w", err)
+		}
+		dueDate, err := redmine.ParseDate(args.DueDate)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, UpdateIssueOutput{}, fmt.Errorf("invalid due_date: %w", err)
+		}
+
+		names, err := resolveIssueNames(ctx, useCases.Resolver, issueNames{
+			project:      args.Project,
+			tracker:      args.Tracker,
+			status:       args.Status,
+			priority:     args.Priority,
+			assignedTo:   args.AssignedTo,
+			fixedVersion: args.FixedVersion,
+		}, func(ctx context.Context) (int, error) {
+			// Versions are looked up in the project the issue is in, or moves to
+			if args.ProjectID != 0 {
+				return args.ProjectID, nil
+			}
+			result, err := useCases.Issue.ShowIssue(ctx, args.ID, nil)
+			if err != nil {
+				return 0, err
+			}
+			return result.Issue.Project.ID, nil
+		})
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, UpdateIssueOutput{}, err
+		}
+
 		req := redmine.IssueUpdateRequest{
 			ProjectID:      args.ProjectID,
 			TrackerID:      args.TrackerID,
@@ -264,18 +376,37 @@ func handleUpdateIssue(useCases *usecase.UseCases) func(ctx context.Context, req
 			AssignedToID:   args.AssignedToID,
 			ParentIssueID:  args.ParentIssueID,
 			Description:    args.Description,
-			StartDate:      args.StartDate,
-			DueDate:        args.DueDate,
-			DoneRatio:      args.DoneRatio,
+			StartDate:      startDate,
+			DueDate:        dueDate,
 			EstimatedHours: args.EstimatedHours,
-			IsPrivate:      args.IsPrivate,
 			Notes:          args.Notes,
 			PrivateNotes:   args.PrivateNotes,
 			CustomFields:   args.CustomFields,
 			Uploads:        args.Uploads,
+			ClearFields:    args.ClearFields,
+		}
+		names.apply(&req.ProjectID, &req.TrackerID, &req.StatusID, &req.PriorityID, &req.AssignedToID, &req.FixedVersionID)
+		if args.DoneRatio != nil {
+			req.DoneRatio = *args.DoneRatio
+			req.ForceSendFields = append(req.ForceSendFields, "done_ratio")
+		}
+		if args.IsPrivate != nil {
+			req.IsPrivate = *args.IsPrivate
+			req.ForceSendFields = append(req.ForceSendFields, "is_private")
 		}
 
-		err := useCases.Issue.UpdateIssue(ctx, args.ID, req)
+		if args.UpdatedOn != "" {
+			updatedOn, err := redmine.ParseTimestamp(args.UpdatedOn)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true}, UpdateIssueOutput{}, fmt.Errorf("invalid updated_on: %w", err)
+			}
+			err = useCases.Issue.UpdateIssueIfUnmodified(ctx, args.ID, updatedOn, req)
+		} else {
+			err = useCases.Issue.UpdateIssue(ctx, args.ID, req)
+		}
+		if errors.Is(err, redmine.ErrConflict) {
+			return &mcp.CallToolResult{IsError: true}, UpdateIssueOutput{}, fmt.Errorf("%w; fetch the issue again and reapply the change", err)
+		}
 		if err != nil {
 			return &mcp.CallToolResult{IsError: true}, UpdateIssueOutput{}, fmt.Errorf("failed to update issue: %w", err)
 		}
@@ -284,6 +415,78 @@ func handleUpdateIssue(useCases *usecase.UseCases) func(ctx context.Context, req
 	}
 }
 
+// issueNames holds issue attributes given by name instead of ID.
+type issueNames struct {
+	project, tracker, status, priority, assignedTo, fixedVersion string
+}
+
+// issueIDs holds the IDs resolved from issueNames, zero for the attributes not given.
+type issueIDs struct {
+	project, tracker, status, priority, assignedTo, fixedVersion int
+}
+
+// resolveIssueNames resolves the attributes given by name. Versions are
+// resolved in the project given by name, or else in the one returned by
+// projectID.
+func resolveIssueNames(ctx context.Context, resolver *redmine.Resolver, names issueNames, projectID func(context.Context) (int, error)) (issueIDs, error) {
+	var ids issueIDs
+	var err error
+	for _, r := range []struct {
+		arg     string
+		name    string
+		id      *int
+		resolve func(context.Context, string) (int, error)
+	}{
+		{"project", names.project, &ids.project, resolver.ProjectID},
+		{"tracker", names.tracker, &ids.tracker, resolver.TrackerID},
+		{"status", names.status, &ids.status, resolver.StatusID},
+		{"priority", names.priority, &ids.priority, resolver.PriorityID},
+		{"assigned_to", names.assignedTo, &ids.assignedTo, resolver.UserID},
+	} {
+		if r.name == "" {
+			continue
+		}
+		if *r.id, err = r.resolve(ctx, r.name); err != nil {
+			return issueIDs{}, fmt.Errorf("invalid %s: %w", r.arg, err)
+		}
+	}
+
+	if names.fixedVersion != "" {
+		project := ids.project
+		if project == 0 && projectID != nil {
+			if project, err = projectID(ctx); err != nil {
+				return issueIDs{}, fmt.Errorf("invalid fixed_version: %w", err)
+			}
+		}
+		if project == 0 {
+			return issueIDs{}, errors.New("invalid fixed_version: a project is required to look up versions by name")
+		}
+		if ids.fixedVersion, err = resolver.VersionID(ctx, strconv.Itoa(project), names.fixedVersion); err != nil {
+			return issueIDs{}, fmt.Errorf("invalid fixed_version: %w", err)
+		}
+	}
+	return ids, nil
+}
+
+// apply sets the IDs that were resolved, keeping the others.
+func (ids issueIDs) apply(project, tracker, status, priority, assignedTo, fixedVersion *int) {
+	for _, f := range []struct {
+		id  int
+		dst *int
+	}{
+		{ids.project, project},
+		{ids.tracker, tracker},
+		{ids.status, status},
+		{ids.priority, priority},
+		{ids.assignedTo, assignedTo},
+		{ids.fixedVersion, fixedVersion},
+	} {
+		if f.id != 0 {
+			*f.dst = f.id
+		}
+	}
+}
+
 // DeleteIssueArgs defines arguments for deleting an issue
 type DeleteIssueArgs struct {
 	ID int `json:"id" jsonschema:"Issue ID"`
@@ -20,14 +20,23 @@ func RegisterJournalTools(server *mcp.Server, useCases *usecase.UseCases, cfg *c
 	if cfg.IsToolEnabled(toolGroup, "show_journal") {
 		mcp.AddTool(server, &mcp.Tool{
 			Name:        "show_journal",
-			Description: "Get details of a specific journal by ID. Note: Journals are typically accessed through issues with include=journals parameter.",
+			Description: "Get details of a specific journal by ID. Set formatted to render changes as readable lines such as 'Status changed from New to In Progress'. Note: Journals are typically accessed through issues with include=journals parameter.",
 		}, handleShowJournal(useCases))
 	}
+
+	// Get Issue Log tool
+	if cfg.IsToolEnabled(toolGroup, "get_issue_log") {
+		mcp.AddTool(server, &mcp.Tool{
+			Name:        "get_issue_log",
+			Description: "Get the change history of an issue as a timeline, with IDs resolved to names (statuses, users, versions, custom fields, etc.).",
+		}, handleGetIssueLog(useCases))
+	}
 }
 
 // ShowJournalArgs defines arguments for showing a journal
 type ShowJournalArgs struct {
-	ID int `json:"id" jsonschema:"Journal ID (required)"`
+	ID        int  `json:"id" jsonschema:"Journal ID (required)"`
+	Formatted bool `json:"formatted,omitempty" jsonschema:"Render changes as readable lines with IDs resolved to names"`
 }
 
 // ShowJournalOutput defines output for showing a journal
@@ -37,7 +46,13 @@ type ShowJournalOutput struct {
 
 func handleShowJournal(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args ShowJournalArgs) (*mcp.CallToolResult, ShowJournalOutput, error) {
 	return func(ctx context.Context, request *mcp.CallToolRequest, args ShowJournalArgs) (*mcp.CallToolResult, ShowJournalOutput, error) {
-		result, err := useCases.Journal.ShowJournal(ctx, args.ID)
+		var result any
+		var err error
+		if args.Formatted {
+			result, err = useCases.Journal.ShowFormattedJournal(ctx, args.ID)
+		} else {
+			result, err = useCases.Journal.ShowJournal(ctx, args.ID)
+		}
 		if err != nil {
 			return &mcp.CallToolResult{IsError: true}, ShowJournalOutput{}, fmt.Errorf("failed to show journal: %w", err)
 		}
@@ -50,3 +65,29 @@ func handleShowJournal(useCases *usecase.UseCases) func(ctx context.Context, req
 		return nil, ShowJournalOutput{Result: string(jsonData)}, nil
 	}
 }
+
+// GetIssueLogArgs defines arguments for getting the change history of an issue
+type GetIssueLogArgs struct {
+	IssueID int `json:"issue_id" jsonschema:"Issue ID (required)"`
+}
+
+// GetIssueLogOutput defines output for getting the change history of an issue
+type GetIssueLogOutput struct {
+	Result string `json:"result" jsonschema:"JSON formatted list of journals with readable changes"`
+}
+
+func handleGetIssueLog(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args GetIssueLogArgs) (*mcp.CallToolResult, GetIssueLogOutput, error) {
+	return func(ctx context.Context, request *mcp.CallToolRequest, args GetIssueLogArgs) (*mcp.CallToolResult, GetIssueLogOutput, error) {
+		entries, err := useCases.Journal.GetIssueLog(ctx, args.IssueID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, GetIssueLogOutput{}, fmt.Errorf("failed to get issue log: %w", err)
+		}
+
+		jsonData, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, GetIssueLogOutput{}, fmt.Errorf("failed to marshal response: %w", err)
+		}
+
+		return nil, GetIssueLogOutput{Result: string(jsonData)}, nil
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/kqns91/redmine-go/internal/config"
 	"github.com/kqns91/redmine-go/internal/usecase"
+	"github.com/kqns91/redmine-go/pkg/issuegraph"
 	"github.com/kqns91/redmine-go/pkg/redmine"
 )
 
@@ -22,16 +23,16 @@ func RegisterProgressMonitoringTools(server *mcp.Server, useCases *usecase.UseCa
 	if cfg.IsToolEnabled(toolGroup, "analyze_project_health") {
 		mcp.AddTool(server, &mcp.Tool{
 			Name:        "analyze_project_health",
-			Description: "Analyze project health by checking issue progress, delays, and critical path status. Returns summary statistics and lists of at-risk and delayed issues.",
-		}, handleAnalyzeProjectHealth(useCases))
+			Description: "Analyze project health by checking issue progress, delays, and critical path status. The critical path is computed with the Critical Path Method over blocks, precedes and parent/child relations, using remaining estimated hours. Returns summary statistics, lists of at-risk and delayed issues, and any dependency cycles.",
+		}, handleAnalyzeProjectHealth(useCases.RedmineClient))
 	}
 
 	// Suggest Reschedule tool
 	if cfg.IsToolEnabled(toolGroup, "suggest_reschedule") {
 		mcp.AddTool(server, &mcp.Tool{
 			Name:        "suggest_reschedule",
-			Description: "Suggest rescheduling for delayed tasks based on dependencies and current progress. Optionally apply the suggested changes automatically.",
-		}, handleSuggestReschedule(useCases))
+			Description: "Suggest rescheduling for tasks that cannot finish by their due date, based on remaining work and on the tasks they depend on (blocks and precedes relations). Optionally apply the suggested changes automatically.",
+		}, handleSuggestReschedule(useCases.RedmineClient))
 	}
 
 	// Adjust Estimates tool
@@ -39,7 +40,7 @@ func RegisterProgressMonitoringTools(server *mcp.Server, useCases *usecase.UseCa
 		mcp.AddTool(server, &mcp.Tool{
 			Name:        "adjust_estimates",
 			Description: "Adjust estimated hours based on actual time entries and current progress. Provides forecasted completion dates.",
-		}, handleAdjustEstimates(useCases))
+		}, handleAdjustEstimates(useCases.RedmineClient))
 	}
 }
 
@@ -57,6 +58,7 @@ type ProjectHealthResult struct {
 	AtRiskIssues    []IssueHealth        `json:"at_risk_issues"`
 	OnTrackIssues   []IssueHealth        `json:"on_track_issues"`
 	CriticalPath    []IssueHealth        `json:"critical_path"`
+	Cycles          [][]int              `json:"cycles,omitempty"`
 	Recommendations []string             `json:"recommendations"`
 }
 
@@ -87,12 +89,13 @@ type IssueHealth struct {
 	SpentHours     float64 `json:"spent_hours,omitempty"`
 	DelayDays      int     `json:"delay_days"`
 	IsCriticalPath bool    `json:"is_critical_path"`
+	SlackDays      int     `json:"slack_days"`
 	BlockedBy      []int   `json:"blocked_by,omitempty"`
 	Blocks         []int   `json:"blocks,omitempty"`
 	ImpactLevel    string  `json:"impact_level"` // "critical", "high", "medium", "low"
 }
 
-func handleAnalyzeProjectHealth(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args AnalyzeProjectHealthArgs) (*mcp.CallToolResult, ProjectHealthResult, error) {
+func handleAnalyzeProjectHealth(client redmine.IssuesService) func(ctx context.Context, request *mcp.CallToolRequest, args AnalyzeProjectHealthArgs) (*mcp.CallToolResult, ProjectHealthResult, error) {
 	return func(ctx context.Context, request *mcp.CallToolRequest, args AnalyzeProjectHealthArgs) (*mcp.CallToolResult, ProjectHealthResult, error) {
 		if args.ProjectID == 0 {
 			return nil, ProjectHealthResult{}, errors.New("project_id is required")
@@ -106,15 +109,15 @@ func handleAnalyzeProjectHealth(useCases *usecase.UseCases) func(ctx context.Con
 		// Fetch all issues for the project
 		listOpts := &redmine.ListIssuesOptions{
 			ProjectID: args.ProjectID,
-			Limit:     1000, // Fetch a large number
+			Include:   "relations",
 		}
 
-		issuesResp, err := useCases.RedmineClient.ListIssues(ctx, listOpts)
+		issues, err := client.ListAllIssues(ctx, listOpts)
 		if err != nil {
 			return nil, ProjectHealthResult{}, fmt.Errorf("failed to list issues: %w", err)
 		}
 
-		result := analyzeIssues(issuesResp.Issues, args.ThresholdDays)
+		result := analyzeIssues(issues, args.ThresholdDays)
 
 		return nil, *result, nil
 	}
@@ -136,8 +139,19 @@ func analyzeIssues(issues []redmine.Issue, thresholdDays int) *ProjectHealthResu
 	totalDelay := 0
 	delayCount := 0
 
+	// Schedule the remaining work from today to find the critical path
+	graph := issuegraph.New(issues)
+	schedule, err := graph.Schedule(&issuegraph.ScheduleOptions{Remaining: true})
+	var cycleErr *issuegraph.CycleError
+	if errors.As(err, &cycleErr) {
+		result.Cycles = cycleErr.Cycles
+	}
+	if schedule != nil {
+		result.Summary.EstimatedCompletion = schedule.Finish.String()
+	}
+
 	for _, issue := range issues {
-		health := analyzeIssueHealth(issue, now, thresholdDays)
+		health := analyzeIssueHealth(issue, now, thresholdDays, graph, schedule)
 
 		// Categorize
 		switch {
@@ -153,11 +167,6 @@ func analyzeIssues(issues []redmine.Issue, thresholdDays int) *ProjectHealthResu
 			result.OnTrackIssues = append(result.OnTrackIssues, health)
 		}
 
-		// Track critical path (issues with blocks relations)
-		if len(health.Blocks) > 0 {
-			result.CriticalPath = append(result.CriticalPath, health)
-		}
-
 		// Aggregate time
 		result.Summary.TotalEstimatedTime += health.EstimatedHours
 		// Note: SpentHours needs to be fetched separately via TimeEntry API
@@ -187,6 +196,15 @@ func analyzeIssues(issues []redmine.Issue, thresholdDays int) *ProjectHealthResu
 		result.Summary.ProjectStatus = "on_schedule"
 	}
 
+	// List the critical path in schedule order
+	if schedule != nil {
+		for _, id := range schedule.CriticalPath {
+			if issue, ok := graph.Issue(id); ok && issue.DoneRatio < 100 {
+				result.CriticalPath = append(result.CriticalPath, analyzeIssueHealth(issue, now, thresholdDays, graph, schedule))
+			}
+		}
+	}
+
 	// Generate recommendations
 	result.Recommendations = generateRecommendations(result)
 
@@ -198,13 +216,13 @@ func analyzeIssues(issues []redmine.Issue, thresholdDays int) *ProjectHealthResu
 	return result
 }
 
-func analyzeIssueHealth(issue redmine.Issue, now time.Time, thresholdDays int) IssueHealth {
+func analyzeIssueHealth(issue redmine.Issue, now time.Time, thresholdDays int, graph *issuegraph.Graph, schedule *issuegraph.Schedule) IssueHealth {
 	health := IssueHealth{
 		ID:             issue.ID,
 		Subject:        issue.Subject,
 		Status:         issue.Status.Name,
-		StartDate:      issue.StartDate,
-		DueDate:        issue.DueDate,
+		StartDate:      issue.StartDate.String(),
+		DueDate:        issue.DueDate.String(),
 		DoneRatio:      issue.DoneRatio,
 		EstimatedHours: issue.EstimatedHours,
 		SpentHours:     0, // Note: Would need to fetch from TimeEntry API
@@ -216,24 +234,20 @@ func analyzeIssueHealth(issue redmine.Issue, now time.Time, thresholdDays int) I
 	}
 
 	// Calculate delay
-	if issue.DueDate != "" && issue.DoneRatio < 100 {
-		dueDate, err := time.Parse("2006-01-02", issue.DueDate)
-		if err == nil {
-			delay := int(now.Sub(dueDate).Hours() / 24)
-			if delay > 0 {
-				health.DelayDays = delay
-			}
+	if !issue.DueDate.IsZero() && issue.DoneRatio < 100 {
+		delay := int(now.Sub(issue.DueDate.Time).Hours() / 24)
+		if delay > 0 {
+			health.DelayDays = delay
 		}
 	}
 
-	// Analyze relations
-	for _, rel := range issue.Relations {
-		switch rel.RelationType {
-		case "blocks":
-			health.Blocks = append(health.Blocks, rel.IssueToID)
-			health.IsCriticalPath = true
-		case "blocked":
-			health.BlockedBy = append(health.BlockedBy, rel.IssueID)
+	// Analyze dependencies
+	health.BlockedBy = append(health.BlockedBy, graph.Predecessors(issue.ID)...)
+	health.Blocks = append(health.Blocks, graph.Successors(issue.ID)...)
+	if schedule != nil {
+		if task, ok := schedule.Task(issue.ID); ok {
+			health.IsCriticalPath = task.Critical && !task.Parent
+			health.SlackDays = task.Slack
 		}
 	}
 
@@ -269,6 +283,10 @@ func generateRecommendations(result *ProjectHealthResult) []string {
 		}
 	}
 
+	if len(result.Cycles) > 0 {
+		recommendations = append(recommendations, fmt.Sprintf("🔁 %d dependency cycles make the schedule impossible: %v. Remove one relation in each cycle.", len(result.Cycles), result.Cycles))
+	}
+
 	if result.Summary.TotalSpentTime > result.Summary.TotalEstimatedTime*1.2 {
 		recommendations = append(recommendations, "⏱️ Actual time spent exceeds estimates by 20%+. Consider revising estimates for remaining tasks.")
 	}
@@ -307,7 +325,7 @@ type RescheduleRecommendation struct {
 	CascadeImpact      []int  `json:"cascade_impact,omitempty"` // IDs of issues affected
 }
 
-func handleSuggestReschedule(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args SuggestRescheduleArgs) (*mcp.CallToolResult, RescheduleResult, error) {
+func handleSuggestReschedule(client redmine.IssuesService) func(ctx context.Context, request *mcp.CallToolRequest, args SuggestRescheduleArgs) (*mcp.CallToolResult, RescheduleResult, error) {
 	return func(ctx context.Context, request *mcp.CallToolRequest, args SuggestRescheduleArgs) (*mcp.CallToolResult, RescheduleResult, error) {
 		if args.ProjectID == 0 {
 			return nil, RescheduleResult{}, errors.New("project_id is required")
@@ -321,24 +339,29 @@ func handleSuggestReschedule(useCases *usecase.UseCases) func(ctx context.Contex
 		// Fetch all issues
 		listOpts := &redmine.ListIssuesOptions{
 			ProjectID: args.ProjectID,
-			Limit:     1000,
+			Include:   "relations",
 		}
 
-		issuesResp, err := useCases.RedmineClient.ListIssues(ctx, listOpts)
+		issues, err := client.ListAllIssues(ctx, listOpts)
 		if err != nil {
 			return nil, RescheduleResult{}, fmt.Errorf("failed to list issues: %w", err)
 		}
 
-		result := generateRescheduleRecommendations(issuesResp.Issues, args.BufferDays, args.OnlyCriticalPath)
+		result := generateRescheduleRecommendations(issues, args.BufferDays, args.OnlyCriticalPath)
 
 		// Apply if requested
 		if args.AutoApply {
 			result.Applied = true
 			for _, rec := range result.Recommendations {
+				dueDate, err := redmine.ParseDate(rec.RecommendedDueDate)
+				if err != nil {
+					result.Errors = append(result.Errors, fmt.Sprintf("Failed to update issue #%d: %v", rec.IssueID, err))
+					continue
+				}
 				req := redmine.IssueUpdateRequest{
-					DueDate: rec.RecommendedDueDate,
+					DueDate: dueDate,
 				}
-				if err := useCases.RedmineClient.UpdateIssue(ctx, rec.IssueID, req); err != nil {
+				if err := client.UpdateIssue(ctx, rec.IssueID, req); err != nil {
 					result.Errors = append(result.Errors, fmt.Sprintf("Failed to update issue #%d: %v", rec.IssueID, err))
 				}
 			}
@@ -355,66 +378,61 @@ func generateRescheduleRecommendations(issues []redmine.Issue, bufferDays int, o
 		Errors:          []string{},
 	}
 
-	now := time.Now()
+	// Forecast the remaining work from today, following dependencies
+	graph := issuegraph.New(issues)
+	schedule, err := graph.Schedule(&issuegraph.ScheduleOptions{Remaining: true})
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Cannot schedule issues: %v", err))
+		return result
+	}
 
-	for _, issue := range issues {
-		if issue.DoneRatio == 100 {
-			continue // Skip completed issues
+	today := redmine.Today()
+
+	for _, task := range schedule.Tasks {
+		issue, _ := graph.Issue(task.IssueID)
+		if issue.DoneRatio == 100 || task.Parent {
+			continue // Skip completed issues and parents, whose dates follow their subtasks
 		}
 
-		if issue.DueDate == "" {
+		if issue.DueDate.IsZero() {
 			continue // Skip issues without due dates
 		}
 
-		dueDate, err := time.Parse("2006-01-02", issue.DueDate)
-		if err != nil {
-			continue
+		if !task.EarliestFinish.After(issue.DueDate.Time) {
+			continue // Can finish on time
 		}
 
-		delay := int(now.Sub(dueDate).Hours() / 24)
-		if delay <= 0 {
-			continue // Not delayed
+		if onlyCriticalPath && !task.Critical {
+			continue
 		}
 
-		// Check if on critical path
-		isOnCriticalPath := false
-		cascadeImpact := []int{}
-		for _, rel := range issue.Relations {
-			if rel.RelationType == "blocks" {
-				isOnCriticalPath = true
-				cascadeImpact = append(cascadeImpact, rel.IssueToID)
-			}
+		var reason string
+		if delay := int(today.Sub(issue.DueDate.Time).Hours() / 24); delay > 0 {
+			reason = fmt.Sprintf("Delayed by %d days", delay)
+		} else {
+			reason = fmt.Sprintf("Forecast to finish %d days late", int(task.EarliestFinish.Sub(issue.DueDate.Time).Hours()/24))
 		}
-
-		if onlyCriticalPath && !isOnCriticalPath {
-			continue
+		if predecessors := graph.Predecessors(issue.ID); len(predecessors) > 0 && task.EarliestStart.After(today.Time) {
+			reason += fmt.Sprintf(", waiting for %v", predecessors)
 		}
-
-		// Calculate new due date
-		newDueDate := now.AddDate(0, 0, bufferDays)
-		if issue.EstimatedHours > 0 {
-			// Add days based on estimated hours (assuming 8h workday)
-			remainingDays := int((issue.EstimatedHours * (1 - float64(issue.DoneRatio)/100)) / 8)
-			newDueDate = now.AddDate(0, 0, remainingDays+bufferDays)
+		if task.Critical {
+			reason += " (critical path)"
 		}
 
-		rec := RescheduleRecommendation{
+		result.Recommendations = append(result.Recommendations, RescheduleRecommendation{
 			IssueID:            issue.ID,
 			Subject:            issue.Subject,
-			CurrentDueDate:     issue.DueDate,
-			RecommendedDueDate: newDueDate.Format("2006-01-02"),
-			Reason:             fmt.Sprintf("Delayed by %d days", delay),
-			CascadeImpact:      cascadeImpact,
-		}
-
-		if isOnCriticalPath {
-			rec.Reason += " (critical path)"
-		}
-
-		result.Recommendations = append(result.Recommendations, rec)
+			CurrentDueDate:     issue.DueDate.String(),
+			RecommendedDueDate: task.EarliestFinish.AddDays(bufferDays).String(),
+			Reason:             reason,
+			CascadeImpact:      graph.Dependents(issue.ID),
+		})
 	}
 
 	result.TotalAffectedIssues = len(result.Recommendations)
+	if result.TotalAffectedIssues > 0 {
+		result.NewProjectCompletion = schedule.Finish.AddDays(bufferDays).String()
+	}
 
 	return result
 }
@@ -450,14 +468,14 @@ type ChildEstimateSummary struct {
 	EfficiencyRatio          float64 `json:"efficiency_ratio"`
 }
 
-func handleAdjustEstimates(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args AdjustEstimatesArgs) (*mcp.CallToolResult, EstimateAdjustmentResult, error) {
+func handleAdjustEstimates(client redmine.IssuesService) func(ctx context.Context, request *mcp.CallToolRequest, args AdjustEstimatesArgs) (*mcp.CallToolResult, EstimateAdjustmentResult, error) {
 	return func(ctx context.Context, request *mcp.CallToolRequest, args AdjustEstimatesArgs) (*mcp.CallToolResult, EstimateAdjustmentResult, error) {
 		if args.IssueID == 0 {
 			return nil, EstimateAdjustmentResult{}, errors.New("issue_id is required")
 		}
 
 		// Fetch issue details
-		issueResp, err := useCases.RedmineClient.ShowIssue(ctx, args.IssueID, nil)
+		issueResp, err := client.ShowIssue(ctx, args.IssueID, nil)
 		if err != nil {
 			return nil, EstimateAdjustmentResult{}, fmt.Errorf("failed to fetch issue: %w", err)
 		}
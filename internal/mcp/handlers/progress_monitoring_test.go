@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+	"github.com/kqns91/redmine-go/pkg/redminemock"
+)
+
+// projectIssues returns a late issue blocking an open one, and a closed issue.
+func projectIssues() []redmine.Issue {
+	today := redmine.Today()
+	return []redmine.Issue{
+		{
+			ID: 1, Subject: "Late", DueDate: today.AddDays(-10), EstimatedHours: 16,
+			Relations: []redmine.IssueRelation{{IssueID: 1, IssueToID: 2, RelationType: redmine.RelationBlocks}},
+		},
+		{ID: 2, Subject: "Blocked", DueDate: today.AddDays(30), EstimatedHours: 8},
+		{ID: 3, Subject: "Done", DueDate: today.AddDays(-20), DoneRatio: 100},
+	}
+}
+
+func TestHandleAnalyzeProjectHealth(t *testing.T) {
+	issues := redminemock.NewMockIssuesService(gomock.NewController(t))
+	ctx := context.Background()
+	issues.EXPECT().ListAllIssues(ctx, &redmine.ListIssuesOptions{ProjectID: 1, Include: "relations"}).Return(projectIssues(), nil)
+
+	_, result, err := handleAnalyzeProjectHealth(issues)(ctx, nil, AnalyzeProjectHealthArgs{ProjectID: 1})
+	if err != nil {
+		t.Fatalf("handleAnalyzeProjectHealth failed: %v", err)
+	}
+
+	if result.Summary.TotalIssues != 3 || result.Summary.Delayed != 1 || result.Summary.OnTrack != 1 || result.Summary.Completed != 1 {
+		t.Errorf("Expected 1 delayed, 1 on track and 1 completed issue, got %+v", result.Summary)
+	}
+	if result.Summary.ProjectStatus != "delayed" {
+		t.Errorf("Expected a delayed project, got %s", result.Summary.ProjectStatus)
+	}
+	if len(result.DelayedIssues) != 1 || result.DelayedIssues[0].ID != 1 || result.DelayedIssues[0].DelayDays != 10 {
+		t.Errorf("Expected issue 1 to be 10 days late, got %+v", result.DelayedIssues)
+	}
+	if len(result.CriticalPath) != 2 || result.CriticalPath[0].ID != 1 || result.CriticalPath[1].ID != 2 {
+		t.Errorf("Expected the critical path [1 2], got %+v", result.CriticalPath)
+	}
+	if result.DelayedIssues[0].ImpactLevel != "critical" {
+		t.Errorf("Expected a critical impact, got %s", result.DelayedIssues[0].ImpactLevel)
+	}
+}
+
+func TestHandleAnalyzeProjectHealthError(t *testing.T) {
+	issues := redminemock.NewMockIssuesService(gomock.NewController(t))
+	issues.EXPECT().ListAllIssues(gomock.Any(), gomock.Any()).Return(nil, redmine.ErrForbidden)
+
+	_, _, err := handleAnalyzeProjectHealth(issues)(context.Background(), nil, AnalyzeProjectHealthArgs{ProjectID: 1})
+	if !errors.Is(err, redmine.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestHandleSuggestReschedule(t *testing.T) {
+	tests := []struct {
+		name      string
+		autoApply bool
+		updateErr error
+		updates   int
+		errors    int
+	}{
+		{name: "suggest only"},
+		{name: "apply", autoApply: true, updates: 1},
+		{name: "apply fails", autoApply: true, updateErr: redmine.ErrForbidden, updates: 1, errors: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := redminemock.NewMockIssuesService(gomock.NewController(t))
+			ctx := context.Background()
+			issues.EXPECT().ListAllIssues(ctx, &redmine.ListIssuesOptions{ProjectID: 1, Include: "relations"}).Return(projectIssues(), nil)
+
+			var applied redmine.Date
+			issues.EXPECT().UpdateIssue(ctx, 1, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ int, req redmine.IssueUpdateRequest) error {
+					applied = req.DueDate
+					return tt.updateErr
+				}).Times(tt.updates)
+
+			args := SuggestRescheduleArgs{ProjectID: 1, AutoApply: tt.autoApply, BufferDays: 1}
+			_, result, err := handleSuggestReschedule(issues)(ctx, nil, args)
+			if err != nil {
+				t.Fatalf("handleSuggestReschedule failed: %v", err)
+			}
+
+			// Issue 1 takes two days from today; issue 2 still fits before its due date
+			if len(result.Recommendations) != 1 {
+				t.Fatalf("Expected 1 recommendation, got %+v", result.Recommendations)
+			}
+			rec := result.Recommendations[0]
+			if want := redmine.Today().AddDays(2).String(); rec.IssueID != 1 || rec.RecommendedDueDate != want {
+				t.Errorf("Expected issue 1 to be due %s, got %+v", want, rec)
+			}
+			if len(rec.CascadeImpact) != 1 || rec.CascadeImpact[0] != 2 {
+				t.Errorf("Expected issue 2 to be affected, got %v", rec.CascadeImpact)
+			}
+			if result.Applied != tt.autoApply {
+				t.Errorf("Expected applied %v, got %v", tt.autoApply, result.Applied)
+			}
+			if tt.updates > 0 && applied.String() != rec.RecommendedDueDate {
+				t.Errorf("Expected the update to set %s, got %s", rec.RecommendedDueDate, applied)
+			}
+			if len(result.Errors) != tt.errors {
+				t.Errorf("Expected %d errors, got %v", tt.errors, result.Errors)
+			}
+		})
+	}
+}
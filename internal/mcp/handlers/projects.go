@@ -206,15 +206,16 @@ type UpdateProjectArgs struct {
 	Name                string            `json:"name,omitempty" jsonschema:"New project name (optional)"`
 	Description         string            `json:"description,omitempty" jsonschema:"New project description (optional)"`
 	Homepage            string            `json:"homepage,omitempty" jsonschema:"New homepage URL (optional)"`
-	IsPublic            bool              `json:"is_public,omitempty" jsonschema:"Whether the project is public (optional)"`
+	IsPublic            *bool             `json:"is_public,omitempty" jsonschema:"Whether the project is public, false is sent as given (optional)"`
 	ParentID            int               `json:"parent_id,omitempty" jsonschema:"New parent project ID (optional)"`
-	InheritMembers      bool              `json:"inherit_members,omitempty" jsonschema:"Inherit members from parent project (optional)"`
+	InheritMembers      *bool             `json:"inherit_members,omitempty" jsonschema:"Inherit members from parent project, false is sent as given (optional)"`
 	DefaultAssignedToID int               `json:"default_assigned_to_id,omitempty" jsonschema:"Default assignee user ID (optional)"`
 	DefaultVersionID    int               `json:"default_version_id,omitempty" jsonschema:"Default version ID (optional)"`
 	TrackerIDs          []int             `json:"tracker_ids,omitempty" jsonschema:"Tracker IDs to enable (optional)"`
 	EnabledModuleNames  []string          `json:"enabled_module_names,omitempty" jsonschema:"Module names to enable (optional)"`
 	IssueCustomFieldIDs []int             `json:"issue_custom_field_ids,omitempty" jsonschema:"Issue custom field IDs (optional)"`
 	CustomFieldValues   map[string]string `json:"custom_field_values,omitempty" jsonschema:"Custom field values as key-value pairs (optional)"`
+	ClearFields         []string          `json:"clear_fields,omitempty" jsonschema:"Fields to unset, e.g. parent_id, homepage, default_assigned_to_id (optional)"`
 }
 
 // UpdateProjectOutput defines output for updating a project
@@ -228,15 +229,22 @@ func handleUpdateProject(useCases *usecase.UseCases) func(ctx context.Context, r
 			Name:                args.Name,
 			Description:         args.Description,
 			Homepage:            args.Homepage,
-			IsPublic:            args.IsPublic,
 			ParentID:            args.ParentID,
-			InheritMembers:      args.InheritMembers,
 			DefaultAssignedToID: args.DefaultAssignedToID,
 			DefaultVersionID:    args.DefaultVersionID,
 			TrackerIDs:          args.TrackerIDs,
 			EnabledModuleNames:  args.EnabledModuleNames,
 			IssueCustomFieldIDs: args.IssueCustomFieldIDs,
 			CustomFieldValues:   args.CustomFieldValues,
+			ClearFields:         args.ClearFields,
+		}
+		if args.IsPublic != nil {
+			req.IsPublic = *args.IsPublic
+			req.ForceSendFields = append(req.ForceSendFields, "is_public")
+		}
+		if args.InheritMembers != nil {
+			req.InheritMembers = *args.InheritMembers
+			req.ForceSendFields = append(req.ForceSendFields, "inherit_members")
 		}
 
 		err := useCases.Project.UpdateProject(ctx, args.ID, req)
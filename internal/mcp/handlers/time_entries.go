@@ -148,13 +148,18 @@ type CreateTimeEntryOutput struct {
 
 func handleCreateTimeEntry(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args CreateTimeEntryArgs) (*mcp.CallToolResult, CreateTimeEntryOutput, error) {
 	return func(ctx context.Context, request *mcp.CallToolRequest, args CreateTimeEntryArgs) (*mcp.CallToolResult, CreateTimeEntryOutput, error) {
+		spentOn, err := redmine.ParseDate(args.SpentOn)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, CreateTimeEntryOutput{}, fmt.Errorf("invalid spent_on: %w", err)
+		}
+
 		req := redmine.TimeEntryCreateRequest{
 			IssueID:      args.IssueID,
 			ProjectID:    args.ProjectID,
 			Hours:        args.Hours,
 			ActivityID:   args.ActivityID,
 			Comments:     args.Comments,
-			SpentOn:      args.SpentOn,
+			SpentOn:      spentOn,
 			UserID:       args.UserID,
 			CustomFields: args.CustomFields,
 		}
@@ -184,6 +189,7 @@ type UpdateTimeEntryArgs struct {
 	SpentOn      string                `json:"spent_on,omitempty" jsonschema:"New date (YYYY-MM-DD, optional)"`
 	UserID       int                   `json:"user_id,omitempty" jsonschema:"New user ID (optional)"`
 	CustomFields []redmine.CustomField `json:"custom_fields,omitempty" jsonschema:"Custom field values (optional)"`
+	ClearFields  []string              `json:"clear_fields,omitempty" jsonschema:"Fields to unset, e.g. comments (optional)"`
 }
 
 // UpdateTimeEntryOutput defines output for updating a time entry
@@ -193,18 +199,24 @@ type UpdateTimeEntryOutput struct {
 
 func handleUpdateTimeEntry(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args UpdateTimeEntryArgs) (*mcp.CallToolResult, UpdateTimeEntryOutput, error) {
 	return func(ctx context.Context, request *mcp.CallToolRequest, args UpdateTimeEntryArgs) (*mcp.CallToolResult, UpdateTimeEntryOutput, error) {
+		spentOn, err := redmine.ParseDate(args.SpentOn)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, UpdateTimeEntryOutput{}, fmt.Errorf("invalid spent_on: %w", err)
+		}
+
 		req := redmine.TimeEntryUpdateRequest{
 			IssueID:      args.IssueID,
 			ProjectID:    args.ProjectID,
 			Hours:        args.Hours,
 			ActivityID:   args.ActivityID,
 			Comments:     args.Comments,
-			SpentOn:      args.SpentOn,
+			SpentOn:      spentOn,
 			UserID:       args.UserID,
 			CustomFields: args.CustomFields,
+			ClearFields:  args.ClearFields,
 		}
 
-		err := useCases.TimeEntry.UpdateTimeEntry(ctx, args.ID, req)
+		err = useCases.TimeEntry.UpdateTimeEntry(ctx, args.ID, req)
 		if err != nil {
 			return &mcp.CallToolResult{IsError: true}, UpdateTimeEntryOutput{}, fmt.Errorf("failed to update time entry: %w", err)
 		}
@@ -128,11 +128,16 @@ type CreateVersionOutput struct {
 
 func handleCreateVersion(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args CreateVersionArgs) (*mcp.CallToolResult, CreateVersionOutput, error) {
 	return func(ctx context.Context, request *mcp.CallToolRequest, args CreateVersionArgs) (*mcp.CallToolResult, CreateVersionOutput, error) {
+		dueDate, err := redmine.ParseDate(args.DueDate)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, CreateVersionOutput{}, fmt.Errorf("invalid due_date: %w", err)
+		}
+
 		version := redmine.Version{
 			Name:          args.Name,
 			Description:   args.Description,
 			Status:        args.Status,
-			DueDate:       args.DueDate,
+			DueDate:       dueDate,
 			Sharing:       args.Sharing,
 			WikiPageTitle: args.WikiPageTitle,
 		}
@@ -153,13 +158,14 @@ func handleCreateVersion(useCases *usecase.UseCases) func(ctx context.Context, r
 
 // UpdateVersionArgs defines arguments for updating a version
 type UpdateVersionArgs struct {
-	ID            int    `json:"id" jsonschema:"Version ID (required)"`
-	Name          string `json:"name,omitempty" jsonschema:"New version name (optional)"`
-	Description   string `json:"description,omitempty" jsonschema:"New version description (optional)"`
-	Status        string `json:"status,omitempty" jsonschema:"New status: open, locked, closed (optional)"`
-	DueDate       string `json:"due_date,omitempty" jsonschema:"New due date in YYYY-MM-DD format (optional)"`
-	Sharing       string `json:"sharing,omitempty" jsonschema:"New sharing: none, descendants, hierarchy, tree, system (optional)"`
-	WikiPageTitle string `json:"wiki_page_title,omitempty" jsonschema:"New wiki page title (optional)"`
+	ID            int      `json:"id" jsonschema:"Version ID (required)"`
+	Name          string   `json:"name,omitempty" jsonschema:"New version name (optional)"`
+	Description   string   `json:"description,omitempty" jsonschema:"New version description (optional)"`
+	Status        string   `json:"status,omitempty" jsonschema:"New status: open, locked, closed (optional)"`
+	DueDate       string   `json:"due_date,omitempty" jsonschema:"New due date in YYYY-MM-DD format (optional)"`
+	Sharing       string   `json:"sharing,omitempty" jsonschema:"New sharing: none, descendants, hierarchy, tree, system (optional)"`
+	WikiPageTitle string   `json:"wiki_page_title,omitempty" jsonschema:"New wiki page title (optional)"`
+	ClearFields   []string `json:"clear_fields,omitempty" jsonschema:"Fields to unset, e.g. due_date, description, wiki_page_title (optional)"`
 }
 
 // UpdateVersionOutput defines output for updating a version
@@ -169,16 +175,22 @@ type UpdateVersionOutput struct {
 
 func handleUpdateVersion(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args UpdateVersionArgs) (*mcp.CallToolResult, UpdateVersionOutput, error) {
 	return func(ctx context.Context, request *mcp.CallToolRequest, args UpdateVersionArgs) (*mcp.CallToolResult, UpdateVersionOutput, error) {
+		dueDate, err := redmine.ParseDate(args.DueDate)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true}, UpdateVersionOutput{}, fmt.Errorf("invalid due_date: %w", err)
+		}
+
 		version := redmine.Version{
 			Name:          args.Name,
 			Description:   args.Description,
 			Status:        args.Status,
-			DueDate:       args.DueDate,
+			DueDate:       dueDate,
 			Sharing:       args.Sharing,
 			WikiPageTitle: args.WikiPageTitle,
+			ClearFields:   args.ClearFields,
 		}
 
-		err := useCases.Version.UpdateVersion(ctx, args.ID, version)
+		err = useCases.Version.UpdateVersion(ctx, args.ID, version)
 		if err != nil {
 			return &mcp.CallToolResult{IsError: true}, UpdateVersionOutput{}, fmt.Errorf("failed to update version: %w", err)
 		}
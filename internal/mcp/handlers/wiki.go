@@ -37,7 +37,7 @@ func RegisterWikiTools(server *mcp.Server, useCases *usecase.UseCases, cfg *conf
 	if cfg.IsToolEnabled(toolGroup, "create_or_update_wiki_page") {
 		mcp.AddTool(server, &mcp.Tool{
 			Name:        "create_or_update_wiki_page",
-			Description: "Create or update a wiki page. If the page exists, it will be updated; otherwise, a new page is created.",
+			Description: "Create or update a wiki page. If the page exists, it will be updated; otherwise, a new page is created. Pass version to refuse the update if the page was changed since that version.",
 		}, handleCreateOrUpdateWikiPage(useCases))
 	}
 
@@ -113,12 +113,14 @@ func handleShowWikiPage(useCases *usecase.UseCases) func(ctx context.Context, re
 
 // CreateOrUpdateWikiPageArgs defines arguments for creating or updating a wiki page
 type CreateOrUpdateWikiPageArgs struct {
-	ProjectID string           `json:"project_id" jsonschema:"Project ID or identifier (required)"`
-	Title     string           `json:"title" jsonschema:"Wiki page title (required)"`
-	Text      string           `json:"text" jsonschema:"Wiki page content in textile or markdown format (required)"`
-	Comments  string           `json:"comments,omitempty" jsonschema:"Optional comments about the changes"`
-	Version   int              `json:"version,omitempty" jsonschema:"Version number for conflict detection (optional)"`
-	Uploads   []redmine.Upload `json:"uploads,omitempty" jsonschema:"Upload tokens for file attachments (optional)"`
+	ProjectID   string           `json:"project_id" jsonschema:"Project ID or identifier (required)"`
+	Title       string           `json:"title" jsonschema:"Wiki page title (required)"`
+	Text        string           `json:"text" jsonschema:"Wiki page content in textile or markdown format (required)"`
+	Comments    string           `json:"comments,omitempty" jsonschema:"Optional comments about the changes"`
+	Version     int              `json:"version,omitempty" jsonschema:"Version number for conflict detection (optional)"`
+	ParentTitle string           `json:"parent_title,omitempty" jsonschema:"Title of the parent page (optional)"`
+	Uploads     []redmine.Upload `json:"uploads,omitempty" jsonschema:"Upload tokens for file attachments (optional)"`
+	ClearFields []string         `json:"clear_fields,omitempty" jsonschema:"Fields to unset, e.g. parent_title to make it a root page (optional)"`
 }
 
 // CreateOrUpdateWikiPageOutput defines output for creating or updating a wiki page
@@ -129,10 +131,12 @@ type CreateOrUpdateWikiPageOutput struct {
 func handleCreateOrUpdateWikiPage(useCases *usecase.UseCases) func(ctx context.Context, request *mcp.CallToolRequest, args CreateOrUpdateWikiPageArgs) (*mcp.CallToolResult, CreateOrUpdateWikiPageOutput, error) {
 	return func(ctx context.Context, request *mcp.CallToolRequest, args CreateOrUpdateWikiPageArgs) (*mcp.CallToolResult, CreateOrUpdateWikiPageOutput, error) {
 		page := redmine.WikiPageUpdate{
-			Text:     args.Text,
-			Comments: args.Comments,
-			Version:  args.Version,
-			Uploads:  args.Uploads,
+			Text:        args.Text,
+			Comments:    args.Comments,
+			Version:     args.Version,
+			ParentTitle: args.ParentTitle,
+			Uploads:     args.Uploads,
+			ClearFields: args.ClearFields,
 		}
 
 		err := useCases.Wiki.CreateOrUpdateWikiPage(ctx, args.ProjectID, args.Title, page)
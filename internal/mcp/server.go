@@ -1,6 +1,9 @@
 package mcp
 
 import (
+	"log/slog"
+	"os"
+
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
 	"github.com/kqns91/redmine-go/internal/config"
@@ -12,11 +15,12 @@ import (
 // NewServer creates and initializes a new MCP server with all tools registered.
 func NewServer(cfg *config.Config) (*mcp.Server, error) {
 	// Create Redmine client
-	client := redmine.New(cfg.RedmineURL, cfg.APIKey)
+	client := redmine.New(cfg.RedmineURL, cfg.APIKey, clientOptions(cfg)...)
 
 	// Initialize use cases
 	useCases := &usecase.UseCases{
 		RedmineClient: client,
+		Resolver:      redmine.NewResolver(client),
 		Project:       usecase.NewProjectUseCase(client),
 		Issue:         usecase.NewIssueUseCase(client),
 		User:          usecase.NewUserUseCase(client),
@@ -38,6 +42,7 @@ func NewServer(cfg *config.Config) (*mcp.Server, error) {
 		Role:          usecase.NewRoleUseCase(client),
 		Enumeration:   usecase.NewEnumerationUseCase(client),
 		MyAccount:     usecase.NewMyAccountUseCase(client),
+		Activity:      usecase.NewActivityUseCase(client),
 	}
 
 	// Create MCP server
@@ -68,8 +73,41 @@ func NewServer(cfg *config.Config) (*mcp.Server, error) {
 	handlers.RegisterRoleTools(server, useCases, cfg)
 	handlers.RegisterEnumerationTools(server, useCases, cfg)
 	handlers.RegisterMyAccountTools(server, useCases, cfg)
+	handlers.RegisterActivityTools(server, useCases, cfg)
 	handlers.RegisterBatchOperationTools(server, useCases, cfg)
 	handlers.RegisterProgressMonitoringTools(server, useCases, cfg)
 
 	return server, nil
 }
+
+// clientOptions builds Redmine client options from the configuration.
+func clientOptions(cfg *config.Config) []redmine.Option {
+	opts := []redmine.Option{}
+	switch {
+	case cfg.Anonymous:
+		opts = append(opts, redmine.WithAuthenticator(redmine.AnonymousAuth()))
+	case cfg.Username != "":
+		opts = append(opts, redmine.WithAuthenticator(redmine.BasicAuth(cfg.Username, cfg.Password)))
+	}
+	if cfg.SwitchUser != "" {
+		opts = append(opts, redmine.WithSwitchUser(cfg.SwitchUser))
+	}
+	if cfg.FeedKey != "" {
+		opts = append(opts, redmine.WithFeedKey(cfg.FeedKey))
+	}
+	if cfg.Timeout > 0 {
+		opts = append(opts, redmine.WithTimeout(cfg.Timeout))
+	}
+	if cfg.CacheTTL > 0 {
+		opts = append(opts, redmine.WithCache(redmine.NewCache(&redmine.CacheOptions{TTL: cfg.CacheTTL})))
+	}
+	if cfg.UserAgent != "" {
+		opts = append(opts, redmine.WithUserAgent(cfg.UserAgent))
+	}
+	if cfg.Debug {
+		// stdout is reserved for the MCP stdio transport
+		logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		opts = append(opts, redmine.WithLogger(logger))
+	}
+	return opts
+}
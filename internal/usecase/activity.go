@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// ActivityUseCase provides business logic for activity operations.
+type ActivityUseCase struct {
+	client redmine.ActivityService
+}
+
+// NewActivityUseCase creates a new ActivityUseCase instance.
+func NewActivityUseCase(client redmine.ActivityService) *ActivityUseCase {
+	return &ActivityUseCase{
+		client: client,
+	}
+}
+
+// ListActivity retrieves the activity stream of Redmine or of a project.
+func (u *ActivityUseCase) ListActivity(ctx context.Context, opts *redmine.ListActivityOptions) ([]redmine.ActivityEvent, error) {
+	return u.client.ListActivity(ctx, opts)
+}
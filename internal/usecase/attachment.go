@@ -8,11 +8,11 @@ import (
 
 // AttachmentUseCase provides business logic for attachment operations.
 type AttachmentUseCase struct {
-	client *redmine.Client
+	client redmine.AttachmentsService
 }
 
 // NewAttachmentUseCase creates a new AttachmentUseCase instance.
-func NewAttachmentUseCase(client *redmine.Client) *AttachmentUseCase {
+func NewAttachmentUseCase(client redmine.AttachmentsService) *AttachmentUseCase {
 	return &AttachmentUseCase{
 		client: client,
 	}
@@ -8,11 +8,11 @@ import (
 
 // CategoryUseCase provides business logic for issue category operations.
 type CategoryUseCase struct {
-	client *redmine.Client
+	client redmine.IssueCategoriesService
 }
 
 // NewCategoryUseCase creates a new CategoryUseCase instance.
-func NewCategoryUseCase(client *redmine.Client) *CategoryUseCase {
+func NewCategoryUseCase(client redmine.IssueCategoriesService) *CategoryUseCase {
 	return &CategoryUseCase{
 		client: client,
 	}
@@ -8,11 +8,11 @@ import (
 
 // CustomFieldUseCase provides business logic for custom field operations.
 type CustomFieldUseCase struct {
-	client *redmine.Client
+	client redmine.CustomFieldsService
 }
 
 // NewCustomFieldUseCase creates a new CustomFieldUseCase instance.
-func NewCustomFieldUseCase(client *redmine.Client) *CustomFieldUseCase {
+func NewCustomFieldUseCase(client redmine.CustomFieldsService) *CustomFieldUseCase {
 	return &CustomFieldUseCase{
 		client: client,
 	}
@@ -8,11 +8,11 @@ import (
 
 // EnumerationUseCase provides business logic for enumeration operations.
 type EnumerationUseCase struct {
-	client *redmine.Client
+	client redmine.EnumerationsService
 }
 
 // NewEnumerationUseCase creates a new EnumerationUseCase instance.
-func NewEnumerationUseCase(client *redmine.Client) *EnumerationUseCase {
+func NewEnumerationUseCase(client redmine.EnumerationsService) *EnumerationUseCase {
 	return &EnumerationUseCase{
 		client: client,
 	}
@@ -8,11 +8,11 @@ import (
 
 // FileUseCase provides business logic for file operations.
 type FileUseCase struct {
-	client *redmine.Client
+	client redmine.FilesService
 }
 
 // NewFileUseCase creates a new FileUseCase instance.
-func NewFileUseCase(client *redmine.Client) *FileUseCase {
+func NewFileUseCase(client redmine.FilesService) *FileUseCase {
 	return &FileUseCase{
 		client: client,
 	}
@@ -8,11 +8,11 @@ import (
 
 // GroupUseCase provides business logic for group operations.
 type GroupUseCase struct {
-	client *redmine.Client
+	client redmine.GroupsService
 }
 
 // NewGroupUseCase creates a new GroupUseCase instance.
-func NewGroupUseCase(client *redmine.Client) *GroupUseCase {
+func NewGroupUseCase(client redmine.GroupsService) *GroupUseCase {
 	return &GroupUseCase{
 		client: client,
 	}
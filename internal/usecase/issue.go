@@ -6,13 +6,19 @@ import (
 	"github.com/kqns91/redmine-go/pkg/redmine"
 )
 
+// IssueClient is the part of the Redmine API used by IssueUseCase.
+type IssueClient interface {
+	redmine.IssuesService
+	redmine.WatchersService
+}
+
 // IssueUseCase provides business logic for issue operations.
 type IssueUseCase struct {
-	client *redmine.Client
+	client IssueClient
 }
 
 // NewIssueUseCase creates a new IssueUseCase instance.
-func NewIssueUseCase(client *redmine.Client) *IssueUseCase {
+func NewIssueUseCase(client IssueClient) *IssueUseCase {
 	return &IssueUseCase{
 		client: client,
 	}
@@ -38,6 +44,11 @@ func (u *IssueUseCase) UpdateIssue(ctx context.Context, id int, req redmine.Issu
 	return u.client.UpdateIssue(ctx, id, req)
 }
 
+// UpdateIssueIfUnmodified updates an issue only if it has not changed since lastUpdatedOn.
+func (u *IssueUseCase) UpdateIssueIfUnmodified(ctx context.Context, id int, lastUpdatedOn redmine.Timestamp, req redmine.IssueUpdateRequest) error {
+	return u.client.UpdateIssueIfUnmodified(ctx, id, lastUpdatedOn, req)
+}
+
 // DeleteIssue deletes an issue.
 func (u *IssueUseCase) DeleteIssue(ctx context.Context, id int) error {
 	return u.client.DeleteIssue(ctx, id)
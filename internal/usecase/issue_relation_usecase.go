@@ -8,11 +8,11 @@ import (
 
 // IssueRelationUseCase provides business logic for issue relation operations.
 type IssueRelationUseCase struct {
-	client *redmine.Client
+	client redmine.IssueRelationsService
 }
 
 // NewIssueRelationUseCase creates a new IssueRelationUseCase instance.
-func NewIssueRelationUseCase(client *redmine.Client) *IssueRelationUseCase {
+func NewIssueRelationUseCase(client redmine.IssueRelationsService) *IssueRelationUseCase {
 	return &IssueRelationUseCase{
 		client: client,
 	}
@@ -6,16 +6,21 @@ import (
 	"github.com/kqns91/redmine-go/pkg/redmine"
 )
 
+// JournalClient is the part of the Redmine API used by JournalUseCase.
+type JournalClient interface {
+	redmine.JournalsService
+	redmine.IssuesService
+	redmine.ChangelogClient
+}
+
 // JournalUseCase provides business logic for journal operations.
 type JournalUseCase struct {
-	client *redmine.Client
+	client JournalClient
 }
 
 // NewJournalUseCase creates a new JournalUseCase instance.
-func NewJournalUseCase(client *redmine.Client) *JournalUseCase {
-	return &JournalUseCase{
-		client: client,
-	}
+func NewJournalUseCase(client JournalClient) *JournalUseCase {
+	return &JournalUseCase{client: client}
 }
 
 // ShowJournal retrieves a specific journal entry by ID.
@@ -23,3 +28,33 @@ func NewJournalUseCase(client *redmine.Client) *JournalUseCase {
 func (u *JournalUseCase) ShowJournal(ctx context.Context, id int) (*redmine.JournalResponse, error) {
 	return u.client.ShowJournal(ctx, id)
 }
+
+// ShowFormattedJournal retrieves a journal entry and renders its changes as readable lines.
+func (u *JournalUseCase) ShowFormattedJournal(ctx context.Context, id int) (*redmine.ChangelogEntry, error) {
+	result, err := u.client.ShowJournal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := u.resolver().Changelog(ctx, []redmine.Journal{result.Journal})
+	if err != nil {
+		return nil, err
+	}
+	return &entries[0], nil
+}
+
+// GetIssueLog retrieves the journals of an issue rendered as a timeline.
+func (u *JournalUseCase) GetIssueLog(ctx context.Context, issueID int) ([]redmine.ChangelogEntry, error) {
+	result, err := u.client.ShowIssue(ctx, issueID, &redmine.ShowIssueOptions{Include: "journals"})
+	if err != nil {
+		return nil, err
+	}
+	return u.resolver().Changelog(ctx, result.Issue.Journals)
+}
+
+// resolver returns a ChangelogResolver for one call. Its names are cached for
+// the call only, so that renamed statuses, users or versions show up at once;
+// the client's metadata cache, when enabled, saves the repeated lookups.
+func (u *JournalUseCase) resolver() *redmine.ChangelogResolver {
+	return redmine.NewChangelogResolver(u.client)
+}
@@ -8,11 +8,11 @@ import (
 
 // MembershipUseCase provides business logic for membership operations.
 type MembershipUseCase struct {
-	client *redmine.Client
+	client redmine.MembershipsService
 }
 
 // NewMembershipUseCase creates a new MembershipUseCase instance.
-func NewMembershipUseCase(client *redmine.Client) *MembershipUseCase {
+func NewMembershipUseCase(client redmine.MembershipsService) *MembershipUseCase {
 	return &MembershipUseCase{
 		client: client,
 	}
@@ -6,13 +6,19 @@ import (
 	"github.com/kqns91/redmine-go/pkg/redmine"
 )
 
+// MetadataClient is the part of the Redmine API used by MetadataUseCase.
+type MetadataClient interface {
+	redmine.TrackersService
+	redmine.IssueStatusesService
+}
+
 // MetadataUseCase provides business logic for metadata operations.
 type MetadataUseCase struct {
-	client *redmine.Client
+	client MetadataClient
 }
 
 // NewMetadataUseCase creates a new MetadataUseCase instance.
-func NewMetadataUseCase(client *redmine.Client) *MetadataUseCase {
+func NewMetadataUseCase(client MetadataClient) *MetadataUseCase {
 	return &MetadataUseCase{
 		client: client,
 	}
@@ -8,11 +8,11 @@ import (
 
 // MyAccountUseCase provides business logic for my account operations.
 type MyAccountUseCase struct {
-	client *redmine.Client
+	client redmine.MyAccountService
 }
 
 // NewMyAccountUseCase creates a new MyAccountUseCase instance.
-func NewMyAccountUseCase(client *redmine.Client) *MyAccountUseCase {
+func NewMyAccountUseCase(client redmine.MyAccountService) *MyAccountUseCase {
 	return &MyAccountUseCase{
 		client: client,
 	}
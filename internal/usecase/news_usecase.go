@@ -8,11 +8,11 @@ import (
 
 // NewsUseCase provides business logic for news operations.
 type NewsUseCase struct {
-	client *redmine.Client
+	client redmine.NewsService
 }
 
 // NewNewsUseCase creates a new NewsUseCase instance.
-func NewNewsUseCase(client *redmine.Client) *NewsUseCase {
+func NewNewsUseCase(client redmine.NewsService) *NewsUseCase {
 	return &NewsUseCase{
 		client: client,
 	}
@@ -8,11 +8,11 @@ import (
 
 // ProjectUseCase provides business logic for project operations.
 type ProjectUseCase struct {
-	client *redmine.Client
+	client redmine.ProjectsService
 }
 
 // NewProjectUseCase creates a new ProjectUseCase instance.
-func NewProjectUseCase(client *redmine.Client) *ProjectUseCase {
+func NewProjectUseCase(client redmine.ProjectsService) *ProjectUseCase {
 	return &ProjectUseCase{
 		client: client,
 	}
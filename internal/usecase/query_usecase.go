@@ -8,11 +8,11 @@ import (
 
 // QueryUseCase provides business logic for query operations.
 type QueryUseCase struct {
-	client *redmine.Client
+	client redmine.QueriesService
 }
 
 // NewQueryUseCase creates a new QueryUseCase instance.
-func NewQueryUseCase(client *redmine.Client) *QueryUseCase {
+func NewQueryUseCase(client redmine.QueriesService) *QueryUseCase {
 	return &QueryUseCase{
 		client: client,
 	}
@@ -8,11 +8,11 @@ import (
 
 // RoleUseCase provides business logic for role operations.
 type RoleUseCase struct {
-	client *redmine.Client
+	client redmine.RolesService
 }
 
 // NewRoleUseCase creates a new RoleUseCase instance.
-func NewRoleUseCase(client *redmine.Client) *RoleUseCase {
+func NewRoleUseCase(client redmine.RolesService) *RoleUseCase {
 	return &RoleUseCase{
 		client: client,
 	}
@@ -8,11 +8,11 @@ import (
 
 // SearchUseCase provides business logic for search operations.
 type SearchUseCase struct {
-	client *redmine.Client
+	client redmine.SearchService
 }
 
 // NewSearchUseCase creates a new SearchUseCase instance.
-func NewSearchUseCase(client *redmine.Client) *SearchUseCase {
+func NewSearchUseCase(client redmine.SearchService) *SearchUseCase {
 	return &SearchUseCase{
 		client: client,
 	}
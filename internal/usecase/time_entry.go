@@ -8,11 +8,11 @@ import (
 
 // TimeEntryUseCase provides business logic for time entry operations.
 type TimeEntryUseCase struct {
-	client *redmine.Client
+	client redmine.TimeEntriesService
 }
 
 // NewTimeEntryUseCase creates a new TimeEntryUseCase instance.
-func NewTimeEntryUseCase(client *redmine.Client) *TimeEntryUseCase {
+func NewTimeEntryUseCase(client redmine.TimeEntriesService) *TimeEntryUseCase {
 	return &TimeEntryUseCase{
 		client: client,
 	}
@@ -4,7 +4,8 @@ import "github.com/kqns91/redmine-go/pkg/redmine"
 
 // UseCases holds all use case instances.
 type UseCases struct {
-	RedmineClient *redmine.Client // Direct access to Redmine client for batch operations
+	RedmineClient redmine.API       // Direct access to Redmine client for batch operations
+	Resolver      *redmine.Resolver // Resolves names given instead of IDs
 	Project       *ProjectUseCase
 	Issue         *IssueUseCase
 	User          *UserUseCase
@@ -26,4 +27,5 @@ type UseCases struct {
 	Role          *RoleUseCase
 	Enumeration   *EnumerationUseCase
 	MyAccount     *MyAccountUseCase
+	Activity      *ActivityUseCase
 }
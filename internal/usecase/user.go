@@ -8,11 +8,11 @@ import (
 
 // UserUseCase provides business logic for user operations.
 type UserUseCase struct {
-	client *redmine.Client
+	client redmine.UsersService
 }
 
 // NewUserUseCase creates a new UserUseCase instance.
-func NewUserUseCase(client *redmine.Client) *UserUseCase {
+func NewUserUseCase(client redmine.UsersService) *UserUseCase {
 	return &UserUseCase{
 		client: client,
 	}
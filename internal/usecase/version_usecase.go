@@ -8,11 +8,11 @@ import (
 
 // VersionUseCase provides business logic for version operations.
 type VersionUseCase struct {
-	client *redmine.Client
+	client redmine.VersionsService
 }
 
 // NewVersionUseCase creates a new VersionUseCase instance.
-func NewVersionUseCase(client *redmine.Client) *VersionUseCase {
+func NewVersionUseCase(client redmine.VersionsService) *VersionUseCase {
 	return &VersionUseCase{
 		client: client,
 	}
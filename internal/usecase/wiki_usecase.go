@@ -8,11 +8,11 @@ import (
 
 // WikiUseCase provides business logic for wiki operations.
 type WikiUseCase struct {
-	client *redmine.Client
+	client redmine.WikiService
 }
 
 // NewWikiUseCase creates a new WikiUseCase instance.
-func NewWikiUseCase(client *redmine.Client) *WikiUseCase {
+func NewWikiUseCase(client redmine.WikiService) *WikiUseCase {
 	return &WikiUseCase{
 		client: client,
 	}
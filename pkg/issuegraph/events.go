@@ -0,0 +1,146 @@
+package issuegraph
+
+import "slices"
+
+// eventGraph models each issue as a start event and a finish event, so that
+// finish-to-start dependencies and parents spanning their subtasks are plain
+// edges. The events of the issue at index i are 2i (start) and 2i+1 (finish).
+type eventGraph struct {
+	ids   []int
+	index map[int]int
+	out   [][]eventEdge
+	in    [][]eventEdge
+}
+
+type eventEdge struct {
+	from, to int
+	// lag is the minimum number of days between the two events.
+	lag int
+	// task marks the edge from the start to the finish of a task without
+	// subtasks, whose lag is the duration of the task.
+	task bool
+}
+
+func startEvent(i int) int  { return 2 * i }
+func finishEvent(i int) int { return 2*i + 1 }
+
+func (g *Graph) events() *eventGraph {
+	e := &eventGraph{
+		ids:   g.ids,
+		index: make(map[int]int, len(g.ids)),
+		out:   make([][]eventEdge, 2*len(g.ids)),
+		in:    make([][]eventEdge, 2*len(g.ids)),
+	}
+	for i, id := range g.ids {
+		e.index[id] = i
+	}
+
+	for i, id := range g.ids {
+		e.add(eventEdge{from: startEvent(i), to: finishEvent(i), task: len(g.children[id]) == 0})
+	}
+	for _, d := range g.deps {
+		from, to := e.index[d.From], e.index[d.To]
+		switch d.Kind {
+		case KindParent:
+			// A parent starts with its first subtask and finishes with its last
+			e.add(eventEdge{from: startEvent(from), to: startEvent(to)})
+			e.add(eventEdge{from: finishEvent(to), to: finishEvent(from)})
+		default:
+			e.add(eventEdge{from: finishEvent(from), to: startEvent(to), lag: d.Delay})
+		}
+	}
+
+	return e
+}
+
+func (e *eventGraph) add(edge eventEdge) {
+	e.out[edge.from] = append(e.out[edge.from], edge)
+	e.in[edge.to] = append(e.in[edge.to], edge)
+}
+
+// topologicalOrder returns the events in topological order, breaking ties by
+// event number, or false if there is a cycle.
+func (e *eventGraph) topologicalOrder() ([]int, bool) {
+	indegree := make([]int, len(e.out))
+	for v := range e.in {
+		indegree[v] = len(e.in[v])
+	}
+
+	var ready []int
+	for v, n := range indegree {
+		if n == 0 {
+			ready = append(ready, v)
+		}
+	}
+
+	order := make([]int, 0, len(e.out))
+	for len(ready) > 0 {
+		v := ready[0]
+		ready = ready[1:]
+		order = append(order, v)
+		for _, edge := range e.out[v] {
+			indegree[edge.to]--
+			if indegree[edge.to] == 0 {
+				i, _ := slices.BinarySearch(ready, edge.to)
+				ready = slices.Insert(ready, i, edge.to)
+			}
+		}
+	}
+
+	return order, len(order) == len(e.out)
+}
+
+// stronglyConnected returns the strongly connected components of the graph
+// using Tarjan's algorithm.
+func (e *eventGraph) stronglyConnected() [][]int {
+	n := len(e.out)
+	index := make([]int, n)
+	low := make([]int, n)
+	onStack := make([]bool, n)
+	for v := range index {
+		index[v] = -1
+	}
+
+	var stack []int
+	var components [][]int
+	next := 0
+
+	var connect func(v int)
+	connect = func(v int) {
+		index[v], low[v] = next, next
+		next++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, edge := range e.out[v] {
+			switch {
+			case index[edge.to] < 0:
+				connect(edge.to)
+				low[v] = min(low[v], low[edge.to])
+			case onStack[edge.to]:
+				low[v] = min(low[v], index[edge.to])
+			}
+		}
+
+		if low[v] == index[v] {
+			var component []int
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+				if w == v {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+
+	for v := range n {
+		if index[v] < 0 {
+			connect(v)
+		}
+	}
+	return components
+}
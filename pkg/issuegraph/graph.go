@@ -0,0 +1,225 @@
+// Package issuegraph analyses the dependencies between Redmine issues: it
+// builds a graph from blocks, precedes and parent/child relations, detects
+// cycles, orders issues topologically and schedules them with the Critical
+// Path Method.
+package issuegraph
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// Kinds of Dependency.
+const (
+	// KindBlocks means From must be closed before To can be closed.
+	KindBlocks = "blocks"
+	// KindPrecedes means To starts at least Delay days after From is due.
+	KindPrecedes = "precedes"
+	// KindParent means From is the parent task of To.
+	KindParent = "parent"
+)
+
+// ErrCycle is matched by a *CycleError with errors.Is.
+var ErrCycle = errors.New("issuegraph: dependency cycle")
+
+// CycleError is returned when issues depend on each other in a cycle, which
+// rules out a topological order or a schedule.
+type CycleError struct {
+	// Cycles holds the IDs of the issues in each cycle, in ascending order.
+	Cycles [][]int
+}
+
+func (e *CycleError) Error() string {
+	cycles := make([]string, 0, len(e.Cycles))
+	for _, cycle := range e.Cycles {
+		ids := make([]string, 0, len(cycle))
+		for _, id := range cycle {
+			ids = append(ids, fmt.Sprintf("#%d", id))
+		}
+		cycles = append(cycles, strings.Join(ids, ", "))
+	}
+	return fmt.Sprintf("%s among issues %s", ErrCycle, strings.Join(cycles, "; "))
+}
+
+// Is reports whether target is ErrCycle.
+func (e *CycleError) Is(target error) bool {
+	return target == ErrCycle
+}
+
+// Dependency is an edge of the graph. For KindBlocks and KindPrecedes, From
+// must be finished before To starts.
+type Dependency struct {
+	From  int
+	To    int
+	Kind  string
+	Delay int
+}
+
+// Graph is the dependency graph of a set of issues.
+type Graph struct {
+	issues   map[int]redmine.Issue
+	ids      []int
+	deps     []Dependency
+	children map[int][]int
+}
+
+// New builds the graph of issues from their relations and parents. Issues must
+// have been retrieved with include=relations. Relations to issues that are not
+// in issues, such as closed issues left out of a listing, are ignored, and
+// relations other than blocks and precedes do not constrain the schedule.
+func New(issues []redmine.Issue) *Graph {
+	g := &Graph{issues: map[int]redmine.Issue{}, children: map[int][]int{}}
+	for _, issue := range issues {
+		g.issues[issue.ID] = issue
+		g.ids = append(g.ids, issue.ID)
+	}
+	slices.Sort(g.ids)
+
+	seen := map[Dependency]bool{}
+	add := func(d Dependency) {
+		_, fromOK := g.issues[d.From]
+		_, toOK := g.issues[d.To]
+		if !fromOK || !toOK || d.From == d.To || seen[d] {
+			return
+		}
+		seen[d] = true
+		g.deps = append(g.deps, d)
+	}
+
+	for _, id := range g.ids {
+		issue := g.issues[id]
+		if issue.Parent.ID != 0 {
+			if _, ok := g.issues[issue.Parent.ID]; ok {
+				g.children[issue.Parent.ID] = append(g.children[issue.Parent.ID], id)
+			}
+			add(Dependency{From: issue.Parent.ID, To: id, Kind: KindParent})
+		}
+		for _, r := range issue.Relations {
+			switch r.RelationType {
+			case redmine.RelationBlocks:
+				add(Dependency{From: r.IssueID, To: r.IssueToID, Kind: KindBlocks})
+			case redmine.RelationBlocked:
+				add(Dependency{From: r.IssueToID, To: r.IssueID, Kind: KindBlocks})
+			case redmine.RelationPrecedes:
+				add(Dependency{From: r.IssueID, To: r.IssueToID, Kind: KindPrecedes, Delay: r.Delay})
+			case redmine.RelationFollows:
+				add(Dependency{From: r.IssueToID, To: r.IssueID, Kind: KindPrecedes, Delay: r.Delay})
+			}
+		}
+	}
+
+	return g
+}
+
+// Issue returns the issue with id.
+func (g *Graph) Issue(id int) (redmine.Issue, bool) {
+	issue, ok := g.issues[id]
+	return issue, ok
+}
+
+// Dependencies returns the edges of the graph.
+func (g *Graph) Dependencies() []Dependency {
+	return slices.Clone(g.deps)
+}
+
+// Children returns the IDs of the subtasks of id.
+func (g *Graph) Children(id int) []int {
+	return slices.Clone(g.children[id])
+}
+
+// Predecessors returns the IDs of the issues that block or precede id.
+func (g *Graph) Predecessors(id int) []int {
+	var ids []int
+	for _, d := range g.deps {
+		if d.To == id && d.Kind != KindParent {
+			ids = append(ids, d.From)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// Successors returns the IDs of the issues that id blocks or precedes.
+func (g *Graph) Successors(id int) []int {
+	var ids []int
+	for _, d := range g.deps {
+		if d.From == id && d.Kind != KindParent {
+			ids = append(ids, d.To)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// Dependents returns the IDs of the issues that depend on id directly or
+// transitively, including the subtasks of those issues, which would be
+// delayed if id were.
+func (g *Graph) Dependents(id int) []int {
+	e := g.events()
+	seen := map[int]bool{}
+	var visit func(v int)
+	visit = func(v int) {
+		for _, edge := range e.out[v] {
+			if !seen[edge.to] {
+				seen[edge.to] = true
+				visit(edge.to)
+			}
+		}
+	}
+	visit(finishEvent(e.index[id]))
+
+	var ids []int
+	for v := range seen {
+		if issueID := e.ids[v/2]; issueID != id && v%2 == 0 && !slices.Contains(ids, issueID) {
+			ids = append(ids, issueID)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// Cycles returns the IDs of the issues in each dependency cycle, or nil if
+// the graph is acyclic.
+func (g *Graph) Cycles() [][]int {
+	e := g.events()
+	var cycles [][]int
+	for _, component := range e.stronglyConnected() {
+		if len(component) < 2 {
+			continue
+		}
+		var ids []int
+		for _, v := range component {
+			if id := e.ids[v/2]; !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+		slices.Sort(ids)
+		cycles = append(cycles, ids)
+	}
+	slices.SortFunc(cycles, func(a, b []int) int { return cmp.Compare(a[0], b[0]) })
+	return cycles
+}
+
+// TopologicalOrder returns the IDs of the issues in an order where every issue
+// comes after the issues it depends on and after its parent, breaking ties by
+// ID. It returns a *CycleError if there is a cycle.
+func (g *Graph) TopologicalOrder() ([]int, error) {
+	e := g.events()
+	order, ok := e.topologicalOrder()
+	if !ok {
+		return nil, &CycleError{Cycles: g.Cycles()}
+	}
+
+	ids := make([]int, 0, len(g.ids))
+	for _, v := range order {
+		if v%2 == 0 {
+			ids = append(ids, e.ids[v/2])
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,90 @@
+package issuegraph
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// testIssues returns a small plan: #1 blocks #2, #1 precedes #3 with a delay
+// of one day, #4 waits for #2 and #3, and #5 is the parent of #2 and #3.
+func testIssues() []redmine.Issue {
+	return []redmine.Issue{
+		{ID: 1, Subject: "Design", EstimatedHours: 16, StartDate: redmine.NewDate(2024, time.April, 1), Relations: []redmine.IssueRelation{
+			{IssueID: 1, IssueToID: 2, RelationType: redmine.RelationBlocks},
+			{IssueID: 1, IssueToID: 3, RelationType: redmine.RelationPrecedes, Delay: 1},
+		}},
+		{ID: 2, Subject: "Backend", EstimatedHours: 24, Parent: redmine.Resource{ID: 5}, Relations: []redmine.IssueRelation{
+			{IssueID: 1, IssueToID: 2, RelationType: redmine.RelationBlocks},
+			{IssueID: 2, IssueToID: 4, RelationType: redmine.RelationBlocks},
+		}},
+		{ID: 3, Subject: "Frontend", EstimatedHours: 8, Parent: redmine.Resource{ID: 5}},
+		{ID: 4, Subject: "Release", EstimatedHours: 4, Relations: []redmine.IssueRelation{
+			{IssueID: 4, IssueToID: 3, RelationType: redmine.RelationBlocked},
+			{IssueID: 4, IssueToID: 99, RelationType: redmine.RelationFollows},
+		}},
+		{ID: 5, Subject: "Implementation"},
+	}
+}
+
+func TestNew(t *testing.T) {
+	g := New(testIssues())
+
+	// The relation listed by both #1 and #2 and the one to #99 are dropped
+	if got := len(g.Dependencies()); got != 6 {
+		t.Errorf("Expected 6 dependencies, got %d: %v", got, g.Dependencies())
+	}
+	if got := g.Predecessors(4); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("Expected predecessors [2 3], got %v", got)
+	}
+	if got := g.Successors(1); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("Expected successors [2 3], got %v", got)
+	}
+	if got := g.Children(5); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("Expected children [2 3], got %v", got)
+	}
+	if got := g.Dependents(1); !slices.Equal(got, []int{2, 3, 4}) {
+		t.Errorf("Expected dependents [2 3 4], got %v", got)
+	}
+}
+
+func TestTopologicalOrder(t *testing.T) {
+	order, err := New(testIssues()).TopologicalOrder()
+	if err != nil {
+		t.Fatalf("TopologicalOrder failed: %v", err)
+	}
+	if !slices.Equal(order, []int{1, 5, 2, 3, 4}) {
+		t.Errorf("Expected order [1 5 2 3 4], got %v", order)
+	}
+}
+
+func TestCycles(t *testing.T) {
+	issues := append(testIssues(),
+		redmine.Issue{ID: 6, Relations: []redmine.IssueRelation{{IssueID: 6, IssueToID: 7, RelationType: redmine.RelationPrecedes}}},
+		redmine.Issue{ID: 7, Relations: []redmine.IssueRelation{{IssueID: 7, IssueToID: 8, RelationType: redmine.RelationBlocks}}},
+		redmine.Issue{ID: 8, Relations: []redmine.IssueRelation{{IssueID: 8, IssueToID: 6, RelationType: redmine.RelationBlocks}}},
+	)
+	g := New(issues)
+
+	cycles := g.Cycles()
+	if len(cycles) != 1 || !slices.Equal(cycles[0], []int{6, 7, 8}) {
+		t.Errorf("Expected cycle [6 7 8], got %v", cycles)
+	}
+
+	_, err := g.TopologicalOrder()
+	if !errors.Is(err, ErrCycle) {
+		t.Errorf("Expected ErrCycle, got %v", err)
+	}
+	_, err = g.Schedule(nil)
+	var cycleErr *CycleError
+	if !errors.As(err, &cycleErr) || len(cycleErr.Cycles) != 1 {
+		t.Errorf("Expected *CycleError, got %v", err)
+	}
+
+	if cycles := New(testIssues()).Cycles(); cycles != nil {
+		t.Errorf("Expected no cycles, got %v", cycles)
+	}
+}
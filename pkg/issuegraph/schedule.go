@@ -0,0 +1,218 @@
+package issuegraph
+
+import (
+	"math"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// ScheduleOptions configures Graph.Schedule.
+type ScheduleOptions struct {
+	// Start is the first day of the schedule. It defaults to the earliest start
+	// date of the issues, or to today with Remaining or if no issue has one.
+	Start redmine.Date
+	// HoursPerDay converts estimated hours into days. It defaults to 8.
+	HoursPerDay float64
+	// Remaining schedules only the work left, reducing durations by the done
+	// ratio of each issue, so that a schedule from today forecasts completion.
+	Remaining bool
+}
+
+// Task is the schedule of an issue. Days are inclusive, like Redmine's start
+// and due dates, and a task lasting 0 days starts and finishes the same day.
+type Task struct {
+	IssueID        int          `json:"issue_id"`
+	Subject        string       `json:"subject"`
+	Duration       int          `json:"duration"`
+	EarliestStart  redmine.Date `json:"earliest_start"`
+	EarliestFinish redmine.Date `json:"earliest_finish"`
+	LatestStart    redmine.Date `json:"latest_start"`
+	LatestFinish   redmine.Date `json:"latest_finish"`
+	// Slack is how many days the task can slip without delaying the project.
+	Slack    int  `json:"slack"`
+	Critical bool `json:"critical"`
+	// Parent is true for tasks whose dates are derived from their subtasks.
+	Parent bool `json:"parent,omitempty"`
+}
+
+// Schedule is the result of the Critical Path Method over a graph.
+type Schedule struct {
+	Start  redmine.Date `json:"start"`
+	Finish redmine.Date `json:"finish"`
+	// Duration is the length of the project in days.
+	Duration int `json:"duration"`
+	// Tasks holds a task for every issue, in topological order.
+	Tasks []Task `json:"tasks"`
+	// CriticalPath holds the IDs of the tasks without subtasks that have no
+	// slack, in topological order. Any delay to one of them delays the project.
+	CriticalPath []int `json:"critical_path"`
+}
+
+// Task returns the task of the issue with id.
+func (s *Schedule) Task(id int) (Task, bool) {
+	for _, t := range s.Tasks {
+		if t.IssueID == id {
+			return t, true
+		}
+	}
+	return Task{}, false
+}
+
+// Schedule runs the Critical Path Method. The duration of an issue is its
+// estimated hours in days, rounded up, or else the days from its start date to
+// its due date. Issues do not start before their start date, and follow the
+// issues that block or precede them, after the delay of a precedes relation.
+// Parents span their subtasks. It returns a *CycleError if there is a cycle.
+func (g *Graph) Schedule(opts *ScheduleOptions) (*Schedule, error) {
+	if opts == nil {
+		opts = &ScheduleOptions{}
+	}
+	hoursPerDay := opts.HoursPerDay
+	if hoursPerDay <= 0 {
+		hoursPerDay = 8
+	}
+	start := opts.Start
+	if start.IsZero() {
+		start = g.defaultStart(opts.Remaining)
+	}
+
+	e := g.events()
+	order, ok := e.topologicalOrder()
+	if !ok {
+		return nil, &CycleError{Cycles: g.Cycles()}
+	}
+
+	durations := make([]int, len(g.ids))
+	earliest := make([]int, len(e.out))
+	for i, id := range g.ids {
+		issue := g.issues[id]
+		durations[i] = duration(issue, hoursPerDay, opts.Remaining)
+		if !issue.StartDate.IsZero() {
+			earliest[startEvent(i)] = max(0, daysBetween(start, issue.StartDate))
+		}
+	}
+	lag := func(edge eventEdge) int {
+		if edge.task {
+			return edge.lag + durations[edge.from/2]
+		}
+		return edge.lag
+	}
+
+	// Forward pass
+	end := 0
+	for _, v := range order {
+		for _, edge := range e.out[v] {
+			earliest[edge.to] = max(earliest[edge.to], earliest[v]+lag(edge))
+		}
+		end = max(end, earliest[v])
+	}
+
+	// Backward pass
+	latest := make([]int, len(e.out))
+	for v := range latest {
+		latest[v] = end
+	}
+	for i := len(order) - 1; i >= 0; i-- {
+		v := order[i]
+		for _, edge := range e.out[v] {
+			latest[v] = min(latest[v], latest[edge.to]-lag(edge))
+		}
+	}
+
+	// Parents span their subtasks, which are later in order
+	es, ef := make([]int, len(g.ids)), make([]int, len(g.ids))
+	ls, lf := make([]int, len(g.ids)), make([]int, len(g.ids))
+	for j := len(order) - 1; j >= 0; j-- {
+		if order[j]%2 != 0 {
+			continue
+		}
+		i := order[j] / 2
+		es[i], ef[i] = earliest[startEvent(i)], earliest[finishEvent(i)]
+		ls[i], lf[i] = latest[startEvent(i)], latest[finishEvent(i)]
+		for k, child := range g.children[g.ids[i]] {
+			c := e.index[child]
+			if k == 0 {
+				es[i], ef[i], ls[i], lf[i] = es[c], ef[c], ls[c], lf[c]
+				continue
+			}
+			es[i], ef[i] = min(es[i], es[c]), max(ef[i], ef[c])
+			ls[i], lf[i] = min(ls[i], ls[c]), max(lf[i], lf[c])
+		}
+	}
+
+	s := &Schedule{
+		Start:        start,
+		Finish:       lastDay(start, 0, end),
+		Duration:     end,
+		Tasks:        make([]Task, 0, len(g.ids)),
+		CriticalPath: []int{},
+	}
+	for _, v := range order {
+		if v%2 != 0 {
+			continue
+		}
+		i := v / 2
+		id := g.ids[i]
+		t := Task{
+			IssueID:        id,
+			Subject:        g.issues[id].Subject,
+			Duration:       ef[i] - es[i],
+			EarliestStart:  start.AddDays(es[i]),
+			EarliestFinish: lastDay(start, es[i], ef[i]),
+			LatestStart:    start.AddDays(ls[i]),
+			LatestFinish:   lastDay(start, ls[i], lf[i]),
+			Slack:          lf[i] - ef[i],
+			Parent:         len(g.children[id]) > 0,
+		}
+		t.Critical = t.Slack == 0
+		s.Tasks = append(s.Tasks, t)
+		if t.Critical && !t.Parent {
+			s.CriticalPath = append(s.CriticalPath, id)
+		}
+	}
+
+	return s, nil
+}
+
+func (g *Graph) defaultStart(remaining bool) redmine.Date {
+	var start redmine.Date
+	if !remaining {
+		for _, issue := range g.issues {
+			if !issue.StartDate.IsZero() && (start.IsZero() || issue.StartDate.Before(start.Time)) {
+				start = issue.StartDate
+			}
+		}
+	}
+	if start.IsZero() {
+		start = redmine.Today()
+	}
+	return start
+}
+
+// duration returns the number of days issue takes.
+func duration(issue redmine.Issue, hoursPerDay float64, remaining bool) int {
+	left := 1.0
+	if remaining {
+		left = 1 - float64(min(issue.DoneRatio, 100))/100
+	}
+
+	var days float64
+	switch {
+	case issue.EstimatedHours > 0:
+		days = issue.EstimatedHours / hoursPerDay
+	case !issue.StartDate.IsZero() && !issue.DueDate.IsZero():
+		days = float64(max(0, daysBetween(issue.StartDate, issue.DueDate)+1))
+	}
+	return int(math.Ceil(days*left - 1e-9))
+}
+
+// daysBetween returns the number of days from a to b.
+func daysBetween(a, b redmine.Date) int {
+	return int(math.Round(b.Sub(a.Time).Hours() / 24))
+}
+
+// lastDay returns the last day of a period from day from to day to, exclusive,
+// counted from start.
+func lastDay(start redmine.Date, from, to int) redmine.Date {
+	return start.AddDays(max(from, to-1))
+}
@@ -0,0 +1,75 @@
+package issuegraph
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+func TestSchedule(t *testing.T) {
+	s, err := New(testIssues()).Schedule(nil)
+	if err != nil {
+		t.Fatalf("Schedule failed: %v", err)
+	}
+
+	if s.Start.String() != "2024-04-01" || s.Finish.String() != "2024-04-06" || s.Duration != 6 {
+		t.Errorf("Expected 2024-04-01 to 2024-04-06 (6 days), got %s to %s (%d days)", s.Start, s.Finish, s.Duration)
+	}
+	if !slices.Equal(s.CriticalPath, []int{1, 2, 4}) {
+		t.Errorf("Expected critical path [1 2 4], got %v", s.CriticalPath)
+	}
+
+	tests := []struct {
+		id                     int
+		earliestStart, lastDay string
+		latestStart            string
+		duration, slack        int
+	}{
+		{id: 1, earliestStart: "2024-04-01", lastDay: "2024-04-02", latestStart: "2024-04-01", duration: 2},
+		{id: 2, earliestStart: "2024-04-03", lastDay: "2024-04-05", latestStart: "2024-04-03", duration: 3},
+		{id: 3, earliestStart: "2024-04-04", lastDay: "2024-04-04", latestStart: "2024-04-05", duration: 1, slack: 1},
+		{id: 4, earliestStart: "2024-04-06", lastDay: "2024-04-06", latestStart: "2024-04-06", duration: 1},
+		{id: 5, earliestStart: "2024-04-03", lastDay: "2024-04-05", latestStart: "2024-04-03", duration: 3},
+	}
+
+	for _, tt := range tests {
+		task, ok := s.Task(tt.id)
+		if !ok {
+			t.Fatalf("Expected task #%d", tt.id)
+		}
+		if task.EarliestStart.String() != tt.earliestStart || task.EarliestFinish.String() != tt.lastDay {
+			t.Errorf("#%d: Expected %s to %s, got %s to %s", tt.id, tt.earliestStart, tt.lastDay, task.EarliestStart, task.EarliestFinish)
+		}
+		if task.LatestStart.String() != tt.latestStart {
+			t.Errorf("#%d: Expected latest start %s, got %s", tt.id, tt.latestStart, task.LatestStart)
+		}
+		if task.Duration != tt.duration || task.Slack != tt.slack || task.Critical != (tt.slack == 0) {
+			t.Errorf("#%d: Expected duration %d and slack %d, got %+v", tt.id, tt.duration, tt.slack, task)
+		}
+	}
+}
+
+func TestScheduleRemaining(t *testing.T) {
+	issues := []redmine.Issue{
+		{ID: 1, EstimatedHours: 40, DoneRatio: 60, StartDate: redmine.NewDate(2024, time.March, 1)},
+		{ID: 2, StartDate: redmine.NewDate(2024, time.March, 4), DueDate: redmine.NewDate(2024, time.March, 7), Relations: []redmine.IssueRelation{
+			{IssueID: 1, IssueToID: 2, RelationType: redmine.RelationPrecedes},
+		}},
+	}
+
+	start := redmine.NewDate(2024, time.May, 1)
+	s, err := New(issues).Schedule(&ScheduleOptions{Start: start, HoursPerDay: 4, Remaining: true})
+	if err != nil {
+		t.Fatalf("Schedule failed: %v", err)
+	}
+
+	// 16 hours left at 4 hours a day, then 4 days spanned by the dates of #2
+	if task, _ := s.Task(1); task.Duration != 4 {
+		t.Errorf("Expected 4 days left for #1, got %d", task.Duration)
+	}
+	if task, _ := s.Task(2); task.EarliestStart.String() != "2024-05-05" || task.EarliestFinish.String() != "2024-05-08" {
+		t.Errorf("Expected #2 from 2024-05-05 to 2024-05-08, got %s to %s", task.EarliestStart, task.EarliestFinish)
+	}
+}
@@ -0,0 +1,193 @@
+package redmine
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const activityAtom = `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Redmine: Activity</title>
+  <link rel="self" href="https://redmine.example.com/activity.atom"/>
+  <link rel="alternate" href="https://redmine.example.com/activity"/>
+  <id>https://redmine.example.com/</id>
+  <updated>2024-05-03T09:00:00Z</updated>
+  <author><name>Redmine</name></author>
+  <entry>
+    <title>Web - Bug #12 (Closed): Login fails</title>
+    <link rel="alternate" href="https://redmine.example.com/issues/12#change-34"/>
+    <id>https://redmine.example.com/issues/12#change-34</id>
+    <updated>2024-05-03T09:00:00Z</updated>
+    <author><name>John Smith</name><email>john@example.com</email></author>
+    <content type="html">&lt;p&gt;Fixed in r42&lt;/p&gt;</content>
+  </entry>
+  <entry>
+    <title>Web - Revision 42 (main): Fix login</title>
+    <link rel="alternate" href="https://redmine.example.com/projects/web/repository/main/revisions/42"/>
+    <id>https://redmine.example.com/projects/web/repository/main/revisions/42</id>
+    <updated>2024-05-02T18:00:00+09:00</updated>
+    <author><name>John Smith</name></author>
+    <content type="html"></content>
+  </entry>
+  <entry>
+    <title>API - Wiki edit: Release notes - Q2 (#3)</title>
+    <link rel="alternate" href="https://redmine.example.com/projects/api/wiki/Release_notes?version=3"/>
+    <id>https://redmine.example.com/projects/api/wiki/Release_notes?version=3</id>
+    <updated>2024-05-01T08:00:00Z</updated>
+    <author><name>Jane Doe</name></author>
+    <content type="html"></content>
+  </entry>
+  <entry>
+    <title>API - 2.50 hours (Feature #7 (New): Add export)</title>
+    <link rel="alternate" href="https://redmine.example.com/projects/api/time_entries?issue_id=7"/>
+    <id>https://redmine.example.com/projects/api/time_entries?issue_id=7</id>
+    <updated>2024-04-30T08:00:00Z</updated>
+    <author><name>Jane Doe</name></author>
+    <content type="html"></content>
+  </entry>
+</feed>`
+
+func TestListActivity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/activity.atom" {
+			t.Errorf("Expected path /activity.atom, got %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("key") != "feed-key" {
+			t.Errorf("Expected key feed-key, got %s", query.Get("key"))
+		}
+		if query.Get("user_id") != "5" || query.Get("show_issues") != "1" || query.Get("show_changesets") != "1" {
+			t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/atom+xml")
+		//nolint:errcheck
+		w.Write([]byte(activityAtom))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key", WithFeedKey("feed-key"))
+	events, err := client.ListActivity(context.Background(), &ListActivityOptions{
+		UserID: 5,
+		Types:  []string{ActivityIssues, ActivityChangesets},
+		From:   time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("ListActivity failed: %v", err)
+	}
+
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(events))
+	}
+	issue := events[0]
+	if issue.Type != ActivityIssues || issue.IssueID != 12 || issue.JournalID != 34 ||
+		issue.Project != "Web" || issue.Title != "Bug #12 (Closed): Login fails" ||
+		issue.Author != "John Smith" || issue.AuthorEmail != "john@example.com" ||
+		issue.Content != "<p>Fixed in r42</p>" || issue.URL != "https://redmine.example.com/issues/12#change-34" {
+		t.Errorf("Unexpected issue event: %+v", issue)
+	}
+	if !issue.Updated.Equal(time.Date(2024, time.May, 3, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected update time: %v", issue.Updated)
+	}
+	if e := events[1]; e.Type != ActivityChangesets || e.IssueID != 0 || !e.Updated.Equal(time.Date(2024, time.May, 2, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected changeset event: %+v", e)
+	}
+	if e := events[2]; e.Type != ActivityWikiEdits || e.Project != "API" || e.Title != "Wiki edit: Release notes - Q2 (#3)" {
+		t.Errorf("Unexpected wiki event: %+v", e)
+	}
+}
+
+func TestNewActivityEventType(t *testing.T) {
+	tests := []struct {
+		url       string
+		wantType  string
+		wantIssue int
+	}{
+		{url: "https://redmine.example.com/issues/12#change-34", wantType: ActivityIssues, wantIssue: 12},
+		// Project identifiers that are also type names are skipped
+		{url: "https://redmine.example.com/projects/news/wiki/Foo", wantType: ActivityWikiEdits},
+		{url: "https://redmine.example.com/projects/issues/files", wantType: ActivityFiles},
+		{url: "https://redmine.example.com/redmine/projects/wiki/repository/revisions/42", wantType: ActivityChangesets},
+		{url: "https://redmine.example.com/projects/news", wantType: ""},
+		{url: "https://redmine.example.com/news/7", wantType: ActivityNews},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			e := newActivityEvent(atomEntry{ID: tt.url})
+			if e.Type != tt.wantType || e.IssueID != tt.wantIssue {
+				t.Errorf("Expected type %q and issue %d, got %q and %d", tt.wantType, tt.wantIssue, e.Type, e.IssueID)
+			}
+		})
+	}
+}
+
+func TestListActivityProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/projects/api/activity.atom" {
+			t.Errorf("Expected path /projects/api/activity.atom, got %s", r.URL.Path)
+		}
+		if r.URL.RawQuery != "" {
+			t.Errorf("Expected no query without a feed key, got %s", r.URL.RawQuery)
+		}
+		//nolint:errcheck
+		w.Write([]byte(strings.ReplaceAll(activityAtom, "API - ", "")))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	events, err := client.ListActivity(context.Background(), &ListActivityOptions{
+		ProjectID: "api",
+		To:        time.Date(2024, time.May, 1, 8, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("ListActivity failed: %v", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(events))
+	}
+	if e := events[0]; e.Project != "" || e.Title != "Wiki edit: Release notes - Q2 (#3)" {
+		t.Errorf("Expected the title to be kept in project feeds, got %+v", e)
+	}
+	if e := events[1]; e.Type != ActivityTimeEntries {
+		t.Errorf("Expected time entry event, got %+v", e)
+	}
+}
+
+func TestGetFeedRedactsKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := New(server.URL, "test-api-key", WithFeedKey("s3cret-feed-key"), WithLogger(logger))
+
+	_, err := client.GetFeed(context.Background(), "/issues/12.atom", nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected APIError, got %v", err)
+	}
+	if strings.Contains(err.Error(), "s3cret") || !strings.Contains(apiErr.URL, "key=REDACTED") {
+		t.Errorf("Expected the key to be redacted, got %s", err)
+	}
+	if strings.Contains(logs.String(), "s3cret") || !strings.Contains(logs.String(), "key=REDACTED") {
+		t.Errorf("Expected the key to be redacted from logs, got %s", logs.String())
+	}
+
+	// Transport errors carry the URL too
+	server.Close()
+	_, err = client.GetFeed(context.Background(), "/issues/12.atom", nil)
+	if err == nil || strings.Contains(err.Error(), "s3cret") {
+		t.Errorf("Expected the key to be redacted, got %v", err)
+	}
+}
@@ -10,14 +10,14 @@ import (
 )
 
 type Attachment struct {
-	ID          int      `json:"id,omitempty"`
-	Filename    string   `json:"filename,omitempty"`
-	Filesize    int      `json:"filesize,omitempty"`
-	ContentType string   `json:"content_type,omitempty"`
-	Description string   `json:"description,omitempty"`
-	ContentURL  string   `json:"content_url,omitempty"`
-	Author      Resource `json:"author,omitempty"`
-	CreatedOn   string   `json:"created_on,omitempty"`
+	ID          int       `json:"id,omitempty"`
+	Filename    string    `json:"filename,omitempty"`
+	Filesize    int       `json:"filesize,omitempty"`
+	ContentType string    `json:"content_type,omitempty"`
+	Description string    `json:"description,omitempty"`
+	ContentURL  string    `json:"content_url,omitempty"`
+	Author      Resource  `json:"author,omitempty"`
+	CreatedOn   Timestamp `json:"created_on,omitzero"`
 }
 
 type AttachmentResponse struct {
@@ -70,8 +70,7 @@ func (c *Client) UpdateAttachment(ctx context.Context, id int, attachment Attach
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to update attachment: %s", string(body))
+		return fmt.Errorf("failed to update attachment: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -89,8 +88,7 @@ func (c *Client) DeleteAttachment(ctx context.Context, id int) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete attachment: %s", string(body))
+		return fmt.Errorf("failed to delete attachment: %w", newAPIError(resp))
 	}
 
 	return nil
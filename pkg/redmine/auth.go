@@ -0,0 +1,77 @@
+package redmine
+
+import (
+	"context"
+	"net/http"
+)
+
+// Authenticator applies credentials to an outgoing request.
+type Authenticator interface {
+	Authenticate(req *http.Request)
+}
+
+type apiKeyAuth struct {
+	key string
+}
+
+// APIKeyAuth authenticates with the X-Redmine-Api-Key header.
+func APIKeyAuth(key string) Authenticator {
+	return apiKeyAuth{key: key}
+}
+
+func (a apiKeyAuth) Authenticate(req *http.Request) {
+	req.Header.Set("X-Redmine-Api-Key", a.key)
+}
+
+type basicAuth struct {
+	username string
+	password string
+}
+
+// BasicAuth authenticates with HTTP Basic authentication using a login and password.
+func BasicAuth(username, password string) Authenticator {
+	return basicAuth{username: username, password: password}
+}
+
+func (a basicAuth) Authenticate(req *http.Request) {
+	req.SetBasicAuth(a.username, a.password)
+}
+
+type anonymousAuth struct{}
+
+// AnonymousAuth sends requests without credentials.
+func AnonymousAuth() Authenticator {
+	return anonymousAuth{}
+}
+
+func (anonymousAuth) Authenticate(*http.Request) {}
+
+type switchUserAuth struct {
+	base  Authenticator
+	login string
+}
+
+// SwitchUserAuth wraps base and impersonates the user with the given login
+// through the X-Redmine-Switch-User header. base must authenticate an administrator.
+func SwitchUserAuth(base Authenticator, login string) Authenticator {
+	return switchUserAuth{base: base, login: login}
+}
+
+func (a switchUserAuth) Authenticate(req *http.Request) {
+	a.base.Authenticate(req)
+	req.Header.Set("X-Redmine-Switch-User", a.login)
+}
+
+type switchUserKey struct{}
+
+// ContextWithSwitchUser returns a context that makes requests impersonate the
+// user with the given login, overriding any impersonation set on the client.
+func ContextWithSwitchUser(ctx context.Context, login string) context.Context {
+	return context.WithValue(ctx, switchUserKey{}, login)
+}
+
+// SwitchUserFromContext returns the login set by ContextWithSwitchUser.
+func SwitchUserFromContext(ctx context.Context) (string, bool) {
+	login, ok := ctx.Value(switchUserKey{}).(string)
+	return login, ok && login != ""
+}
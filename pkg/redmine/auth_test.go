@@ -0,0 +1,61 @@
+package redmine
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAuthenticators(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiKey     string
+		opts       []Option
+		ctxLogin   string
+		wantKey    string
+		wantBasic  bool
+		wantSwitch string
+	}{
+		{name: "api key", apiKey: "secret", wantKey: "secret"},
+		{name: "anonymous", apiKey: ""},
+		{name: "basic", apiKey: "secret", opts: []Option{WithAuthenticator(BasicAuth("jsmith", "pass"))}, wantBasic: true},
+		{name: "switch user", apiKey: "secret", opts: []Option{WithSwitchUser("tanaka")}, wantKey: "secret", wantSwitch: "tanaka"},
+		{name: "context switch user", apiKey: "secret", opts: []Option{WithSwitchUser("tanaka")}, ctxLogin: "suzuki", wantKey: "secret", wantSwitch: "suzuki"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("X-Redmine-Api-Key"); got != tt.wantKey {
+					t.Errorf("Expected X-Redmine-API-Key %q, got %q", tt.wantKey, got)
+				}
+				username, password, ok := r.BasicAuth()
+				if ok != tt.wantBasic {
+					t.Errorf("Expected basic auth %v, got %v", tt.wantBasic, ok)
+				}
+				if ok && (username != "jsmith" || password != "pass") {
+					t.Errorf("Unexpected basic auth credentials %s:%s", username, password)
+				}
+				if got := r.Header.Get("X-Redmine-Switch-User"); got != tt.wantSwitch {
+					t.Errorf("Expected X-Redmine-Switch-User %q, got %q", tt.wantSwitch, got)
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			ctx := context.Background()
+			if tt.ctxLogin != "" {
+				ctx = ContextWithSwitchUser(ctx, tt.ctxLogin)
+			}
+
+			client := New(server.URL, tt.apiKey, tt.opts...)
+			resp, err := client.do(ctx, http.MethodGet, server.URL+"/test", nil)
+			if err != nil {
+				t.Fatalf("do() failed: %v", err)
+			}
+			//nolint:errcheck
+			resp.Body.Close()
+		})
+	}
+}
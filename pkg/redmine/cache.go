@@ -0,0 +1,373 @@
+package redmine
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Cached resources, used as keys of CacheOptions.TTLs and for Cache.Invalidate.
+const (
+	CacheTrackers            = "trackers"
+	CacheIssueStatuses       = "issue_statuses"
+	CacheIssuePriorities     = "issue_priorities"
+	CacheTimeEntryActivities = "time_entry_activities"
+	CacheDocumentCategories  = "document_categories"
+	CacheRoles               = "roles"
+	CacheCustomFields        = "custom_fields"
+	CacheVersions            = "versions"
+	CacheIssueCategories     = "issue_categories"
+)
+
+type cacheResource struct {
+	name string
+	path *regexp.Regexp
+}
+
+// cacheResources maps the endpoints of the cached resources, both lists and
+// single items, to their names.
+var cacheResources = []cacheResource{
+	{CacheTrackers, regexp.MustCompile(`/trackers\.json$`)},
+	{CacheIssueStatuses, regexp.MustCompile(`/issue_statuses\.json$`)},
+	{CacheIssuePriorities, regexp.MustCompile(`/enumerations/issue_priorities\.json$`)},
+	{CacheTimeEntryActivities, regexp.MustCompile(`/enumerations/time_entry_activities\.json$`)},
+	{CacheDocumentCategories, regexp.MustCompile(`/enumerations/document_categories\.json$`)},
+	{CacheRoles, regexp.MustCompile(`/roles(/\d+)?\.json$`)},
+	{CacheCustomFields, regexp.MustCompile(`/custom_fields\.json$`)},
+	{CacheVersions, regexp.MustCompile(`(/projects/[^/]+/versions|/versions/\d+)\.json$`)},
+	{CacheIssueCategories, regexp.MustCompile(`(/projects/[^/]+/issue_categories|/issue_categories/\d+)\.json$`)},
+}
+
+// CacheOptions configures a Cache.
+type CacheOptions struct {
+	// TTL is how long a response is reused without asking Redmine. It defaults
+	// to 10 minutes.
+	TTL time.Duration
+	// TTLs overrides TTL for some resources, keyed by the Cache* constants. A
+	// negative TTL disables caching of the resource.
+	TTLs map[string]time.Duration
+	// Dir, if set, keeps the cache in files under Dir so that it outlives the
+	// process. Failures to read or write the files are ignored.
+	Dir string
+}
+
+// CacheCounts counts the requests answered by a Cache.
+type CacheCounts struct {
+	// Hits were answered from the cache without a request.
+	Hits int64 `json:"hits"`
+	// Revalidations were answered from the cache after Redmine replied
+	// 304 Not Modified to a conditional request.
+	Revalidations int64 `json:"revalidations"`
+	// Misses were fetched from Redmine.
+	Misses int64 `json:"misses"`
+	// Invalidations counts the entries dropped after writes or by Invalidate.
+	Invalidations int64 `json:"invalidations"`
+}
+
+// CacheStats holds the counts of a Cache, in total and per resource.
+type CacheStats struct {
+	CacheCounts
+	Resources map[string]CacheCounts `json:"resources"`
+}
+
+// HitRatio returns the share of requests answered from the cache, including
+// revalidations, or 0 if there were none.
+func (c CacheCounts) HitRatio() float64 {
+	total := c.Hits + c.Revalidations + c.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(c.Hits+c.Revalidations) / float64(total)
+}
+
+// Cache keeps the responses for lookup resources that rarely change, such as
+// trackers, statuses, enumerations, roles, custom fields and versions. Enable
+// it with WithCache.
+//
+// Responses are reused until their TTL expires, then revalidated with
+// If-None-Match when Redmine sent an ETag. Writes through the client to a
+// cached resource, such as creating a version, drop the cached responses of
+// that resource. Entries are keyed by the credentials of the request, so a
+// Cache can be shared by clients of different users. It is safe for
+// concurrent use.
+type Cache struct {
+	opts    CacheOptions
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	counts  map[string]*CacheCounts
+	now     func() time.Time
+}
+
+type cacheEntry struct {
+	Key         string    `json:"key"`
+	Resource    string    `json:"resource"`
+	ETag        string    `json:"etag,omitempty"`
+	ContentType string    `json:"content_type,omitempty"`
+	Fetched     time.Time `json:"fetched"`
+	Body        []byte    `json:"body"`
+}
+
+// NewCache returns an empty Cache.
+func NewCache(opts *CacheOptions) *Cache {
+	c := &Cache{
+		entries: map[string]*cacheEntry{},
+		counts:  map[string]*CacheCounts{},
+		now:     time.Now,
+	}
+	if opts != nil {
+		c.opts = *opts
+	}
+	if c.opts.TTL <= 0 {
+		c.opts.TTL = 10 * time.Minute
+	}
+	return c
+}
+
+// Stats returns the counts of the cache since it was created.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := CacheStats{Resources: make(map[string]CacheCounts, len(c.counts))}
+	for resource, counts := range c.counts {
+		stats.Resources[resource] = *counts
+		stats.Hits += counts.Hits
+		stats.Revalidations += counts.Revalidations
+		stats.Misses += counts.Misses
+		stats.Invalidations += counts.Invalidations
+	}
+	return stats
+}
+
+// Invalidate drops the cached responses of the given resources, or of all
+// resources if none is given.
+func (c *Cache) Invalidate(resources ...string) {
+	if len(resources) == 0 {
+		for _, r := range cacheResources {
+			resources = append(resources, r.name)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, e := range c.entries {
+		if slices.Contains(resources, e.Resource) {
+			delete(c.entries, key)
+			c.count(e.Resource).Invalidations++
+		}
+	}
+	if c.opts.Dir != "" {
+		for _, resource := range resources {
+			//nolint:errcheck
+			os.RemoveAll(filepath.Join(c.opts.Dir, resource))
+		}
+	}
+}
+
+// get returns the cached response to req if it is fresh. Otherwise it returns
+// the stale entry, if any, and makes req conditional on its ETag.
+func (c *Cache) get(req *http.Request) (*http.Response, *cacheEntry) {
+	resource, key, ok := c.match(req)
+	if !ok || req.Method != http.MethodGet {
+		return nil, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e := c.entries[key]
+	if e == nil {
+		e = c.load(resource, key)
+	}
+	if e == nil {
+		return nil, nil
+	}
+	if c.now().Before(e.Fetched.Add(c.ttl(resource))) {
+		c.count(resource).Hits++
+		return e.response(req), nil
+	}
+	if e.ETag != "" {
+		req.Header.Set("If-None-Match", e.ETag)
+	}
+	return nil, e
+}
+
+// put records the response to req, which was sent after get returned stale.
+// It answers 304 responses from stale, caches successful responses to GET
+// requests, and invalidates the resource after successful writes.
+func (c *Cache) put(req *http.Request, resp *http.Response, stale *cacheEntry) (*http.Response, error) {
+	resource, key, ok := c.match(req)
+	if !ok {
+		return resp, nil
+	}
+	if req.Method != http.MethodGet {
+		if resp.StatusCode < 400 {
+			c.Invalidate(resource)
+		}
+		return resp, nil
+	}
+
+	if resp.StatusCode == http.StatusNotModified && stale != nil {
+		//nolint:errcheck
+		resp.Body.Close()
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		stale.Fetched = c.now()
+		c.entries[key] = stale
+		c.save(stale)
+		c.count(resource).Revalidations++
+		return stale.response(req), nil
+	}
+
+	c.mu.Lock()
+	c.count(resource).Misses++
+	c.mu.Unlock()
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	//nolint:errcheck
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e := &cacheEntry{
+		Key:         key,
+		Resource:    resource,
+		ETag:        resp.Header.Get("ETag"),
+		ContentType: resp.Header.Get("Content-Type"),
+		Fetched:     c.now(),
+		Body:        body,
+	}
+	c.entries[key] = e
+	c.save(e)
+	return resp, nil
+}
+
+// match returns the resource of req and its cache key, made of the URL and
+// a hash of the credentials.
+func (c *Cache) match(req *http.Request) (string, string, bool) {
+	i := slices.IndexFunc(cacheResources, func(r cacheResource) bool {
+		return r.path.MatchString(req.URL.Path)
+	})
+	if i < 0 || c.ttl(cacheResources[i].name) < 0 {
+		return "", "", false
+	}
+
+	h := sha256.New()
+	for _, name := range []string{"X-Redmine-Api-Key", "Authorization", "X-Redmine-Switch-User"} {
+		fmt.Fprintf(h, "%s=%s\n", name, req.Header.Get(name))
+	}
+	h.Write([]byte(req.URL.String()))
+	return cacheResources[i].name, hex.EncodeToString(h.Sum(nil)), true
+}
+
+func (c *Cache) ttl(resource string) time.Duration {
+	if ttl, ok := c.opts.TTLs[resource]; ok {
+		return ttl
+	}
+	return c.opts.TTL
+}
+
+// count returns the counts of resource. c.mu must be held.
+func (c *Cache) count(resource string) *CacheCounts {
+	counts := c.counts[resource]
+	if counts == nil {
+		counts = &CacheCounts{}
+		c.counts[resource] = counts
+	}
+	return counts
+}
+
+// load reads an entry from Dir. c.mu must be held.
+func (c *Cache) load(resource, key string) *cacheEntry {
+	if c.opts.Dir == "" {
+		return nil
+	}
+	data, err := os.ReadFile(filepath.Join(c.opts.Dir, resource, key+".json"))
+	if err != nil {
+		return nil
+	}
+	var e cacheEntry
+	if err := json.Unmarshal(data, &e); err != nil || e.Key != key {
+		return nil
+	}
+	c.entries[key] = &e
+	return &e
+}
+
+// save writes an entry to Dir. c.mu must be held.
+func (c *Cache) save(e *cacheEntry) {
+	if c.opts.Dir == "" {
+		return
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	dir := filepath.Join(c.opts.Dir, e.Resource)
+	//nolint:gosec // 0755 is appropriate for a cache directory
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return
+	}
+	//nolint:errcheck
+	writeFileAtomic(filepath.Join(dir, e.Key+".json"), data)
+}
+
+// response returns a response to req with the cached body.
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	header := http.Header{}
+	if e.ContentType != "" {
+		header.Set("Content-Type", e.ContentType)
+	}
+	if e.ETag != "" {
+		header.Set("ETag", e.ETag)
+	}
+	header.Set("Content-Length", strconv.Itoa(len(e.Body)))
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// writeFileAtomic writes data to a temporary file and renames it over path.
+func writeFileAtomic(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		//nolint:errcheck
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
@@ -0,0 +1,201 @@
+package redmine
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCacheTTLAndRevalidation(t *testing.T) {
+	var requests, conditional atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			conditional.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		//nolint:errcheck
+		w.Write([]byte(`{"trackers":[{"id":1,"name":"Bug"}]}`))
+	}))
+	defer server.Close()
+
+	now := time.Date(2024, time.May, 1, 9, 0, 0, 0, time.UTC)
+	cache := NewCache(&CacheOptions{TTL: time.Minute})
+	cache.now = func() time.Time { return now }
+	client := New(server.URL, "test-api-key", WithCache(cache))
+
+	for range 3 {
+		result, err := client.ListTrackers(context.Background())
+		if err != nil {
+			t.Fatalf("ListTrackers failed: %v", err)
+		}
+		if len(result.Trackers) != 1 || result.Trackers[0].Name != "Bug" {
+			t.Fatalf("Unexpected trackers: %+v", result.Trackers)
+		}
+	}
+	if requests.Load() != 1 {
+		t.Errorf("Expected 1 request within the TTL, got %d", requests.Load())
+	}
+
+	// Expired entries are revalidated, and served when unchanged
+	now = now.Add(2 * time.Minute)
+	result, err := client.ListTrackers(context.Background())
+	if err != nil {
+		t.Fatalf("ListTrackers failed: %v", err)
+	}
+	if len(result.Trackers) != 1 {
+		t.Errorf("Expected the cached trackers after revalidation, got %+v", result.Trackers)
+	}
+	if requests.Load() != 2 || conditional.Load() != 1 {
+		t.Errorf("Expected 1 conditional request, got %d requests, %d conditional", requests.Load(), conditional.Load())
+	}
+
+	// Revalidation renews the TTL
+	if _, err := client.ListTrackers(context.Background()); err != nil {
+		t.Fatalf("ListTrackers failed: %v", err)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("Expected no request after revalidation, got %d", requests.Load())
+	}
+
+	stats := cache.Stats()
+	if stats.Hits != 3 || stats.Revalidations != 1 || stats.Misses != 1 {
+		t.Errorf("Unexpected stats: %+v", stats.CacheCounts)
+	}
+	if stats.Resources[CacheTrackers] != stats.CacheCounts {
+		t.Errorf("Expected all counts for trackers, got %+v", stats.Resources)
+	}
+	if ratio := stats.HitRatio(); ratio != 0.8 {
+		t.Errorf("Expected hit ratio 0.8, got %v", ratio)
+	}
+}
+
+func TestCacheInvalidatesOnWrite(t *testing.T) {
+	var gets atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			gets.Add(1)
+			//nolint:errcheck
+			w.Write([]byte(`{"versions":[],"total_count":0}`))
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			//nolint:errcheck
+			w.Write([]byte(`{"version":{"id":3,"name":"1.0"}}`))
+		}
+	}))
+	defer server.Close()
+
+	cache := NewCache(nil)
+	client := New(server.URL, "test-api-key", WithCache(cache))
+	ctx := context.Background()
+
+	for range 2 {
+		if _, err := client.ListVersions(ctx, "foo"); err != nil {
+			t.Fatalf("ListVersions failed: %v", err)
+		}
+	}
+	if gets.Load() != 1 {
+		t.Fatalf("Expected 1 request, got %d", gets.Load())
+	}
+
+	if _, err := client.CreateVersion(ctx, "foo", Version{Name: "1.0"}); err != nil {
+		t.Fatalf("CreateVersion failed: %v", err)
+	}
+	if _, err := client.ListVersions(ctx, "foo"); err != nil {
+		t.Fatalf("ListVersions failed: %v", err)
+	}
+	if gets.Load() != 2 {
+		t.Errorf("Expected the write to invalidate the cache, got %d requests", gets.Load())
+	}
+	if stats := cache.Stats(); stats.Invalidations != 1 {
+		t.Errorf("Expected 1 invalidation, got %+v", stats.CacheCounts)
+	}
+
+	cache.Invalidate(CacheVersions)
+	if _, err := client.ListVersions(ctx, "foo"); err != nil {
+		t.Fatalf("ListVersions failed: %v", err)
+	}
+	if gets.Load() != 3 {
+		t.Errorf("Expected Invalidate to drop the entry, got %d requests", gets.Load())
+	}
+}
+
+func TestCacheKeys(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		//nolint:errcheck
+		w.Write([]byte(`{"issue_statuses":[]}`))
+	}))
+	defer server.Close()
+
+	cache := NewCache(&CacheOptions{TTLs: map[string]time.Duration{CacheTrackers: -1}})
+	alice := New(server.URL, "alice-key", WithCache(cache))
+	bob := New(server.URL, "bob-key", WithCache(cache))
+	ctx := context.Background()
+
+	for _, client := range []*Client{alice, bob, alice} {
+		if _, err := client.ListIssueStatuses(ctx); err != nil {
+			t.Fatalf("ListIssueStatuses failed: %v", err)
+		}
+	}
+	if requests.Load() != 2 {
+		t.Errorf("Expected 1 request per user, got %d", requests.Load())
+	}
+
+	// Disabled resources and other endpoints are not cached
+	for range 2 {
+		//nolint:errcheck
+		alice.ListTrackers(ctx)
+		//nolint:errcheck
+		alice.ListIssues(ctx, nil)
+	}
+	if requests.Load() != 6 {
+		t.Errorf("Expected uncached requests, got %d", requests.Load())
+	}
+}
+
+func TestCacheDir(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		//nolint:errcheck
+		w.Write([]byte(`{"roles":[{"id":3,"name":"Manager"}]}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	ctx := context.Background()
+	client := New(server.URL, "test-api-key", WithCache(NewCache(&CacheOptions{Dir: dir})))
+	if _, err := client.ListRoles(ctx); err != nil {
+		t.Fatalf("ListRoles failed: %v", err)
+	}
+
+	// A new cache on the same directory reuses the entry
+	cache := NewCache(&CacheOptions{Dir: dir})
+	client = New(server.URL, "test-api-key", WithCache(cache))
+	result, err := client.ListRoles(ctx)
+	if err != nil {
+		t.Fatalf("ListRoles failed: %v", err)
+	}
+	if len(result.Roles) != 1 || result.Roles[0].Name != "Manager" {
+		t.Errorf("Unexpected roles: %+v", result.Roles)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("Expected the entry to be read from disk, got %d requests", requests.Load())
+	}
+
+	cache.Invalidate()
+	if _, err := New(server.URL, "test-api-key", WithCache(NewCache(&CacheOptions{Dir: dir}))).ListRoles(ctx); err != nil {
+		t.Fatalf("ListRoles failed: %v", err)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("Expected Invalidate to remove the files, got %d requests", requests.Load())
+	}
+}
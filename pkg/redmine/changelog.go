@@ -0,0 +1,403 @@
+package redmine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// journalAttributeLabels are the labels Redmine uses for issue attributes in journals
+var journalAttributeLabels = map[string]string{
+	"project_id":       "Project",
+	"tracker_id":       "Tracker",
+	"subject":          "Subject",
+	"description":      "Description",
+	"status_id":        "Status",
+	"priority_id":      "Priority",
+	"assigned_to_id":   "Assignee",
+	"category_id":      "Category",
+	"fixed_version_id": "Target version",
+	"parent_id":        "Parent task",
+	"start_date":       "Start date",
+	"due_date":         "Due date",
+	"done_ratio":       "% Done",
+	"estimated_hours":  "Estimated time",
+	"is_private":       "Private",
+}
+
+// relationLabels are the labels Redmine uses for relation types
+var relationLabels = map[string]string{
+	RelationRelates:    "Related to",
+	RelationDuplicates: "Is duplicate of",
+	RelationDuplicated: "Has duplicate",
+	RelationBlocks:     "Blocks",
+	RelationBlocked:    "Blocked by",
+	RelationPrecedes:   "Precedes",
+	RelationFollows:    "Follows",
+	RelationCopiedTo:   "Copied to",
+	RelationCopiedFrom: "Copied from",
+}
+
+// ChangelogEntry is a journal rendered as readable lines.
+type ChangelogEntry struct {
+	ID        int       `json:"id"`
+	User      Resource  `json:"user"`
+	CreatedOn Timestamp `json:"created_on,omitzero"`
+	Notes     string    `json:"notes,omitempty"`
+	Changes   []string  `json:"changes,omitempty"`
+}
+
+// ChangelogResolver renders journal details as readable lines such as
+// "Status changed from New to In Progress", resolving IDs to names with the
+// trackers, statuses, priorities, users, versions, categories and custom field
+// definitions of the server. Names are looked up when first needed and cached.
+// IDs that cannot be looked up, for lack of permission for instance, are shown
+// as they are. A ChangelogResolver is safe for concurrent use.
+type ChangelogResolver struct {
+	client ChangelogClient
+
+	mu           sync.Mutex
+	names        map[string]string
+	loaded       map[string]bool
+	customFields map[int]CustomFieldDefinition
+}
+
+// ChangelogClient is the part of the API used by a ChangelogResolver. Client
+// satisfies it.
+type ChangelogClient interface {
+	ResolverClient
+	IssueCategoriesService
+	CustomFieldsService
+}
+
+// NewChangelogResolver returns a ChangelogResolver that looks up names with c.
+func NewChangelogResolver(c ChangelogClient) *ChangelogResolver {
+	return &ChangelogResolver{
+		client:       c,
+		names:        map[string]string{},
+		loaded:       map[string]bool{},
+		customFields: map[int]CustomFieldDefinition{},
+	}
+}
+
+// Changelog renders journals, remembering the names of their authors.
+func (r *ChangelogResolver) Changelog(ctx context.Context, journals []Journal) ([]ChangelogEntry, error) {
+	entries := make([]ChangelogEntry, 0, len(journals))
+	for _, j := range journals {
+		if j.User.ID != 0 && j.User.Name != "" {
+			r.remember("user", j.User.ID, j.User.Name)
+		}
+		changes, err := r.DescribeJournal(ctx, j)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, ChangelogEntry{
+			ID:        j.ID,
+			User:      j.User,
+			CreatedOn: j.CreatedOn,
+			Notes:     j.Notes,
+			Changes:   changes,
+		})
+	}
+	return entries, nil
+}
+
+// DescribeJournal renders each detail of j as a line.
+func (r *ChangelogResolver) DescribeJournal(ctx context.Context, j Journal) ([]string, error) {
+	lines := make([]string, 0, len(j.Details))
+	for _, d := range j.Details {
+		line, err := r.DescribeDetail(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// DescribeDetail renders d as a line. It fails only if a lookup fails for a
+// reason other than an error response from Redmine, such as a cancelled context.
+func (r *ChangelogResolver) DescribeDetail(ctx context.Context, d JournalDetail) (string, error) {
+	switch d.Property {
+	case JournalPropertyAttr:
+		label, ok := journalAttributeLabels[d.Name]
+		if !ok {
+			label = d.Name
+		}
+		if d.Name == "description" {
+			return label + " updated", nil
+		}
+		oldValue, err := r.attributeValue(ctx, d.Name, d.OldValue)
+		if err != nil {
+			return "", err
+		}
+		newValue, err := r.attributeValue(ctx, d.Name, d.NewValue)
+		if err != nil {
+			return "", err
+		}
+		return describeChange(label, oldValue, newValue), nil
+
+	case JournalPropertyCustomField:
+		id, err := strconv.Atoi(d.Name)
+		if err != nil {
+			return describeChange(d.Name, d.OldValue, d.NewValue), nil
+		}
+		def, err := r.customField(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		label := def.Name
+		if label == "" {
+			label = "Custom field #" + d.Name
+		}
+		oldValue, err := r.customFieldValue(ctx, def, d.OldValue)
+		if err != nil {
+			return "", err
+		}
+		newValue, err := r.customFieldValue(ctx, def, d.NewValue)
+		if err != nil {
+			return "", err
+		}
+		return describeChange(label, oldValue, newValue), nil
+
+	case JournalPropertyRelation:
+		label, ok := relationLabels[d.Name]
+		if !ok {
+			label = d.Name
+		}
+		if d.NewValue != "" {
+			return fmt.Sprintf("%s #%s added", label, d.NewValue), nil
+		}
+		return fmt.Sprintf("%s #%s deleted", label, d.OldValue), nil
+
+	case JournalPropertyAttachment:
+		if d.NewValue != "" {
+			return fmt.Sprintf("File %s added", d.NewValue), nil
+		}
+		return fmt.Sprintf("File %s deleted", d.OldValue), nil
+
+	default:
+		return describeChange(d.Property+"."+d.Name, d.OldValue, d.NewValue), nil
+	}
+}
+
+// describeChange phrases a change the way Redmine does.
+func describeChange(label, oldValue, newValue string) string {
+	switch {
+	case oldValue == "":
+		return fmt.Sprintf("%s set to %s", label, newValue)
+	case newValue == "":
+		return fmt.Sprintf("%s deleted (%s)", label, oldValue)
+	default:
+		return fmt.Sprintf("%s changed from %s to %s", label, oldValue, newValue)
+	}
+}
+
+func (r *ChangelogResolver) attributeValue(ctx context.Context, attribute, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	switch attribute {
+	case "is_private":
+		return yesNo(value), nil
+	case "parent_id":
+		return "#" + value, nil
+	case "done_ratio":
+		return value + "%", nil
+	}
+
+	kind, ok := strings.CutSuffix(attribute, "_id")
+	if !ok {
+		return value, nil
+	}
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		return value, nil
+	}
+	if kind == "fixed_version" {
+		kind = "version"
+	}
+	if kind == "assigned_to" {
+		kind = "user"
+	}
+	return r.name(ctx, kind, id)
+}
+
+func (r *ChangelogResolver) customFieldValue(ctx context.Context, def CustomFieldDefinition, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	switch def.FieldFormat {
+	case "bool":
+		return yesNo(value), nil
+	case "user", "version":
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			return value, nil
+		}
+		return r.name(ctx, def.FieldFormat, id)
+	case "enumeration", "list":
+		for _, pv := range def.PossibleValues {
+			if pv.Value == value && pv.Label != "" {
+				return pv.Label, nil
+			}
+		}
+	}
+	return value, nil
+}
+
+func yesNo(value string) string {
+	if value == "1" || value == "true" {
+		return "Yes"
+	}
+	return "No"
+}
+
+// name returns the name of the kind of object with id, or id itself if it
+// cannot be looked up.
+func (r *ChangelogResolver) name(ctx context.Context, kind string, id int) (string, error) {
+	key := kind + ":" + strconv.Itoa(id)
+
+	r.mu.Lock()
+	name, ok := r.names[key]
+	loaded := r.loaded[kind]
+	r.mu.Unlock()
+	if ok {
+		return name, nil
+	}
+
+	name, err := r.lookup(ctx, kind, id, loaded)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		name, err = "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if name == "" {
+		name = strconv.Itoa(id)
+	}
+	r.remember(kind, id, name)
+	return name, nil
+}
+
+// lookup fetches the name of an object. Kinds with a list endpoint are loaded
+// in full the first time.
+func (r *ChangelogResolver) lookup(ctx context.Context, kind string, id int, loaded bool) (string, error) {
+	switch kind {
+	case "tracker", "status", "priority":
+		if loaded {
+			return "", nil
+		}
+		if err := r.load(ctx, kind); err != nil {
+			return "", err
+		}
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return r.names[kind+":"+strconv.Itoa(id)], nil
+	case "user":
+		result, err := r.client.ShowUser(ctx, id, nil)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(result.User.Firstname + " " + result.User.Lastname), nil
+	case "version":
+		result, err := r.client.ShowVersion(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return result.Version.Name, nil
+	case "category":
+		result, err := r.client.ShowIssueCategory(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return result.IssueCategory.Name, nil
+	case "project":
+		result, err := r.client.ShowProject(ctx, strconv.Itoa(id), nil)
+		if err != nil {
+			return "", err
+		}
+		return result.Project.Name, nil
+	default:
+		return "", nil
+	}
+}
+
+func (r *ChangelogResolver) load(ctx context.Context, kind string) error {
+	names := map[int]string{}
+	switch kind {
+	case "tracker":
+		result, err := r.client.ListTrackers(ctx)
+		if err != nil {
+			return err
+		}
+		for _, t := range result.Trackers {
+			names[t.ID] = t.Name
+		}
+	case "status":
+		result, err := r.client.ListIssueStatuses(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range result.IssueStatuses {
+			names[s.ID] = s.Name
+		}
+	case "priority":
+		result, err := r.client.ListIssuePriorities(ctx)
+		if err != nil {
+			return err
+		}
+		for _, p := range result.Enumerations {
+			names[p.ID] = p.Name
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, name := range names {
+		r.names[kind+":"+strconv.Itoa(id)] = name
+	}
+	r.loaded[kind] = true
+	return nil
+}
+
+// customField returns the definition of a custom field, or one with only the
+// ID set if the definitions cannot be listed, which requires admin privileges.
+func (r *ChangelogResolver) customField(ctx context.Context, id int) (CustomFieldDefinition, error) {
+	r.mu.Lock()
+	loaded := r.loaded["custom_field"]
+	r.mu.Unlock()
+
+	if !loaded {
+		result, err := r.client.ListCustomFields(ctx)
+		var apiErr *APIError
+		if err != nil && !errors.As(err, &apiErr) {
+			return CustomFieldDefinition{}, err
+		}
+		r.mu.Lock()
+		if result != nil {
+			for _, def := range result.CustomFields {
+				r.customFields[def.ID] = def
+			}
+		}
+		r.loaded["custom_field"] = true
+		r.mu.Unlock()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if def, ok := r.customFields[id]; ok {
+		return def, nil
+	}
+	return CustomFieldDefinition{ID: id}, nil
+}
+
+func (r *ChangelogResolver) remember(kind string, id int, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.names[kind+":"+strconv.Itoa(id)] = name
+}
@@ -0,0 +1,114 @@
+package redmine
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestChangelogResolver(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/issue_statuses.json":
+			_, _ = w.Write([]byte(`{"issue_statuses":[{"id":1,"name":"New"},{"id":2,"name":"In Progress"}]}`))
+		case "/users/5.json":
+			_, _ = w.Write([]byte(`{"user":{"id":5,"firstname":"John","lastname":"Smith"}}`))
+		case "/versions/3.json":
+			_, _ = w.Write([]byte(`{"version":{"id":3,"name":"1.0"}}`))
+		case "/custom_fields.json":
+			_, _ = w.Write([]byte(`{"custom_fields":[
+				{"id":4,"name":"Severity","field_format":"enumeration","possible_values":[{"value":"10","label":"High"}]},
+				{"id":6,"name":"Reviewed","field_format":"bool"}
+			]}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	resolver := NewChangelogResolver(client)
+
+	journals := []Journal{
+		{ID: 1, User: Resource{ID: 9, Name: "Jane Doe"}, Notes: "Started", Details: []JournalDetail{
+			{Property: JournalPropertyAttr, Name: "status_id", OldValue: "1", NewValue: "2"},
+			{Property: JournalPropertyAttr, Name: "assigned_to_id", NewValue: "5"},
+			{Property: JournalPropertyAttr, Name: "fixed_version_id", OldValue: "3"},
+			{Property: JournalPropertyAttr, Name: "description", OldValue: "a", NewValue: "b"},
+			{Property: JournalPropertyAttr, Name: "category_id", OldValue: "7", NewValue: "8"},
+		}},
+		{ID: 2, User: Resource{ID: 5, Name: "John Smith"}, Details: []JournalDetail{
+			{Property: JournalPropertyAttr, Name: "assigned_to_id", OldValue: "5", NewValue: "9"},
+			{Property: JournalPropertyCustomField, Name: "4", NewValue: "10"},
+			{Property: JournalPropertyCustomField, Name: "6", OldValue: "0", NewValue: "1"},
+			{Property: JournalPropertyCustomField, Name: "99", OldValue: "x", NewValue: "y"},
+			{Property: JournalPropertyRelation, Name: RelationBlocks, NewValue: "12"},
+			{Property: JournalPropertyAttachment, Name: "30", OldValue: "log.txt"},
+		}},
+	}
+
+	entries, err := resolver.Changelog(context.Background(), journals)
+	if err != nil {
+		t.Fatalf("Changelog failed: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+
+	want := []string{
+		"Status changed from New to In Progress",
+		"Assignee set to John Smith",
+		"Target version deleted (1.0)",
+		"Description updated",
+		"Category changed from 7 to 8",
+	}
+	if !slices.Equal(entries[0].Changes, want) {
+		t.Errorf("Expected %q, got %q", want, entries[0].Changes)
+	}
+	if entries[0].Notes != "Started" || entries[0].User.Name != "Jane Doe" {
+		t.Errorf("Unexpected entry: %+v", entries[0])
+	}
+
+	want = []string{
+		"Assignee changed from John Smith to Jane Doe",
+		"Severity set to High",
+		"Reviewed changed from No to Yes",
+		"Custom field #99 changed from x to y",
+		"Blocks #12 added",
+		"File log.txt deleted",
+	}
+	if !slices.Equal(entries[1].Changes, want) {
+		t.Errorf("Expected %q, got %q", want, entries[1].Changes)
+	}
+
+	// Names are cached, and the author of a journal is known without a lookup
+	for path, n := range requests {
+		if n != 1 {
+			t.Errorf("Expected 1 request to %s, got %d", path, n)
+		}
+	}
+	if requests["/users/9.json"] != 0 {
+		t.Errorf("Expected no lookup of journal author, got %d", requests["/users/9.json"])
+	}
+}
+
+func TestChangelogResolverCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"issue_statuses":[]}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	resolver := NewChangelogResolver(New(server.URL, "test-api-key"))
+	_, err := resolver.DescribeDetail(ctx, JournalDetail{Property: JournalPropertyAttr, Name: "status_id", NewValue: "1"})
+	if err == nil {
+		t.Error("Expected error for cancelled context, got nil")
+	}
+}
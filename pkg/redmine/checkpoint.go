@@ -0,0 +1,110 @@
+package redmine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Checkpoint is what a ChangeFeed remembers between polls.
+type Checkpoint struct {
+	// Sources maps the key of each WatchSource to its state.
+	Sources map[string]*SourceState `json:"sources"`
+}
+
+// SourceState is the last known state of a WatchSource.
+type SourceState struct {
+	// Since is the latest update seen, from which the next poll lists changes.
+	Since Timestamp `json:"since,omitzero"`
+	// Polls counts the polls since the last full listing.
+	Polls int `json:"polls,omitempty"`
+	// Items maps the key of each known item to its field values.
+	Items map[string]map[string]string `json:"items"`
+}
+
+// CheckpointStore persists the Checkpoint of a ChangeFeed.
+type CheckpointStore interface {
+	// Load returns the saved checkpoint, or nil if none was saved.
+	Load(ctx context.Context) (*Checkpoint, error)
+	Save(ctx context.Context, cp *Checkpoint) error
+}
+
+// MemoryCheckpointStore keeps a checkpoint in memory. It is safe for concurrent use.
+type MemoryCheckpointStore struct {
+	mu   sync.Mutex
+	data []byte
+}
+
+// NewMemoryCheckpointStore returns an empty MemoryCheckpointStore.
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{}
+}
+
+// Load returns a copy of the saved checkpoint.
+func (s *MemoryCheckpointStore) Load(ctx context.Context) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.data == nil {
+		return nil, nil
+	}
+	var cp Checkpoint
+	if err := json.Unmarshal(s.data, &cp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal checkpoint: %w", err)
+	}
+	return &cp, nil
+}
+
+// Save stores a copy of cp.
+func (s *MemoryCheckpointStore) Save(ctx context.Context, cp *Checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return fmt.Errorf("failed to marshal checkpoint: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data = data
+	return nil
+}
+
+// FileCheckpointStore keeps a checkpoint in a JSON file, replaced atomically on save.
+type FileCheckpointStore struct {
+	Path string
+}
+
+// NewFileCheckpointStore returns a FileCheckpointStore writing to path.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{Path: path}
+}
+
+// Load reads the checkpoint file, returning nil if it does not exist.
+func (s *FileCheckpointStore) Load(ctx context.Context) (*Checkpoint, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	var cp Checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal checkpoint: %w", err)
+	}
+	return &cp, nil
+}
+
+// Save writes cp to a temporary file and renames it over the checkpoint file.
+func (s *FileCheckpointStore) Save(ctx context.Context, cp *Checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return fmt.Errorf("failed to marshal checkpoint: %w", err)
+	}
+
+	if err := writeFileAtomic(s.Path, data); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package redmine
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckpointStores(t *testing.T) {
+	stores := map[string]CheckpointStore{
+		"memory": NewMemoryCheckpointStore(),
+		"file":   NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json")),
+	}
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			cp, err := store.Load(ctx)
+			if err != nil || cp != nil {
+				t.Fatalf("Expected no checkpoint, got %v, %v", cp, err)
+			}
+
+			saved := &Checkpoint{Sources: map[string]*SourceState{
+				"issues": {Since: feedTime(5), Polls: 2, Items: map[string]map[string]string{"1": {"status_id": "2"}}},
+			}}
+			if err := store.Save(ctx, saved); err != nil {
+				t.Fatalf("Save failed: %v", err)
+			}
+
+			// Changes after saving are not persisted
+			saved.Sources["issues"].Items["1"]["status_id"] = "3"
+
+			cp, err = store.Load(ctx)
+			if err != nil {
+				t.Fatalf("Load failed: %v", err)
+			}
+			state := cp.Sources["issues"]
+			if state == nil || !state.Since.Equal(feedTime(5).Time) || state.Polls != 2 || state.Items["1"]["status_id"] != "2" {
+				t.Errorf("Unexpected checkpoint: %+v", state)
+			}
+		})
+	}
+}
@@ -2,50 +2,170 @@ package redmine
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 )
 
 type Client struct {
-	baseURL string
-	apiKey  string
+	baseURL   string
+	apiKey    string
+	userAgent string
+	auth      Authenticator
+	headers   http.Header
+	logger    *slog.Logger
+	prefetch  int
+	feedKey   string
+	cache     *Cache
+	timeout   time.Duration
+	transport http.RoundTripper
 
 	HTTPClient *http.Client
+	// Retry configures automatic retries. Requests are not retried when nil.
+	Retry *RetryPolicy
 }
 
-func New(endpoint string, apiKey string) *Client {
-	return &Client{
+func New(endpoint string, apiKey string, opts ...Option) *Client {
+	c := &Client{
 		baseURL:    strings.TrimSuffix(endpoint, "/"),
 		apiKey:     apiKey,
+		headers:    http.Header{},
 		HTTPClient: &http.Client{},
 	}
+	if apiKey != "" {
+		c.auth = APIKeyAuth(apiKey)
+	} else {
+		c.auth = AnonymousAuth()
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	// The HTTP client may be shared, such as http.DefaultClient, so it is
+	// copied rather than changed
+	if c.timeout != 0 || c.transport != nil {
+		hc := *c.HTTPClient
+		if c.timeout != 0 {
+			hc.Timeout = c.timeout
+		}
+		if c.transport != nil {
+			hc.Transport = c.transport
+		}
+		c.HTTPClient = &hc
+	}
+	return c
 }
 
 func (c *Client) do(ctx context.Context, method string, url string, body io.Reader) (*http.Response, error) {
+	req, err := c.newRequest(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	return c.doRequest(req)
+}
+
+// newRequest builds a JSON API request carrying the client's headers and credentials.
+func (c *Client) newRequest(ctx context.Context, method string, url string, body io.Reader) (*http.Request, error) {
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("X-Redmine-Api-Key", c.apiKey)
+	for key, values := range c.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	c.auth.Authenticate(req)
+	if login, ok := SwitchUserFromContext(ctx); ok {
+		req.Header.Set("X-Redmine-Switch-User", login)
+	}
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.HTTPClient.Do(req)
+	return req, nil
+}
+
+// doRequest sends req and turns error statuses into an *APIError.
+func (c *Client) doRequest(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	var stale *cacheEntry
+	if c.cache != nil {
+		var resp *http.Response
+		if resp, stale = c.cache.get(req); resp != nil {
+			if c.logger != nil {
+				c.logger.LogAttrs(ctx, slog.LevelDebug, "redmine cache hit",
+					slog.String("method", req.Method), slog.String("url", redactURL(req.URL)))
+			}
+			return resp, nil
+		}
+	}
+
+	start := time.Now()
+	resp, err := c.send(req)
 	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			urlErr.URL = redactURL(req.URL)
+		}
+		c.logRequest(ctx, req, 0, time.Since(start), err)
 		return nil, fmt.Errorf("failed to request: %w", err)
 	}
+	c.logRequest(ctx, req, resp.StatusCode, time.Since(start), nil)
+
+	if c.cache != nil {
+		if resp, err = c.cache.put(req, resp, stale); err != nil {
+			return nil, err
+		}
+	}
 
 	if resp.StatusCode >= 400 {
-		b, err := io.ReadAll(resp.Body)
 		//nolint:errcheck
 		defer resp.Body.Close()
-		if err != nil {
-			return nil, fmt.Errorf("failed to read response body: %w", err)
-		}
 
-		return nil, fmt.Errorf("failed to request: %s", b)
+		return nil, newAPIError(resp)
 	}
 
 	return resp, nil
 }
+
+// logRequest records a finished request when a logger is configured.
+func (c *Client) logRequest(ctx context.Context, req *http.Request, status int, elapsed time.Duration, err error) {
+	if c.logger == nil {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", redactURL(req.URL)),
+		slog.Duration("elapsed", elapsed),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		c.logger.LogAttrs(ctx, slog.LevelDebug, "redmine request failed", attrs...)
+		return
+	}
+	attrs = append(attrs, slog.Int("status", status))
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "redmine request", attrs...)
+}
+
+// redactURL returns u as a string with the value of the key parameter, which
+// carries the Atom feed key, hidden.
+func redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	params := u.Query()
+	if !params.Has("key") {
+		return u.String()
+	}
+	params.Set("key", "REDACTED")
+	redacted := *u
+	redacted.RawQuery = params.Encode()
+	return redacted.String()
+}
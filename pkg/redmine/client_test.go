@@ -1,10 +1,17 @@
 package redmine
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestNew(t *testing.T) {
@@ -108,3 +115,138 @@ func TestClientDoSuccess(t *testing.T) {
 		t.Errorf("Expected status code 200, got %d", resp.StatusCode)
 	}
 }
+
+func TestNewWithOptions(t *testing.T) {
+	httpClient := &http.Client{}
+	client := New("https://example.com", "test-api-key",
+		WithHTTPClient(httpClient),
+		WithTimeout(5*time.Second),
+		WithRetry(DefaultRetryPolicy()),
+	)
+
+	if client.HTTPClient.Timeout != 5*time.Second {
+		t.Errorf("Expected timeout 5s, got %v", client.HTTPClient.Timeout)
+	}
+	if client.Retry == nil {
+		t.Error("Expected retry policy to be set")
+	}
+}
+
+func TestNewWithHTTPClientOptionOrder(t *testing.T) {
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("unused")
+	})
+	jar := &fakeJar{}
+
+	tests := []struct {
+		name string
+		opts func(hc *http.Client) []Option
+	}{
+		{name: "client first", opts: func(hc *http.Client) []Option {
+			return []Option{WithHTTPClient(hc), WithTimeout(5 * time.Second), WithTransport(transport)}
+		}},
+		{name: "client last", opts: func(hc *http.Client) []Option {
+			return []Option{WithTimeout(5 * time.Second), WithTransport(transport), WithHTTPClient(hc)}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hc := &http.Client{Timeout: time.Minute, Jar: jar}
+			client := New("https://example.com", "test-api-key", tt.opts(hc)...)
+
+			if client.HTTPClient.Timeout != 5*time.Second {
+				t.Errorf("Expected timeout 5s, got %v", client.HTTPClient.Timeout)
+			}
+			if client.HTTPClient.Transport == nil {
+				t.Error("Expected the transport to be set")
+			}
+			if client.HTTPClient.Jar != jar {
+				t.Error("Expected the other settings of the HTTP client to be kept")
+			}
+			if hc.Timeout != time.Minute || hc.Transport != nil {
+				t.Errorf("Expected the caller's HTTP client to be left untouched, got timeout %v and transport %v", hc.Timeout, hc.Transport)
+			}
+		})
+	}
+
+	// The process-wide default client is shared, and used as it is without these options
+	New("https://example.com", "", WithHTTPClient(http.DefaultClient), WithTransport(transport))
+	if http.DefaultClient.Transport != nil {
+		t.Error("Expected http.DefaultClient to be left untouched")
+	}
+	if client := New("https://example.com", "", WithHTTPClient(http.DefaultClient)); client.HTTPClient != http.DefaultClient {
+		t.Error("Expected the HTTP client to be used as it is")
+	}
+}
+
+// fakeJar is a cookie jar that stores nothing.
+type fakeJar struct{}
+
+func (*fakeJar) SetCookies(*url.URL, []*http.Cookie) {}
+
+func (*fakeJar) Cookies(*url.URL) []*http.Cookie {
+	return nil
+}
+
+func TestClientDoSendsConfiguredHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ua := r.Header.Get("User-Agent"); ua != "redmine-test/1.0" {
+			t.Errorf("Expected User-Agent redmine-test/1.0, got %s", ua)
+		}
+		if v := r.Header.Get("X-Custom"); v != "custom-value" {
+			t.Errorf("Expected X-Custom: custom-value, got %s", v)
+		}
+		if apiKey := r.Header.Get("X-Redmine-Api-Key"); apiKey != "test-api-key" {
+			t.Errorf("Expected X-Redmine-API-Key: test-api-key, got %s", apiKey)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client := New(server.URL, "test-api-key",
+		WithUserAgent("redmine-test/1.0"),
+		WithHeader("X-Custom", "custom-value"),
+		WithLogger(logger),
+	)
+	resp, err := client.do(context.Background(), http.MethodGet, server.URL+"/test", nil)
+	if err != nil {
+		t.Fatalf("do() failed: %v", err)
+	}
+	//nolint:errcheck
+	defer resp.Body.Close()
+
+	if !strings.Contains(logs.String(), "status=200") {
+		t.Errorf("Expected request to be logged, got %q", logs.String())
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewWithTransport(t *testing.T) {
+	called := false
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		called = true
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"issues":[]}`)),
+			Header:     http.Header{},
+			Request:    req,
+		}, nil
+	})
+
+	client := New("https://example.com", "test-api-key", WithTransport(transport))
+	if _, err := client.ListIssues(context.Background(), nil); err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+	if !called {
+		t.Error("Expected custom transport to be used")
+	}
+}
@@ -0,0 +1,153 @@
+package redmine
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+)
+
+// ConflictError is returned when a write is refused because the resource
+// changed since it was read. It matches ErrConflict with errors.Is.
+type ConflictError struct {
+	// Resource names the resource, such as `issue #42` or `wiki page "Guide"`.
+	Resource string
+	// Version is the current version of a wiki page.
+	Version int
+	// UpdatedOn is when the resource was last changed on the server.
+	UpdatedOn Timestamp
+	// Issue is the current issue, for issue conflicts.
+	Issue *Issue
+	// Err is the error Redmine responded with, if any.
+	Err error
+}
+
+func (e *ConflictError) Error() string {
+	msg := fmt.Sprintf("%s: %s was changed by someone else", ErrConflict, e.Resource)
+	if e.Version > 0 {
+		msg += fmt.Sprintf(", current version is %d", e.Version)
+	}
+	if !e.UpdatedOn.IsZero() {
+		msg += ", updated on " + e.UpdatedOn.String()
+	}
+	return msg
+}
+
+// Is reports whether target is ErrConflict.
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// wikiConflict turns a 409 response to a wiki page update into a *ConflictError
+// carrying the current version of the page.
+func (c *Client) wikiConflict(ctx context.Context, projectIDOrIdentifier string, pageName string, err error) error {
+	conflict := &ConflictError{Resource: fmt.Sprintf("wiki page %q", pageName), Err: err}
+	if current, getErr := c.GetWikiPage(ctx, projectIDOrIdentifier, pageName, nil); getErr == nil {
+		conflict.Version = current.WikiPage.Version
+		conflict.UpdatedOn = current.WikiPage.UpdatedOn
+	}
+	return conflict
+}
+
+// UpdateIssueIfUnmodified updates an issue only if it has not changed since it
+// was read. lastUpdatedOn is the UpdatedOn of the issue the change is based on.
+// If the issue has changed, it returns a *ConflictError holding the current
+// issue, which MergeIssueUpdate can rebase req onto.
+//
+// Redmine offers no conditional update for issues, so the check is made with a
+// read just before the write and cannot rule out a write in between.
+func (c *Client) UpdateIssueIfUnmodified(ctx context.Context, id int, lastUpdatedOn Timestamp, req IssueUpdateRequest) error {
+	current, err := c.ShowIssue(ctx, id, nil)
+	if err != nil {
+		return err
+	}
+
+	issue := current.Issue
+	if !issue.UpdatedOn.Equal(lastUpdatedOn.Time) {
+		return &ConflictError{Resource: "issue #" + strconv.Itoa(id), UpdatedOn: issue.UpdatedOn, Issue: &issue}
+	}
+
+	return c.UpdateIssue(ctx, id, req)
+}
+
+// issueFieldValues reads the current value of each IssueUpdateRequest field from an Issue
+var issueFieldValues = map[string]func(Issue) any{
+	"project_id":       func(i Issue) any { return i.Project.ID },
+	"tracker_id":       func(i Issue) any { return i.Tracker.ID },
+	"subject":          func(i Issue) any { return i.Subject },
+	"status_id":        func(i Issue) any { return i.Status.ID },
+	"priority_id":      func(i Issue) any { return i.Priority.ID },
+	"category_id":      func(i Issue) any { return i.Category.ID },
+	"fixed_version_id": func(i Issue) any { return i.FixedVersion.ID },
+	"assigned_to_id":   func(i Issue) any { return i.AssignedTo.ID },
+	"parent_issue_id":  func(i Issue) any { return i.Parent.ID },
+	"description":      func(i Issue) any { return i.Description },
+	"start_date":       func(i Issue) any { return i.StartDate },
+	"due_date":         func(i Issue) any { return i.DueDate },
+	"done_ratio":       func(i Issue) any { return i.DoneRatio },
+	"estimated_hours":  func(i Issue) any { return i.EstimatedHours },
+	"is_private":       func(i Issue) any { return i.IsPrivate },
+}
+
+// MergeIssueUpdate rebases req, a change made to base, onto current, the issue
+// as it is now. Fields that req changes and that were not changed since base
+// are kept, as are notes and uploads. Fields that were changed since base to a
+// value other than the one in req are dropped from the returned request and
+// reported as conflicts, by JSON key or, for custom fields, as "cf_<id>".
+func MergeIssueUpdate(base, current Issue, req IssueUpdateRequest) (IssueUpdateRequest, []string) {
+	merged := req
+	merged.ForceSendFields = slices.Clone(req.ForceSendFields)
+	merged.ClearFields = slices.Clone(req.ClearFields)
+	merged.CustomFields = nil
+
+	var conflicts []string
+	fields := jsonFields(reflect.ValueOf(&merged).Elem())
+	for _, key := range slices.Sorted(maps.Keys(issueFieldValues)) {
+		value := issueFieldValues[key]
+		field := fields[key]
+		cleared := slices.Contains(req.ClearFields, key)
+		if field.IsZero() && !cleared && !slices.Contains(req.ForceSendFields, key) {
+			continue
+		}
+
+		mine := fmt.Sprint(field.Interface())
+		if cleared {
+			mine = fmt.Sprint(reflect.Zero(field.Type()).Interface())
+		}
+		theirs := fmt.Sprint(value(current))
+		if fmt.Sprint(value(base)) == theirs || theirs == mine {
+			continue
+		}
+
+		conflicts = append(conflicts, key)
+		field.SetZero()
+		merged.ForceSendFields = slices.DeleteFunc(merged.ForceSendFields, func(s string) bool { return s == key })
+		merged.ClearFields = slices.DeleteFunc(merged.ClearFields, func(s string) bool { return s == key })
+	}
+
+	for _, cf := range req.CustomFields {
+		mine := cf.StringValue()
+		theirs := issueCustomFieldValue(current, cf.ID)
+		if issueCustomFieldValue(base, cf.ID) == theirs || theirs == mine {
+			merged.CustomFields = append(merged.CustomFields, cf)
+			continue
+		}
+		conflicts = append(conflicts, CustomFieldFilter(cf.ID))
+	}
+
+	return merged, conflicts
+}
+
+func issueCustomFieldValue(issue Issue, id int) string {
+	i := slices.IndexFunc(issue.CustomFields, func(cf CustomField) bool { return cf.ID == id })
+	if i < 0 {
+		return ""
+	}
+	return issue.CustomFields[i].StringValue()
+}
@@ -0,0 +1,129 @@
+package redmine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCreateOrUpdateWikiPageConflict(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(WikiPageResponse{WikiPage: WikiPage{Title: "Guide", Version: 7}})
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	err := client.CreateOrUpdateWikiPage(context.Background(), "docs", "Guide", WikiPageUpdate{Text: "Edit", Version: 5})
+
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("Expected ErrConflict, got %v", err)
+	}
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("Expected *ConflictError, got %T", err)
+	}
+	if conflict.Version != 7 {
+		t.Errorf("Expected current version 7, got %d", conflict.Version)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusConflict {
+		t.Errorf("Expected wrapped *APIError with status 409, got %v", err)
+	}
+}
+
+func TestUpdateIssueIfUnmodified(t *testing.T) {
+	updatedOn := Timestamp{time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)}
+
+	var puts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			puts++
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(IssueResponse{Issue: Issue{ID: 1, Subject: "Current", UpdatedOn: updatedOn}})
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	req := IssueUpdateRequest{Subject: "Mine"}
+
+	if err := client.UpdateIssueIfUnmodified(context.Background(), 1, updatedOn, req); err != nil {
+		t.Fatalf("UpdateIssueIfUnmodified failed: %v", err)
+	}
+	if puts != 1 {
+		t.Errorf("Expected 1 update, got %d", puts)
+	}
+
+	stale := Timestamp{updatedOn.Add(-time.Hour)}
+	err := client.UpdateIssueIfUnmodified(context.Background(), 1, stale, req)
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("Expected ErrConflict, got %v", err)
+	}
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) || conflict.Issue == nil || conflict.Issue.Subject != "Current" {
+		t.Errorf("Expected conflict with current issue, got %v", err)
+	}
+	if puts != 1 {
+		t.Errorf("Expected no update on conflict, got %d updates", puts)
+	}
+}
+
+func TestMergeIssueUpdate(t *testing.T) {
+	base := Issue{
+		Subject:    "Login fails",
+		Status:     Resource{ID: 1},
+		AssignedTo: Resource{ID: 3},
+		DueDate:    NewDate(2024, time.June, 1),
+		DoneRatio:  20,
+		CustomFields: []CustomField{
+			{ID: 5, Value: "Low"},
+			{ID: 6, Value: "Linux"},
+		},
+	}
+
+	// Someone else changed the status, due date and custom field 5
+	current := base
+	current.Status = Resource{ID: 2}
+	current.DueDate = NewDate(2024, time.June, 15)
+	current.CustomFields = []CustomField{{ID: 5, Value: "High"}, {ID: 6, Value: "Linux"}}
+
+	req := IssueUpdateRequest{
+		Subject:         "Login fails on Safari",
+		StatusID:        2,
+		DueDate:         NewDate(2024, time.June, 10),
+		Notes:           "Reproduced",
+		ForceSendFields: []string{"done_ratio"},
+		ClearFields:     []string{"assigned_to_id"},
+		CustomFields:    []CustomField{{ID: 5, Value: "Medium"}, {ID: 6, Value: "macOS"}},
+	}
+
+	merged, conflicts := MergeIssueUpdate(base, current, req)
+
+	if !slices.Equal(conflicts, []string{"due_date", "cf_5"}) {
+		t.Errorf("Expected conflicts [due_date cf_5], got %v", conflicts)
+	}
+	if !merged.DueDate.IsZero() {
+		t.Errorf("Expected conflicting due date to be dropped, got %s", merged.DueDate)
+	}
+	if merged.Subject != "Login fails on Safari" || merged.StatusID != 2 || merged.Notes != "Reproduced" {
+		t.Errorf("Expected non-conflicting changes to be kept, got %+v", merged)
+	}
+	if !slices.Equal(merged.ForceSendFields, []string{"done_ratio"}) || !slices.Equal(merged.ClearFields, []string{"assigned_to_id"}) {
+		t.Errorf("Expected ForceSendFields and ClearFields to be kept, got %v and %v", merged.ForceSendFields, merged.ClearFields)
+	}
+	if len(merged.CustomFields) != 1 || merged.CustomFields[0].ID != 6 {
+		t.Errorf("Expected only custom field 6, got %v", merged.CustomFields)
+	}
+}
@@ -8,21 +8,44 @@ import (
 	"net/http"
 )
 
+// CustomFieldDefinition describes a custom field. FieldFormat is one of
+// "string", "text", "link", "int", "float", "date", "bool", "list", "user",
+// "version", "enumeration" or "attachment". Trackers lists the trackers an
+// issue custom field is enabled for.
 type CustomFieldDefinition struct {
-	ID             int      `json:"id,omitempty"`
-	Name           string   `json:"name,omitempty"`
-	CustomizedType string   `json:"customized_type,omitempty"`
-	FieldFormat    string   `json:"field_format,omitempty"`
-	Regexp         string   `json:"regexp,omitempty"`
-	MinLength      int      `json:"min_length,omitempty"`
-	MaxLength      int      `json:"max_length,omitempty"`
-	IsRequired     bool     `json:"is_required,omitempty"`
-	IsFilter       bool     `json:"is_filter,omitempty"`
-	Searchable     bool     `json:"searchable,omitempty"`
-	Multiple       bool     `json:"multiple,omitempty"`
-	DefaultValue   string   `json:"default_value,omitempty"`
-	Visible        bool     `json:"visible,omitempty"`
-	PossibleValues []string `json:"possible_values,omitempty"`
+	ID             int             `json:"id,omitempty"`
+	Name           string          `json:"name,omitempty"`
+	CustomizedType string          `json:"customized_type,omitempty"`
+	FieldFormat    string          `json:"field_format,omitempty"`
+	Regexp         string          `json:"regexp,omitempty"`
+	MinLength      int             `json:"min_length,omitempty"`
+	MaxLength      int             `json:"max_length,omitempty"`
+	IsRequired     bool            `json:"is_required,omitempty"`
+	IsFilter       bool            `json:"is_filter,omitempty"`
+	Searchable     bool            `json:"searchable,omitempty"`
+	Multiple       bool            `json:"multiple,omitempty"`
+	DefaultValue   string          `json:"default_value,omitempty"`
+	Visible        bool            `json:"visible,omitempty"`
+	PossibleValues []PossibleValue `json:"possible_values,omitempty"`
+	Trackers       []Resource      `json:"trackers,omitempty"`
+}
+
+// PossibleValue is an allowed value of a list or key/value list custom field.
+// For key/value lists, Value is the ID of the entry.
+type PossibleValue struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting plain strings as well.
+func (v *PossibleValue) UnmarshalJSON(data []byte) error {
+	if s, ok := unquoteJSON(data); ok {
+		*v = PossibleValue{Value: s, Label: s}
+		return nil
+	}
+
+	type possibleValue PossibleValue
+	return json.Unmarshal(data, (*possibleValue)(v))
 }
 
 type CustomFieldsResponse struct {
@@ -0,0 +1,295 @@
+package redmine
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrInvalidCustomField is matched by every *CustomFieldError with errors.Is.
+var ErrInvalidCustomField = errors.New("redmine: invalid custom field value")
+
+// ListValues returns the values of the field. Redmine sends multi-value fields
+// as arrays and other fields as strings; both are returned as a slice, without
+// empty values.
+func (f CustomField) ListValues() []string {
+	return customFieldValues(f.Value)
+}
+
+// StringValue returns the value of the field, with multiple values joined by ", ".
+func (f CustomField) StringValue() string {
+	return strings.Join(f.ListValues(), ", ")
+}
+
+// IntValue returns the value of an int field, or 0 if it is empty.
+func (f CustomField) IntValue() (int, error) {
+	s, err := f.single()
+	if err != nil || s == "" {
+		return 0, err
+	}
+	return strconv.Atoi(s)
+}
+
+// FloatValue returns the value of a float field, or 0 if it is empty.
+func (f CustomField) FloatValue() (float64, error) {
+	s, err := f.single()
+	if err != nil || s == "" {
+		return 0, err
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// BoolValue returns the value of a bool field, which Redmine stores as "1" or "0".
+// An empty value is false.
+func (f CustomField) BoolValue() (bool, error) {
+	s, err := f.single()
+	if err != nil || s == "" {
+		return false, err
+	}
+	return strconv.ParseBool(s)
+}
+
+// DateValue returns the value of a date field, or the zero Date if it is empty.
+func (f CustomField) DateValue() (Date, error) {
+	s, err := f.single()
+	if err != nil {
+		return Date{}, err
+	}
+	return ParseDate(s)
+}
+
+// UserIDs returns the user IDs of a user field.
+func (f CustomField) UserIDs() ([]int, error) {
+	return f.intValues()
+}
+
+// VersionIDs returns the version IDs of a version field.
+func (f CustomField) VersionIDs() ([]int, error) {
+	return f.intValues()
+}
+
+func (f CustomField) single() (string, error) {
+	values := f.ListValues()
+	switch len(values) {
+	case 0:
+		return "", nil
+	case 1:
+		return values[0], nil
+	default:
+		return "", fmt.Errorf("custom field %q has %d values", f.Name, len(values))
+	}
+}
+
+func (f CustomField) intValues() ([]int, error) {
+	values := f.ListValues()
+	ids := make([]int, 0, len(values))
+	for _, v := range values {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("custom field %q: %w", f.Name, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// customFieldValues flattens a custom field value, as decoded from JSON or
+// set by callers, into its non-empty string values.
+func customFieldValues(v any) []string {
+	var values []string
+	add := func(s string) {
+		if s != "" {
+			values = append(values, s)
+		}
+	}
+
+	switch v := v.(type) {
+	case nil:
+	case string:
+		add(v)
+	case []string:
+		for _, s := range v {
+			add(s)
+		}
+	case []any:
+		for _, e := range v {
+			values = append(values, customFieldValues(e)...)
+		}
+	case []int:
+		for _, n := range v {
+			add(strconv.Itoa(n))
+		}
+	case bool:
+		if v {
+			add("1")
+		} else {
+			add("0")
+		}
+	case float64:
+		add(strconv.FormatFloat(v, 'f', -1, 64))
+	default:
+		add(fmt.Sprint(v))
+	}
+	return values
+}
+
+// CustomFieldError reports a custom field value that Redmine would reject.
+type CustomFieldError struct {
+	ID     int
+	Name   string
+	Reason string
+}
+
+func (e *CustomFieldError) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("custom field %d: %s", e.ID, e.Reason)
+	}
+	return fmt.Sprintf("custom field %q (id %d): %s", e.Name, e.ID, e.Reason)
+}
+
+// Is reports whether target is ErrInvalidCustomField.
+func (e *CustomFieldError) Is(target error) bool {
+	return target == ErrInvalidCustomField
+}
+
+// CustomFieldValidator checks custom field values against their definitions,
+// as returned by ListCustomFields, before a request is sent. Errors join one
+// *CustomFieldError per offending field.
+type CustomFieldValidator struct {
+	fields map[int]CustomFieldDefinition
+}
+
+// NewCustomFieldValidator returns a validator for the given definitions.
+func NewCustomFieldValidator(defs []CustomFieldDefinition) *CustomFieldValidator {
+	fields := make(map[int]CustomFieldDefinition, len(defs))
+	for _, d := range defs {
+		fields[d.ID] = d
+	}
+	return &CustomFieldValidator{fields: fields}
+}
+
+// ValidateIssueCreate validates the custom fields of req. Required fields are
+// checked only when req.TrackerID is set, since they apply per tracker.
+func (v *CustomFieldValidator) ValidateIssueCreate(req IssueCreateRequest) error {
+	return v.validate("issue", req.CustomFields, func(d CustomFieldDefinition) bool {
+		return req.TrackerID != 0 && slices.ContainsFunc(d.Trackers, func(t Resource) bool {
+			return t.ID == req.TrackerID
+		})
+	})
+}
+
+// ValidateIssueUpdate validates the custom fields of req. Fields that are not
+// sent are left unchanged, so only the values present are checked.
+func (v *CustomFieldValidator) ValidateIssueUpdate(req IssueUpdateRequest) error {
+	return v.validate("issue", req.CustomFields, nil)
+}
+
+// ValidateTimeEntryCreate validates the custom fields of req.
+func (v *CustomFieldValidator) ValidateTimeEntryCreate(req TimeEntryCreateRequest) error {
+	return v.validate("time_entry", req.CustomFields, func(CustomFieldDefinition) bool {
+		return true
+	})
+}
+
+// validate checks values against the definitions of customizedType. If
+// applies is non-nil, required fields it reports must be present too.
+func (v *CustomFieldValidator) validate(customizedType string, values []CustomField, applies func(CustomFieldDefinition) bool) error {
+	var errs []error
+	seen := map[int]bool{}
+
+	for _, f := range values {
+		def, ok := v.fields[f.ID]
+		if !ok {
+			errs = append(errs, &CustomFieldError{ID: f.ID, Name: f.Name, Reason: "unknown custom field"})
+			continue
+		}
+		seen[f.ID] = true
+
+		if reason := checkCustomField(customizedType, def, f.ListValues()); reason != "" {
+			errs = append(errs, &CustomFieldError{ID: def.ID, Name: def.Name, Reason: reason})
+		}
+	}
+
+	if applies != nil {
+		for _, id := range slices.Sorted(maps.Keys(v.fields)) {
+			def := v.fields[id]
+			if seen[id] || def.CustomizedType != customizedType || !def.IsRequired || def.DefaultValue != "" || !applies(def) {
+				continue
+			}
+			errs = append(errs, &CustomFieldError{ID: def.ID, Name: def.Name, Reason: "is required"})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkCustomField returns why values are invalid for def, or "" if they are valid.
+func checkCustomField(customizedType string, def CustomFieldDefinition, values []string) string {
+	if def.CustomizedType != "" && def.CustomizedType != customizedType {
+		return fmt.Sprintf("is a %s custom field", def.CustomizedType)
+	}
+	if len(values) == 0 {
+		if def.IsRequired {
+			return "cannot be blank"
+		}
+		return ""
+	}
+	if len(values) > 1 && !def.Multiple {
+		return "does not accept multiple values"
+	}
+
+	for _, s := range values {
+		if reason := checkCustomFieldValue(def, s); reason != "" {
+			return fmt.Sprintf("%q %s", s, reason)
+		}
+	}
+	return ""
+}
+
+func checkCustomFieldValue(def CustomFieldDefinition, s string) string {
+	switch def.FieldFormat {
+	case "int", "user", "version":
+		if _, err := strconv.Atoi(s); err != nil {
+			return "is not an integer"
+		}
+	case "float":
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			return "is not a number"
+		}
+	case "date":
+		if _, err := ParseDate(s); err != nil {
+			return "is not a date in YYYY-MM-DD format"
+		}
+	case "bool":
+		if s != "0" && s != "1" {
+			return `is not "0" or "1"`
+		}
+	}
+
+	if len(def.PossibleValues) > 0 && !slices.ContainsFunc(def.PossibleValues, func(p PossibleValue) bool {
+		return p.Value == s
+	}) {
+		return "is not one of the possible values"
+	}
+
+	switch def.FieldFormat {
+	case "string", "text", "link", "int", "float":
+		if n := utf8.RuneCountInString(s); def.MinLength > 0 && n < def.MinLength {
+			return fmt.Sprintf("is shorter than %d characters", def.MinLength)
+		} else if def.MaxLength > 0 && n > def.MaxLength {
+			return fmt.Sprintf("is longer than %d characters", def.MaxLength)
+		}
+		if def.Regexp != "" {
+			re, err := regexp.Compile(def.Regexp)
+			if err == nil && !re.MatchString(s) {
+				return fmt.Sprintf("does not match %s", def.Regexp)
+			}
+		}
+	}
+	return ""
+}
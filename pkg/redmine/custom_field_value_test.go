@@ -0,0 +1,185 @@
+package redmine
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCustomFieldAccessors(t *testing.T) {
+	var issue Issue
+	data := `{"custom_fields":[
+		{"id":1,"name":"Estimate","value":"42"},
+		{"id":2,"name":"Ratio","value":"0.75"},
+		{"id":3,"name":"Approved","value":"1"},
+		{"id":4,"name":"Release","value":"2025-03-01"},
+		{"id":5,"name":"Platforms","multiple":true,"value":["Linux","macOS"]},
+		{"id":6,"name":"Reviewers","multiple":true,"value":["3","7"]},
+		{"id":7,"name":"Empty","value":""}
+	]}`
+	if err := json.Unmarshal([]byte(data), &issue); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	fields := issue.CustomFields
+
+	if n, err := fields[0].IntValue(); err != nil || n != 42 {
+		t.Errorf("Expected 42, got %d (%v)", n, err)
+	}
+	if f, err := fields[1].FloatValue(); err != nil || f != 0.75 {
+		t.Errorf("Expected 0.75, got %v (%v)", f, err)
+	}
+	if b, err := fields[2].BoolValue(); err != nil || !b {
+		t.Errorf("Expected true, got %v (%v)", b, err)
+	}
+	if d, err := fields[3].DateValue(); err != nil || !d.Equal(NewDate(2025, time.March, 1).Time) {
+		t.Errorf("Expected 2025-03-01, got %v (%v)", d, err)
+	}
+	if got := fields[4].ListValues(); !slices.Equal(got, []string{"Linux", "macOS"}) {
+		t.Errorf("Expected [Linux macOS], got %v", got)
+	}
+	if got := fields[4].StringValue(); got != "Linux, macOS" {
+		t.Errorf("Expected 'Linux, macOS', got %q", got)
+	}
+	if ids, err := fields[5].UserIDs(); err != nil || !slices.Equal(ids, []int{3, 7}) {
+		t.Errorf("Expected [3 7], got %v (%v)", ids, err)
+	}
+	if _, err := fields[5].IntValue(); err == nil {
+		t.Error("Expected error for multiple values, got nil")
+	}
+	if n, err := fields[6].IntValue(); err != nil || n != 0 {
+		t.Errorf("Expected 0 for empty value, got %d (%v)", n, err)
+	}
+	if got := fields[6].ListValues(); len(got) != 0 {
+		t.Errorf("Expected no values, got %v", got)
+	}
+}
+
+func TestPossibleValueUnmarshal(t *testing.T) {
+	var def CustomFieldDefinition
+	data := `{"id":1,"possible_values":[{"value":"High","label":"High"},"Low",{"value":"3","label":"Beta"}]}`
+	if err := json.Unmarshal([]byte(data), &def); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	want := []PossibleValue{{Value: "High", Label: "High"}, {Value: "Low", Label: "Low"}, {Value: "3", Label: "Beta"}}
+	if !slices.Equal(def.PossibleValues, want) {
+		t.Errorf("Expected %v, got %v", want, def.PossibleValues)
+	}
+}
+
+func TestPossibleValueUnmarshalEscaped(t *testing.T) {
+	// Rails escapes & as \u0026
+	var def CustomFieldDefinition
+	data := `{"id":1,"field_format":"list","possible_values":["R\u0026D","a\"b",{"value":"x\\y","label":"x\\y"}]}`
+	if err := json.Unmarshal([]byte(data), &def); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	want := []PossibleValue{{Value: "R&D", Label: "R&D"}, {Value: `a"b`, Label: `a"b`}, {Value: `x\y`, Label: `x\y`}}
+	if !slices.Equal(def.PossibleValues, want) {
+		t.Errorf("Expected %v, got %v", want, def.PossibleValues)
+	}
+
+	def.CustomizedType = "issue"
+	v := NewCustomFieldValidator([]CustomFieldDefinition{def})
+	if err := v.ValidateIssueUpdate(IssueUpdateRequest{CustomFields: []CustomField{{ID: 1, Value: "R&D"}}}); err != nil {
+		t.Errorf("Expected R&D to be valid, got %v", err)
+	}
+}
+
+func testCustomFieldDefinitions() []CustomFieldDefinition {
+	return []CustomFieldDefinition{
+		{ID: 1, Name: "Severity", CustomizedType: "issue", FieldFormat: "list", IsRequired: true,
+			PossibleValues: []PossibleValue{{Value: "Low"}, {Value: "High"}}, Trackers: []Resource{{ID: 1}}},
+		{ID: 2, Name: "Platforms", CustomizedType: "issue", FieldFormat: "list", Multiple: true,
+			PossibleValues: []PossibleValue{{Value: "Linux"}, {Value: "macOS"}}},
+		{ID: 3, Name: "Ticket", CustomizedType: "issue", FieldFormat: "string", Regexp: `^[A-Z]+-\d+$`, MaxLength: 10},
+		{ID: 4, Name: "Release", CustomizedType: "issue", FieldFormat: "date"},
+		{ID: 5, Name: "Billable", CustomizedType: "time_entry", FieldFormat: "bool", IsRequired: true},
+		{ID: 6, Name: "Reviewer", CustomizedType: "issue", FieldFormat: "user"},
+	}
+}
+
+func TestValidateIssueCreate(t *testing.T) {
+	v := NewCustomFieldValidator(testCustomFieldDefinitions())
+
+	valid := IssueCreateRequest{
+		TrackerID: 1,
+		CustomFields: []CustomField{
+			{ID: 1, Value: "High"},
+			{ID: 2, Value: []string{"Linux", "macOS"}},
+			{ID: 3, Value: "OPS-12"},
+			{ID: 4, Value: "2025-03-01"},
+			{ID: 6, Value: 5},
+		},
+	}
+	if err := v.ValidateIssueCreate(valid); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		req    IssueCreateRequest
+		wantID int
+		reason string
+	}{
+		{name: "missing required", req: IssueCreateRequest{TrackerID: 1}, wantID: 1, reason: "is required"},
+		{name: "not a possible value", req: IssueCreateRequest{CustomFields: []CustomField{{ID: 1, Value: "Urgent"}}}, wantID: 1, reason: "possible values"},
+		{name: "multiple values", req: IssueCreateRequest{CustomFields: []CustomField{{ID: 4, Value: []string{"2025-03-01", "2025-04-01"}}}}, wantID: 4, reason: "multiple values"},
+		{name: "regexp", req: IssueCreateRequest{CustomFields: []CustomField{{ID: 3, Value: "ops-12"}}}, wantID: 3, reason: "does not match"},
+		{name: "max length", req: IssueCreateRequest{CustomFields: []CustomField{{ID: 3, Value: "OPS-1234567"}}}, wantID: 3, reason: "longer than 10"},
+		{name: "date", req: IssueCreateRequest{CustomFields: []CustomField{{ID: 4, Value: "03/01/2025"}}}, wantID: 4, reason: "not a date"},
+		{name: "user", req: IssueCreateRequest{CustomFields: []CustomField{{ID: 6, Value: "alice"}}}, wantID: 6, reason: "not an integer"},
+		{name: "wrong type", req: IssueCreateRequest{CustomFields: []CustomField{{ID: 5, Value: "1"}}}, wantID: 5, reason: "time_entry custom field"},
+		{name: "unknown", req: IssueCreateRequest{CustomFields: []CustomField{{ID: 99, Value: "x"}}}, wantID: 99, reason: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.ValidateIssueCreate(tt.req)
+			if !errors.Is(err, ErrInvalidCustomField) {
+				t.Fatalf("Expected ErrInvalidCustomField, got %v", err)
+			}
+
+			var cfErr *CustomFieldError
+			if !errors.As(err, &cfErr) {
+				t.Fatalf("Expected *CustomFieldError, got %T", err)
+			}
+			if cfErr.ID != tt.wantID {
+				t.Errorf("Expected field %d, got %d", tt.wantID, cfErr.ID)
+			}
+			if !strings.Contains(cfErr.Reason, tt.reason) {
+				t.Errorf("Expected reason containing %q, got %q", tt.reason, cfErr.Reason)
+			}
+		})
+	}
+}
+
+func TestValidateIssueUpdate(t *testing.T) {
+	v := NewCustomFieldValidator(testCustomFieldDefinitions())
+
+	if err := v.ValidateIssueUpdate(IssueUpdateRequest{Notes: "No custom fields"}); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	err := v.ValidateIssueUpdate(IssueUpdateRequest{CustomFields: []CustomField{{ID: 1, Value: ""}}})
+	if err == nil || !strings.Contains(err.Error(), `custom field "Severity" (id 1): cannot be blank`) {
+		t.Errorf("Expected blank Severity error, got %v", err)
+	}
+}
+
+func TestValidateTimeEntryCreate(t *testing.T) {
+	v := NewCustomFieldValidator(testCustomFieldDefinitions())
+
+	if err := v.ValidateTimeEntryCreate(TimeEntryCreateRequest{CustomFields: []CustomField{{ID: 5, Value: true}}}); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	err := v.ValidateTimeEntryCreate(TimeEntryCreateRequest{})
+	if err == nil || !strings.Contains(err.Error(), `"Billable" (id 5): is required`) {
+		t.Errorf("Expected required Billable error, got %v", err)
+	}
+}
@@ -0,0 +1,169 @@
+package redmine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DateLayout is the layout Redmine uses for calendar dates.
+const DateLayout = "2006-01-02"
+
+// Date is a calendar date such as a due date. It is stored as midnight UTC.
+// The zero Date encodes as an empty string, and empty strings and null
+// decode to the zero Date.
+type Date struct {
+	time.Time
+}
+
+// NewDate returns the Date for the given year, month and day.
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// DateOf returns the calendar date of t in t's location.
+func DateOf(t time.Time) Date {
+	return NewDate(t.Date())
+}
+
+// Today returns the current date in the local time zone.
+func Today() Date {
+	return DateOf(time.Now())
+}
+
+// ParseDate parses a date in YYYY-MM-DD format. An empty string yields the zero Date.
+func ParseDate(s string) (Date, error) {
+	if s == "" {
+		return Date{}, nil
+	}
+	t, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q: %w", s, err)
+	}
+	return Date{t}, nil
+}
+
+// AddDays returns the date n days after d.
+func (d Date) AddDays(n int) Date {
+	return Date{d.AddDate(0, 0, n)}
+}
+
+// String returns the date in YYYY-MM-DD format, or an empty string for the zero Date.
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.Format(DateLayout)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	s, ok := unquoteJSON(data)
+	if !ok {
+		return fmt.Errorf("invalid date %s", data)
+	}
+	v, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// Timestamp is a point in time such as a creation time. The zero Timestamp
+// encodes as an empty string, and empty strings and null decode to the zero Timestamp.
+type Timestamp struct {
+	time.Time
+}
+
+// ParseTimestamp parses a timestamp in RFC 3339 format. An empty string yields the zero Timestamp.
+func ParseTimestamp(s string) (Timestamp, error) {
+	if s == "" {
+		return Timestamp{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return Timestamp{}, fmt.Errorf("invalid timestamp %q: %w", s, err)
+	}
+	return Timestamp{t}, nil
+}
+
+// String returns the timestamp in RFC 3339 format, or an empty string for the zero Timestamp.
+func (t Timestamp) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + t.String() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	s, ok := unquoteJSON(data)
+	if !ok {
+		return fmt.Errorf("invalid timestamp %s", data)
+	}
+	v, err := ParseTimestamp(s)
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
+
+// unquoteJSON returns the unescaped contents of a JSON string, treating null
+// as empty. It reports false if data is not a string.
+func unquoteJSON(data []byte) (string, bool) {
+	if bytes.Equal(data, []byte("null")) {
+		return "", true
+	}
+	var s string
+	if len(data) == 0 || data[0] != '"' || json.Unmarshal(data, &s) != nil {
+		return "", false
+	}
+	return s, true
+}
+
+// DateRange returns a filter expression matching dates from from to to,
+// inclusive, such as "><2025-01-01|2025-01-31". A zero bound leaves that side open.
+func DateRange(from, to Date) string {
+	return rangeExpr(from.String(), to.String())
+}
+
+// TimeRange returns a filter expression matching timestamps from from to to,
+// inclusive. A zero bound leaves that side open.
+func TimeRange(from, to time.Time) string {
+	return rangeExpr(Timestamp{from.UTC()}.String(), Timestamp{to.UTC()}.String())
+}
+
+func rangeExpr(from, to string) string {
+	switch {
+	case from != "" && to != "":
+		return OpBetween + from + "|" + to
+	case from != "":
+		return OpGreaterOrEqual + from
+	case to != "":
+		return OpLessOrEqual + to
+	default:
+		return ""
+	}
+}
+
+// Dates adds a condition matching field between from and to, inclusive.
+// A zero bound leaves that side open; if both are zero no condition is added.
+func (f *Filter) Dates(field string, from, to Date) *Filter {
+	if expr := DateRange(from, to); expr != "" {
+		f.whereShort(field, expr)
+	}
+	return f
+}
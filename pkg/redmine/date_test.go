@@ -0,0 +1,144 @@
+package redmine
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDateJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Date
+		out   string
+	}{
+		{name: "date", input: `"2025-01-31"`, want: NewDate(2025, time.January, 31), out: `"2025-01-31"`},
+		{name: "empty", input: `""`, want: Date{}, out: `""`},
+		{name: "null", input: `null`, want: Date{}, out: `""`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Date
+			if err := json.Unmarshal([]byte(tt.input), &d); err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			if !d.Equal(tt.want.Time) {
+				t.Errorf("Expected %v, got %v", tt.want, d)
+			}
+
+			got, err := json.Marshal(d)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			if string(got) != tt.out {
+				t.Errorf("Expected %s, got %s", tt.out, got)
+			}
+		})
+	}
+
+	var d Date
+	if err := json.Unmarshal([]byte(`"31/01/2025"`), &d); err == nil {
+		t.Error("Expected error for invalid date, got nil")
+	}
+}
+
+func TestTimestampJSON(t *testing.T) {
+	var ts Timestamp
+	if err := json.Unmarshal([]byte(`"2025-01-31T09:30:00Z"`), &ts); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	want := time.Date(2025, time.January, 31, 9, 30, 0, 0, time.UTC)
+	if !ts.Equal(want) {
+		t.Errorf("Expected %v, got %v", want, ts)
+	}
+
+	got, err := json.Marshal(ts)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(got) != `"2025-01-31T09:30:00Z"` {
+		t.Errorf("Expected \"2025-01-31T09:30:00Z\", got %s", got)
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &ts); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !ts.IsZero() {
+		t.Errorf("Expected zero timestamp, got %v", ts)
+	}
+}
+
+func TestDateOmitZero(t *testing.T) {
+	got, err := json.Marshal(IssueCreateRequest{ProjectID: 1, DueDate: NewDate(2025, time.March, 1)})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	want := `{"project_id":1,"tracker_id":0,"subject":"","due_date":"2025-03-01"}`
+	if string(got) != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+}
+
+func TestDateOf(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	d := DateOf(time.Date(2025, time.January, 1, 1, 0, 0, 0, tokyo))
+
+	if d.String() != "2025-01-01" {
+		t.Errorf("Expected 2025-01-01, got %s", d)
+	}
+	if d.AddDays(31).String() != "2025-02-01" {
+		t.Errorf("Expected 2025-02-01, got %s", d.AddDays(31))
+	}
+}
+
+func TestDateRange(t *testing.T) {
+	from := NewDate(2025, time.January, 1)
+	to := NewDate(2025, time.January, 31)
+
+	tests := []struct {
+		name string
+		from Date
+		to   Date
+		want string
+	}{
+		{name: "between", from: from, to: to, want: "><2025-01-01|2025-01-31"},
+		{name: "from", from: from, want: ">=2025-01-01"},
+		{name: "to", to: to, want: "<=2025-01-31"},
+		{name: "open", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DateRange(tt.from, tt.to); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTimeRange(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	from := time.Date(2025, time.January, 1, 9, 0, 0, 0, tokyo)
+
+	want := ">=2025-01-01T00:00:00Z"
+	if got := TimeRange(from, time.Time{}); got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestFilterDates(t *testing.T) {
+	f := NewFilter().Dates("due_date", NewDate(2025, time.January, 1), NewDate(2025, time.January, 31))
+
+	want := "f%5B%5D=due_date&op%5Bdue_date%5D=%3E%3C&set_filter=1&v%5Bdue_date%5D%5B%5D=2025-01-01&v%5Bdue_date%5D%5B%5D=2025-01-31"
+	if got := f.String(); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+
+	if NewFilter().Dates("due_date", Date{}, Date{}).Len() != 0 {
+		t.Error("Expected no condition for open range")
+	}
+}
@@ -0,0 +1,218 @@
+package redmine
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 3
+
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+// UnifiedDiff returns a line-based unified diff from a to b, labelled with
+// fromName and toName, or an empty string if they are equal. CRLF line
+// endings, which Redmine stores wiki text with, are treated as LF.
+func UnifiedDiff(fromName, toName, a, b string) string {
+	ops := diffLines(splitLines(a), splitLines(b))
+
+	var changes []int
+	for i, op := range ops {
+		if op.kind != ' ' {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+
+	for i := 0; i < len(changes); {
+		// Group changes whose context overlaps into one hunk
+		j := i
+		for j+1 < len(changes) && changes[j+1]-changes[j] <= 2*diffContext {
+			j++
+		}
+		start := max(changes[i]-diffContext, 0)
+		end := min(changes[j]+diffContext+1, len(ops))
+		writeHunk(&sb, ops, start, end)
+		i = j + 1
+	}
+
+	return sb.String()
+}
+
+func writeHunk(sb *strings.Builder, ops []diffOp, start, end int) {
+	var aLine, bLine int
+	for _, op := range ops[:start] {
+		if op.kind != '+' {
+			aLine++
+		}
+		if op.kind != '-' {
+			bLine++
+		}
+	}
+
+	var aLen, bLen int
+	for _, op := range ops[start:end] {
+		if op.kind != '+' {
+			aLen++
+		}
+		if op.kind != '-' {
+			bLen++
+		}
+	}
+	if aLen > 0 {
+		aLine++
+	}
+	if bLen > 0 {
+		bLine++
+	}
+
+	fmt.Fprintf(sb, "@@ -%d,%d +%d,%d @@\n", aLine, aLen, bLine, bLen)
+	for _, op := range ops[start:end] {
+		sb.WriteByte(op.kind)
+		sb.WriteString(op.line)
+		sb.WriteByte('\n')
+	}
+}
+
+func splitLines(s string) []string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines computes a shortest edit script from a to b with the linear space
+// variant of Myers' algorithm, so that memory stays proportional to the input
+// however different a and b are.
+func diffLines(a, b []string) []diffOp {
+	var ops []diffOp
+	diffRange(a, b, &ops)
+	return ops
+}
+
+// diffRange appends an edit script from a to b to ops. Common leading and
+// trailing lines are kept, and the rest is split at a middle snake and diffed
+// in two halves.
+func diffRange(a, b []string, ops *[]diffOp) {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	appendOps(ops, ' ', a[:prefix])
+	midA, midB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if x, y, ok := middleSnake(midA, midB); ok {
+		diffRange(midA[:x], midB[:y], ops)
+		diffRange(midA[x:], midB[y:], ops)
+	} else {
+		appendOps(ops, '-', midA)
+		appendOps(ops, '+', midB)
+	}
+	appendOps(ops, ' ', a[len(a)-suffix:])
+}
+
+func appendOps(ops *[]diffOp, kind byte, lines []string) {
+	for _, line := range lines {
+		*ops = append(*ops, diffOp{kind, line})
+	}
+}
+
+// middleSnake searches forward from the start and backward from the end of the
+// edit graph at once, and returns the point where the searches meet, which
+// splits a shortest edit script in two. It reports false if a or b is empty or
+// they have no line in common, when the script is to delete a and insert b.
+func middleSnake(a, b []string) (int, int, bool) {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return 0, 0, false
+	}
+
+	// vf[offset+k] and vb[offset+k] are the furthest x reached on diagonal k
+	// from the start and, counting from the end, from the end
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	vf := make([]int, 2*maxD+2)
+	vb := make([]int, 2*maxD+2)
+	for i := range vf {
+		vf[i], vb[i] = -1, -1
+	}
+	vf[offset+1], vb[offset+1] = 0, 0
+
+	// The searches meet on a forward step if delta is odd, else on a backward one
+	delta := n - m
+	forward := delta%2 != 0
+	// Diagonals that left the graph are not searched again
+	var fStart, fEnd, bStart, bEnd int
+
+	for d := range maxD {
+		for k := -d + fStart; k <= d-fEnd; k += 2 {
+			i := offset + k
+			var x int
+			if k == -d || (k != d && vf[i-1] < vf[i+1]) {
+				x = vf[i+1]
+			} else {
+				x = vf[i-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			vf[i] = x
+
+			switch {
+			case x > n:
+				fEnd += 2
+			case y > m:
+				fStart += 2
+			case forward:
+				j := offset + delta - k
+				if j >= 0 && j < len(vb) && vb[j] != -1 && x >= n-vb[j] {
+					return x, y, true
+				}
+			}
+		}
+
+		for k := -d + bStart; k <= d-bEnd; k += 2 {
+			i := offset + k
+			var x int
+			if k == -d || (k != d && vb[i-1] < vb[i+1]) {
+				x = vb[i+1]
+			} else {
+				x = vb[i-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[n-x-1] == b[m-y-1] {
+				x++
+				y++
+			}
+			vb[i] = x
+
+			switch {
+			case x > n:
+				bEnd += 2
+			case y > m:
+				bStart += 2
+			case !forward:
+				j := offset + delta - k
+				if j >= 0 && j < len(vf) && vf[j] != -1 && vf[j] >= n-x {
+					return vf[j], vf[j] - (j - offset), true
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,134 @@
+package redmine
+
+import (
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	lines := func(n int, replace map[int]string) string {
+		var sb strings.Builder
+		for i := 1; i <= n; i++ {
+			if s, ok := replace[i]; ok {
+				sb.WriteString(s)
+			} else {
+				sb.WriteString("line " + string(rune('a'+i-1)))
+			}
+			sb.WriteString("\n")
+		}
+		return sb.String()
+	}
+
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want string
+	}{
+		{name: "equal", a: "same\n", b: "same\r\n", want: ""},
+		{name: "from empty", a: "", b: "one\ntwo", want: "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+one\n+two\n"},
+		{name: "to empty", a: "one\n", b: "", want: "--- a\n+++ b\n@@ -1,1 +0,0 @@\n-one\n"},
+		{
+			name: "separate hunks",
+			a:    lines(20, nil),
+			b:    lines(20, map[int]string{2: "changed b", 18: "changed r"}),
+			want: "--- a\n+++ b\n" +
+				"@@ -1,5 +1,5 @@\n line a\n-line b\n+changed b\n line c\n line d\n line e\n" +
+				"@@ -15,6 +15,6 @@\n line o\n line p\n line q\n-line r\n+changed r\n line s\n line t\n",
+		},
+		{
+			name: "merged hunk",
+			a:    lines(10, nil),
+			b:    lines(10, map[int]string{3: "changed c", 7: "changed g"}),
+			want: "--- a\n+++ b\n" +
+				"@@ -1,10 +1,10 @@\n line a\n line b\n-line c\n+changed c\n line d\n line e\n line f\n-line g\n+changed g\n line h\n line i\n line j\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnifiedDiff("a", "b", tt.a, tt.b); got != tt.want {
+				t.Errorf("Expected diff:\n%s\ngot:\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDiffLinesShortest(t *testing.T) {
+	// lcs returns the length of the longest common subsequence of a and b
+	lcs := func(a, b []string) int {
+		prev := make([]int, len(b)+1)
+		for i := range a {
+			cur := make([]int, len(b)+1)
+			for j := range b {
+				if a[i] == b[j] {
+					cur[j+1] = prev[j] + 1
+				} else {
+					cur[j+1] = max(prev[j+1], cur[j])
+				}
+			}
+			prev = cur
+		}
+		return prev[len(b)]
+	}
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	randomLines := func() []string {
+		lines := make([]string, rng.IntN(30))
+		for i := range lines {
+			lines[i] = string(rune('a' + rng.IntN(4)))
+		}
+		return lines
+	}
+
+	for range 500 {
+		a, b := randomLines(), randomLines()
+		ops := diffLines(a, b)
+
+		var gotA, gotB []string
+		edits := 0
+		for _, op := range ops {
+			if op.kind != '+' {
+				gotA = append(gotA, op.line)
+			}
+			if op.kind != '-' {
+				gotB = append(gotB, op.line)
+			}
+			if op.kind != ' ' {
+				edits++
+			}
+		}
+		if !slices.Equal(gotA, a) || !slices.Equal(gotB, b) {
+			t.Fatalf("Edit script %v does not turn %v into %v", ops, a, b)
+		}
+		if want := len(a) + len(b) - 2*lcs(a, b); edits != want {
+			t.Fatalf("Expected %d edits from %v to %v, got %d", want, a, b, edits)
+		}
+	}
+}
+
+func TestDiffLinesMemory(t *testing.T) {
+	// Two large pages with no line in common
+	a := make([]string, 5000)
+	b := make([]string, 5000)
+	for i := range a {
+		a[i] = "old " + strconv.Itoa(i)
+		b[i] = "new " + strconv.Itoa(i)
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	ops := diffLines(a, b)
+	runtime.ReadMemStats(&after)
+
+	if len(ops) != 10000 {
+		t.Errorf("Expected 10000 edits, got %d", len(ops))
+	}
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 16<<20 {
+		t.Errorf("Expected at most 16 MiB to be allocated, got %d MiB", allocated>>20)
+	}
+}
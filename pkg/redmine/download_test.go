@@ -0,0 +1,246 @@
+package redmine
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec // Redmine before 4.2 uses MD5 digests for files
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const downloadContent = "release artifact contents"
+
+// newDownloadServer serves downloadContent at /attachments/download/5,
+// honouring Range headers when ranges is true.
+func newDownloadServer(t *testing.T, ranges bool) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/attachments/download/5" {
+			t.Errorf("Expected path /attachments/download/5, got %s", r.URL.Path)
+		}
+		if got := r.Header.Get("X-Redmine-Api-Key"); got != "test-api-key" {
+			t.Errorf("Expected X-Redmine-API-Key test-api-key, got %s", got)
+		}
+
+		if ranges {
+			http.ServeContent(w, r, "artifact.tar.gz", time.Time{}, strings.NewReader(downloadContent))
+			return
+		}
+		_, _ = w.Write([]byte(downloadContent))
+	}))
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	server := newDownloadServer(t, true)
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+
+	var buf bytes.Buffer
+	n, err := client.DownloadAttachment(context.Background(), 5, &buf)
+	if err != nil {
+		t.Fatalf("DownloadAttachment failed: %v", err)
+	}
+
+	if n != int64(len(downloadContent)) {
+		t.Errorf("Expected %d bytes, got %d", len(downloadContent), n)
+	}
+	if buf.String() != downloadContent {
+		t.Errorf("Expected content %q, got %q", downloadContent, buf.String())
+	}
+}
+
+func TestDownloadAttachmentResume(t *testing.T) {
+	for _, ranges := range []bool{true, false} {
+		server := newDownloadServer(t, ranges)
+
+		client := New(server.URL, "test-api-key")
+
+		var buf bytes.Buffer
+		_, err := client.DownloadAttachmentWithOptions(context.Background(), 5, &buf, &DownloadOptions{Offset: 8})
+		if err != nil {
+			t.Fatalf("DownloadAttachmentWithOptions failed: %v", err)
+		}
+
+		if buf.String() != downloadContent[8:] {
+			t.Errorf("Expected content %q with ranges=%v, got %q", downloadContent[8:], ranges, buf.String())
+		}
+		server.Close()
+	}
+}
+
+func TestDownloadAttachmentNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+
+	_, err := client.DownloadAttachment(context.Background(), 5, &bytes.Buffer{})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestDownloadThumbnail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/attachments/thumbnail/5/200" {
+			t.Errorf("Expected path /attachments/thumbnail/5/200, got %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte("png"))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+
+	var buf bytes.Buffer
+	if _, err := client.DownloadThumbnail(context.Background(), 5, 200, &buf); err != nil {
+		t.Fatalf("DownloadThumbnail failed: %v", err)
+	}
+
+	if buf.String() != "png" {
+		t.Errorf("Expected content png, got %q", buf.String())
+	}
+}
+
+func TestDownloadFileDigest(t *testing.T) {
+	server := newDownloadServer(t, true)
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+
+	md5Sum := md5.Sum([]byte(downloadContent)) //nolint:gosec // Testing MD5 digests
+
+	tests := []struct {
+		name    string
+		digest  string
+		wantErr error
+	}{
+		{name: "sha256", digest: sha256Hex(downloadContent)},
+		{name: "md5", digest: hex.EncodeToString(md5Sum[:])},
+		{name: "empty", digest: ""},
+		{name: "mismatch", digest: sha256Hex("tampered"), wantErr: ErrDigestMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := client.DownloadFile(context.Background(), File{ID: 5, Digest: tt.digest}, &buf)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if buf.String() != downloadContent {
+				t.Errorf("Expected content %q, got %q", downloadContent, buf.String())
+			}
+		})
+	}
+}
+
+func TestDownloadFileToPathResume(t *testing.T) {
+	var rangeHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rangeHeader = r.Header.Get("Range")
+		http.ServeContent(w, r, "artifact.tar.gz", time.Time{}, strings.NewReader(downloadContent))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+
+	path := filepath.Join(t.TempDir(), "artifact.tar.gz")
+	if err := os.WriteFile(path, []byte(downloadContent[:10]), 0o600); err != nil {
+		t.Fatalf("Failed to write partial file: %v", err)
+	}
+
+	file := File{ID: 5, Filesize: len(downloadContent), Digest: sha256Hex(downloadContent)}
+	if err := client.DownloadFileToPath(context.Background(), file, path); err != nil {
+		t.Fatalf("DownloadFileToPath failed: %v", err)
+	}
+
+	if rangeHeader != "bytes=10-" {
+		t.Errorf("Expected Range bytes=10-, got %q", rangeHeader)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read file: %v", err)
+	}
+	if string(b) != downloadContent {
+		t.Errorf("Expected content %q, got %q", downloadContent, string(b))
+	}
+}
+
+func TestDownloadFileToPathCorrupt(t *testing.T) {
+	tests := []struct {
+		name         string
+		local        string
+		wantRequests int
+	}{
+		// The resumed download fails the digest, so the file is downloaded again
+		{name: "corrupt prefix", local: "corrupted!", wantRequests: 2},
+		{name: "same size", local: strings.Repeat("x", len(downloadContent)), wantRequests: 1},
+		{name: "longer", local: downloadContent + "trailing", wantRequests: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				http.ServeContent(w, r, "artifact.tar.gz", time.Time{}, strings.NewReader(downloadContent))
+			}))
+			defer server.Close()
+
+			client := New(server.URL, "test-api-key")
+
+			path := filepath.Join(t.TempDir(), "artifact.tar.gz")
+			if err := os.WriteFile(path, []byte(tt.local), 0o600); err != nil {
+				t.Fatalf("Failed to write local file: %v", err)
+			}
+
+			file := File{ID: 5, Filesize: len(downloadContent), Digest: sha256Hex(downloadContent)}
+			if err := client.DownloadFileToPath(context.Background(), file, path); err != nil {
+				t.Fatalf("DownloadFileToPath failed: %v", err)
+			}
+
+			if requests != tt.wantRequests {
+				t.Errorf("Expected %d requests, got %d", tt.wantRequests, requests)
+			}
+			b, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("Failed to read file: %v", err)
+			}
+			if string(b) != downloadContent {
+				t.Errorf("Expected content %q, got %q", downloadContent, string(b))
+			}
+		})
+	}
+}
+
+func TestDownloadFileToPathDigestMismatch(t *testing.T) {
+	server := newDownloadServer(t, true)
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+
+	path := filepath.Join(t.TempDir(), "artifact.tar.gz")
+	file := File{ID: 5, Filesize: len(downloadContent), Digest: sha256Hex("other contents")}
+	err := client.DownloadFileToPath(context.Background(), file, path)
+	if !errors.Is(err, ErrDigestMismatch) {
+		t.Errorf("Expected ErrDigestMismatch, got %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+package redmine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors that an *APIError matches with errors.Is, based on its status code.
+var (
+	ErrUnauthorized  = errors.New("redmine: unauthorized")
+	ErrForbidden     = errors.New("redmine: forbidden")
+	ErrNotFound      = errors.New("redmine: not found")
+	ErrConflict      = errors.New("redmine: conflict")
+	ErrUnprocessable = errors.New("redmine: unprocessable entity")
+)
+
+// APIError is returned when Redmine responds with an unexpected HTTP status.
+// Errors holds the messages from Redmine's {"errors": [...]} payload, if any.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	Errors     []string
+	Body       string
+}
+
+type errorsResponse struct {
+	Errors []string `json:"errors"`
+}
+
+// newAPIError builds an APIError from resp, consuming its body.
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			apiErr.URL = redactURL(resp.Request.URL)
+		}
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return apiErr
+	}
+	apiErr.Body = string(b)
+
+	var result errorsResponse
+	if err := json.Unmarshal(b, &result); err == nil {
+		apiErr.Errors = result.Errors
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s %s: %d %s", e.Method, e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+	switch {
+	case len(e.Errors) > 0:
+		msg += ": " + strings.Join(e.Errors, ", ")
+	case strings.TrimSpace(e.Body) != "":
+		msg += ": " + strings.TrimSpace(e.Body)
+	}
+	return msg
+}
+
+// Is reports whether target is the sentinel error matching the status code.
+func (e *APIError) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusConflict:
+		return target == ErrConflict
+	case http.StatusUnprocessableEntity:
+		return target == ErrUnprocessable
+	default:
+		return false
+	}
+}
@@ -0,0 +1,93 @@
+package redmine
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIErrorValidationMessages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"errors":["Subject cannot be blank","Tracker cannot be blank"]}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	_, err := client.CreateIssue(context.Background(), IssueCreateRequest{ProjectID: 1})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	if !errors.Is(err, ErrUnprocessable) {
+		t.Errorf("Expected errors.Is(err, ErrUnprocessable), got %v", err)
+	}
+	if errors.Is(err, ErrNotFound) {
+		t.Error("Expected error not to match ErrNotFound")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Expected status 422, got %d", apiErr.StatusCode)
+	}
+	if apiErr.Method != http.MethodPost {
+		t.Errorf("Expected method POST, got %s", apiErr.Method)
+	}
+	if apiErr.URL != server.URL+"/issues.json" {
+		t.Errorf("Expected URL %s/issues.json, got %s", server.URL, apiErr.URL)
+	}
+	if len(apiErr.Errors) != 2 || apiErr.Errors[0] != "Subject cannot be blank" {
+		t.Errorf("Unexpected validation errors: %v", apiErr.Errors)
+	}
+}
+
+func TestAPIErrorSentinels(t *testing.T) {
+	tests := []struct {
+		status int
+		target error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusUnprocessableEntity, ErrUnprocessable},
+	}
+
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+		}))
+
+		client := New(server.URL, "test-api-key")
+		err := client.DeleteIssue(context.Background(), 1)
+		server.Close()
+
+		if !errors.Is(err, tt.target) {
+			t.Errorf("status %d: expected errors.Is(err, %v), got %v", tt.status, tt.target, err)
+		}
+	}
+}
+
+func TestAPIErrorUnexpectedSuccessStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	_, err := client.CreateIssue(context.Background(), IssueCreateRequest{ProjectID: 1, TrackerID: 1, Subject: "x"})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", apiErr.StatusCode)
+	}
+}
@@ -10,17 +10,17 @@ import (
 )
 
 type File struct {
-	ID          int      `json:"id,omitempty"`
-	Filename    string   `json:"filename,omitempty"`
-	Filesize    int      `json:"filesize,omitempty"`
-	ContentType string   `json:"content_type,omitempty"`
-	Description string   `json:"description,omitempty"`
-	ContentURL  string   `json:"content_url,omitempty"`
-	Author      Resource `json:"author,omitempty"`
-	Version     Resource `json:"version,omitempty"`
-	Digest      string   `json:"digest,omitempty"`
-	Downloads   int      `json:"downloads,omitempty"`
-	CreatedOn   string   `json:"created_on,omitempty"`
+	ID          int       `json:"id,omitempty"`
+	Filename    string    `json:"filename,omitempty"`
+	Filesize    int       `json:"filesize,omitempty"`
+	ContentType string    `json:"content_type,omitempty"`
+	Description string    `json:"description,omitempty"`
+	ContentURL  string    `json:"content_url,omitempty"`
+	Author      Resource  `json:"author,omitempty"`
+	Version     Resource  `json:"version,omitempty"`
+	Digest      string    `json:"digest,omitempty"`
+	Downloads   int       `json:"downloads,omitempty"`
+	CreatedOn   Timestamp `json:"created_on,omitzero"`
 }
 
 type FilesResponse struct {
@@ -80,8 +80,7 @@ func (c *Client) UploadFile(ctx context.Context, projectIDOrIdentifier string, f
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to upload file: %s", string(body))
+		return fmt.Errorf("failed to upload file: %w", newAPIError(resp))
 	}
 
 	return nil
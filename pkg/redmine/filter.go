@@ -0,0 +1,203 @@
+package redmine
+
+import (
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Operators for Filter conditions
+const (
+	OpEquals          = "="
+	OpNotEquals       = "!"
+	OpOpen            = "o"
+	OpClosed          = "c"
+	OpAny             = "*"
+	OpNone            = "!*"
+	OpGreaterOrEqual  = ">="
+	OpLessOrEqual     = "<="
+	OpBetween         = "><"
+	OpContains        = "~"
+	OpNotContains     = "!~"
+	OpStartsWith      = "^"
+	OpEndsWith        = "$"
+	OpToday           = "t"
+	OpYesterday       = "ld"
+	OpThisWeek        = "w"
+	OpLastWeek        = "lw"
+	OpThisMonth       = "m"
+	OpLastMonth       = "lm"
+	OpThisYear        = "y"
+	OpDaysAgo         = "t-"
+	OpLessThanDaysAgo = ">t-"
+	OpMoreThanDaysAgo = "<t-"
+	OpPastDays        = "><t-"
+	OpInDays          = "t+"
+	OpInLessThanDays  = "<t+"
+	OpInMoreThanDays  = ">t+"
+	OpNextDays        = "><t+"
+)
+
+// Filter is a set of issue filter conditions sent as Redmine's generic
+// f[]/op[]/v[] parameters. Fields are filter names such as "status_id",
+// "author_id", "watcher_id" or "cf_5" (see CustomFieldFilter).
+//
+// Unlike the shortcut fields of ListIssuesOptions, a Filter without a
+// status_id condition matches closed issues too.
+type Filter struct {
+	conditions []filterCondition
+}
+
+type filterCondition struct {
+	field    string
+	operator string
+	values   []string
+}
+
+// NewFilter returns an empty Filter.
+func NewFilter() *Filter {
+	return &Filter{}
+}
+
+// CustomFieldFilter returns the filter name of the custom field with the given ID.
+func CustomFieldFilter(id int) string {
+	return "cf_" + strconv.Itoa(id)
+}
+
+// Where adds a condition on field. Redmine keeps one condition per field,
+// so a later condition on the same field replaces the earlier one.
+func (f *Filter) Where(field, operator string, values ...string) *Filter {
+	c := filterCondition{field: field, operator: operator, values: values}
+	for i := range f.conditions {
+		if f.conditions[i].field == field {
+			f.conditions[i] = c
+			return f
+		}
+	}
+	f.conditions = append(f.conditions, c)
+	return f
+}
+
+// Is matches issues whose field equals one of values.
+func (f *Filter) Is(field string, values ...string) *Filter {
+	return f.Where(field, OpEquals, values...)
+}
+
+// IsNot matches issues whose field equals none of values.
+func (f *Filter) IsNot(field string, values ...string) *Filter {
+	return f.Where(field, OpNotEquals, values...)
+}
+
+// Contains matches issues whose text field contains s.
+func (f *Filter) Contains(field, s string) *Filter {
+	return f.Where(field, OpContains, s)
+}
+
+// Any matches issues where field is set.
+func (f *Filter) Any(field string) *Filter {
+	return f.Where(field, OpAny)
+}
+
+// None matches issues where field is not set.
+func (f *Filter) None(field string) *Filter {
+	return f.Where(field, OpNone)
+}
+
+// Between matches issues whose field lies between from and to, inclusive.
+func (f *Filter) Between(field, from, to string) *Filter {
+	return f.Where(field, OpBetween, from, to)
+}
+
+// Len returns the number of conditions.
+func (f *Filter) Len() int {
+	if f == nil {
+		return 0
+	}
+	return len(f.conditions)
+}
+
+// Values renders the filter as query parameters.
+func (f *Filter) Values() url.Values {
+	params := url.Values{}
+	f.encode(params)
+	return params
+}
+
+// String returns the encoded query string.
+func (f *Filter) String() string {
+	return f.Values().Encode()
+}
+
+func (f *Filter) encode(params url.Values) {
+	if f.Len() == 0 {
+		return
+	}
+
+	params.Set("set_filter", "1")
+	for _, c := range f.conditions {
+		params.Add("f[]", c.field)
+		params.Set("op["+c.field+"]", c.operator)
+		for _, v := range c.values {
+			params.Add("v["+c.field+"][]", v)
+		}
+	}
+}
+
+// shortOperators are the operators taking values that a short-form condition
+// may start with, longest first so that "><t-7" is not read as "><".
+var shortOperators = []string{
+	OpPastDays, OpNextDays,
+	OpLessThanDaysAgo, OpMoreThanDaysAgo, OpInLessThanDays, OpInMoreThanDays,
+	OpDaysAgo, OpInDays,
+	OpBetween, OpGreaterOrEqual, OpLessOrEqual, OpNotContains,
+	OpContains, OpStartsWith, OpEndsWith, OpEquals, OpNotEquals,
+}
+
+// relativeDateOperators take a number of days.
+var relativeDateOperators = []string{
+	OpPastDays, OpNextDays,
+	OpLessThanDaysAgo, OpMoreThanDaysAgo, OpInLessThanDays, OpInMoreThanDays,
+	OpDaysAgo, OpInDays,
+}
+
+// whereShort adds a condition written in the short form Redmine accepts for
+// plain issue list parameters, such as "open", "!*", ">=2024-01-01",
+// "><1|5", ">t-7", "~text", "^prefix" or "1|2".
+func (f *Filter) whereShort(field, expr string) {
+	if field == "status_id" {
+		switch expr {
+		case OpOpen, "open":
+			f.Where(field, OpOpen)
+			return
+		case OpClosed, "closed":
+			f.Where(field, OpClosed)
+			return
+		}
+	}
+	if expr == OpAny || expr == OpNone {
+		f.Where(field, expr)
+		return
+	}
+
+	for _, op := range shortOperators {
+		rest, ok := strings.CutPrefix(expr, op)
+		if !ok {
+			continue
+		}
+		// Redmine reads these on date fields only, so that a value such as
+		// "t-shirt" elsewhere is compared as is
+		if slices.Contains(relativeDateOperators, op) && !isDays(rest) {
+			continue
+		}
+		f.Where(field, op, strings.Split(rest, "|")...)
+		return
+	}
+	f.Where(field, OpEquals, strings.Split(expr, "|")...)
+}
+
+// isDays reports whether s is a number of days.
+func isDays(s string) bool {
+	n, err := strconv.Atoi(s)
+	return err == nil && n >= 0
+}
@@ -0,0 +1,196 @@
+package redmine
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestFilterValues(t *testing.T) {
+	f := NewFilter().
+		Where("status_id", OpOpen).
+		Between("due_date", "2024-01-01", "2024-01-31").
+		Contains(CustomFieldFilter(5), "urgent").
+		None("assigned_to_id").
+		Where("updated_on", OpLessThanDaysAgo, "7").
+		Is("status_id", "1", "2")
+
+	got := f.Values()
+	want := url.Values{
+		"set_filter":         {"1"},
+		"f[]":                {"status_id", "due_date", "cf_5", "assigned_to_id", "updated_on"},
+		"op[status_id]":      {"="},
+		"v[status_id][]":     {"1", "2"},
+		"op[due_date]":       {"><"},
+		"v[due_date][]":      {"2024-01-01", "2024-01-31"},
+		"op[cf_5]":           {"~"},
+		"v[cf_5][]":          {"urgent"},
+		"op[assigned_to_id]": {"!*"},
+		"op[updated_on]":     {">t-"},
+		"v[updated_on][]":    {"7"},
+	}
+
+	if got.Encode() != want.Encode() {
+		t.Errorf("Expected %s, got %s", want.Encode(), got.Encode())
+	}
+}
+
+func TestFilterWhereShort(t *testing.T) {
+	tests := []struct {
+		field  string
+		expr   string
+		op     string
+		values []string
+	}{
+		{field: "status_id", expr: "open", op: OpOpen},
+		{field: "status_id", expr: "*", op: OpAny},
+		{field: "subject", expr: "open", op: OpEquals, values: []string{"open"}},
+		{field: "assigned_to_id", expr: "me", op: OpEquals, values: []string{"me"}},
+		{field: "tracker_id", expr: "1|2", op: OpEquals, values: []string{"1", "2"}},
+		{field: "due_date", expr: "><2024-01-01|2024-01-31", op: OpBetween, values: []string{"2024-01-01", "2024-01-31"}},
+		{field: "created_on", expr: ">=2024-01-01", op: OpGreaterOrEqual, values: []string{"2024-01-01"}},
+		{field: "subject", expr: "~release", op: OpContains, values: []string{"release"}},
+		{field: "category_id", expr: "!*", op: OpNone},
+		{field: "status_id", expr: "c", op: OpClosed},
+		{field: "tracker_id", expr: "!1|2", op: OpNotEquals, values: []string{"1", "2"}},
+		{field: "tracker_id", expr: "=3", op: OpEquals, values: []string{"3"}},
+		{field: "due_date", expr: "<=2024-01-31", op: OpLessOrEqual, values: []string{"2024-01-31"}},
+		{field: "subject", expr: "!~draft", op: OpNotContains, values: []string{"draft"}},
+		{field: "subject", expr: "^Release", op: OpStartsWith, values: []string{"Release"}},
+		{field: "subject", expr: "$notes", op: OpEndsWith, values: []string{"notes"}},
+		{field: "updated_on", expr: "><t-7", op: OpPastDays, values: []string{"7"}},
+		{field: "updated_on", expr: ">t-7", op: OpLessThanDaysAgo, values: []string{"7"}},
+		{field: "updated_on", expr: "<t-7", op: OpMoreThanDaysAgo, values: []string{"7"}},
+		{field: "updated_on", expr: "t-7", op: OpDaysAgo, values: []string{"7"}},
+		{field: "due_date", expr: "><t+3", op: OpNextDays, values: []string{"3"}},
+		{field: "due_date", expr: ">t+3", op: OpInMoreThanDays, values: []string{"3"}},
+		{field: "due_date", expr: "<t+3", op: OpInLessThanDays, values: []string{"3"}},
+		{field: "due_date", expr: "t+3", op: OpInDays, values: []string{"3"}},
+		// Relative dates need a number of days
+		{field: "subject", expr: "t-shirt", op: OpEquals, values: []string{"t-shirt"}},
+	}
+
+	for _, tt := range tests {
+		f := NewFilter()
+		f.whereShort(tt.field, tt.expr)
+
+		c := f.conditions[0]
+		if c.operator != tt.op {
+			t.Errorf("%s=%s: expected operator %q, got %q", tt.field, tt.expr, tt.op, c.operator)
+		}
+		if len(c.values) != len(tt.values) {
+			t.Errorf("%s=%s: expected values %v, got %v", tt.field, tt.expr, tt.values, c.values)
+			continue
+		}
+		for i := range c.values {
+			if c.values[i] != tt.values[i] {
+				t.Errorf("%s=%s: expected values %v, got %v", tt.field, tt.expr, tt.values, c.values)
+			}
+		}
+	}
+}
+
+func TestListIssuesOptionsShortFiltersWithFilter(t *testing.T) {
+	// Adding a Filter must not change the meaning of the shortcut fields
+	opts := &ListIssuesOptions{
+		UpdatedOn: ">t-7",
+		DueDate:   "><t+3",
+		Subject:   "^Release",
+		Filter:    NewFilter().Is("author_id", "me"),
+	}
+
+	got := opts.values()
+	want := map[string][]string{
+		"op[updated_on]":  {">t-"},
+		"v[updated_on][]": {"7"},
+		"op[due_date]":    {"><t+"},
+		"v[due_date][]":   {"3"},
+		"op[subject]":     {"^"},
+		"v[subject][]":    {"Release"},
+	}
+	for key, values := range want {
+		if len(got[key]) != len(values) || got[key][0] != values[0] {
+			t.Errorf("Expected %s=%v, got %v", key, values, got[key])
+		}
+	}
+}
+
+func TestListIssuesWithFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("project_id") != "1" {
+			t.Errorf("Expected project_id=1, got %s", query.Get("project_id"))
+		}
+		if query.Has("status_id") || query.Has("author_id") {
+			t.Errorf("Expected shortcut filters to be converted, got %s", r.URL.RawQuery)
+		}
+
+		fields := query["f[]"]
+		want := []string{"status_id", "author_id", "cf_3", "watcher_id"}
+		if len(fields) != len(want) {
+			t.Fatalf("Expected fields %v, got %v", want, fields)
+		}
+		for i := range want {
+			if fields[i] != want[i] {
+				t.Errorf("Expected fields %v, got %v", want, fields)
+			}
+		}
+		if query.Get("op[status_id]") != "c" {
+			t.Errorf("Expected op[status_id]=c, got %s", query.Get("op[status_id]"))
+		}
+		if query.Get("v[author_id][]") != "me" {
+			t.Errorf("Expected v[author_id][]=me, got %s", query.Get("v[author_id][]"))
+		}
+		if query.Get("op[cf_3]") != "!" {
+			t.Errorf("Expected op[cf_3]=!, got %s", query.Get("op[cf_3]"))
+		}
+		if query.Get("op[watcher_id]") != "=" {
+			t.Errorf("Expected op[watcher_id]==, got %s", query.Get("op[watcher_id]"))
+		}
+
+		_ = json.NewEncoder(w).Encode(IssuesResponse{Issues: []Issue{}})
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+
+	_, err := client.ListIssues(context.Background(), &ListIssuesOptions{
+		ProjectID:    1,
+		StatusID:     "closed",
+		AuthorID:     "me",
+		CustomFields: map[int]string{3: "low"},
+		Filter:       NewFilter().IsNot(CustomFieldFilter(3), "low").Is("watcher_id", "me"),
+	})
+	if err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+}
+
+func TestListIssuesShortcutFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("author_id") != "5" || query.Get("watcher_id") != "me" || query.Get("cf_2") != "~foo" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		if query.Has("f[]") {
+			t.Errorf("Expected no generic filters, got %s", r.URL.RawQuery)
+		}
+
+		_ = json.NewEncoder(w).Encode(IssuesResponse{Issues: []Issue{}})
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+
+	_, err := client.ListIssues(context.Background(), &ListIssuesOptions{
+		AuthorID:     "5",
+		WatcherID:    "me",
+		CustomFields: map[int]string{2: "~foo"},
+	})
+	if err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+}
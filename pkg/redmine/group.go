@@ -117,8 +117,7 @@ func (c *Client) CreateGroup(ctx context.Context, group Group) (*GroupResponse,
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create group: %s", string(body))
+		return nil, fmt.Errorf("failed to create group: %w", newAPIError(resp))
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -152,8 +151,7 @@ func (c *Client) UpdateGroup(ctx context.Context, id int, group Group) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to update group: %s", string(body))
+		return fmt.Errorf("failed to update group: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -171,8 +169,7 @@ func (c *Client) DeleteGroup(ctx context.Context, id int) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete group: %s", string(body))
+		return fmt.Errorf("failed to delete group: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -200,8 +197,7 @@ func (c *Client) AddUserToGroup(ctx context.Context, groupID int, userID int) er
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to add user to group: %s", string(body))
+		return fmt.Errorf("failed to add user to group: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -219,8 +215,7 @@ func (c *Client) RemoveUserFromGroup(ctx context.Context, groupID int, userID in
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to remove user from group: %s", string(body))
+		return fmt.Errorf("failed to remove user from group: %w", newAPIError(resp))
 	}
 
 	return nil
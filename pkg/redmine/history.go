@@ -0,0 +1,359 @@
+package redmine
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// reverseRelations maps a relation type to its name as seen from the other issue
+var reverseRelations = map[string]string{
+	RelationRelates:    RelationRelates,
+	RelationDuplicates: RelationDuplicated,
+	RelationDuplicated: RelationDuplicates,
+	RelationBlocks:     RelationBlocked,
+	RelationBlocked:    RelationBlocks,
+	RelationPrecedes:   RelationFollows,
+	RelationFollows:    RelationPrecedes,
+	RelationCopiedTo:   RelationCopiedFrom,
+	RelationCopiedFrom: RelationCopiedTo,
+}
+
+// RelatedIssue is a relation as seen from the issue that holds it.
+type RelatedIssue struct {
+	Type    string
+	IssueID int
+}
+
+// IssueState is the state of an issue at a point in time, with values
+// recorded the way journals record them: IDs and numbers as decimal strings,
+// dates as YYYY-MM-DD, booleans as "0" or "1", and "" when unset.
+type IssueState struct {
+	At time.Time
+	// Attributes maps attribute names, such as "status_id", "assigned_to_id",
+	// "due_date" or "done_ratio", to their values.
+	Attributes map[string]string
+	// CustomFields maps custom field IDs to their values.
+	CustomFields map[int][]string
+	Relations    []RelatedIssue
+}
+
+// Value returns the value of an attribute, or of a custom field named as by
+// CustomFieldFilter ("cf_5"), with multiple values joined by ", ".
+func (s IssueState) Value(field string) string {
+	if id, ok := customFieldID(field); ok {
+		return strings.Join(s.CustomFields[id], ", ")
+	}
+	return s.Attributes[field]
+}
+
+// StatusID returns the status ID.
+func (s IssueState) StatusID() int {
+	return s.intAttribute("status_id")
+}
+
+// AssignedToID returns the ID of the assignee, or 0 if unassigned.
+func (s IssueState) AssignedToID() int {
+	return s.intAttribute("assigned_to_id")
+}
+
+// DoneRatio returns the done ratio.
+func (s IssueState) DoneRatio() int {
+	return s.intAttribute("done_ratio")
+}
+
+// DueDate returns the due date, or the zero Date if unset.
+func (s IssueState) DueDate() Date {
+	d, _ := ParseDate(s.Attributes["due_date"])
+	return d
+}
+
+// flatten returns the attributes and custom fields, named as by Value.
+func (s IssueState) flatten() map[string]string {
+	fields := maps.Clone(s.Attributes)
+	for id := range s.CustomFields {
+		fields[CustomFieldFilter(id)] = s.Value(CustomFieldFilter(id))
+	}
+	return fields
+}
+
+func (s IssueState) intAttribute(name string) int {
+	n, _ := strconv.Atoi(s.Attributes[name])
+	return n
+}
+
+func (s IssueState) clone() IssueState {
+	customFields := make(map[int][]string, len(s.CustomFields))
+	for id, values := range s.CustomFields {
+		customFields[id] = slices.Clone(values)
+	}
+	return IssueState{
+		At:           s.At,
+		Attributes:   maps.Clone(s.Attributes),
+		CustomFields: customFields,
+		Relations:    slices.Clone(s.Relations),
+	}
+}
+
+// undo reverts the changes recorded in j.
+func (s *IssueState) undo(j Journal, multiple map[int]bool) {
+	for _, d := range j.Details {
+		switch d.Property {
+		case JournalPropertyAttr:
+			s.Attributes[d.Name] = d.OldValue
+		case JournalPropertyCustomField:
+			id, err := strconv.Atoi(d.Name)
+			if err != nil {
+				continue
+			}
+			if !multiple[id] {
+				s.CustomFields[id] = nil
+				if d.OldValue != "" {
+					s.CustomFields[id] = []string{d.OldValue}
+				}
+				continue
+			}
+			// Values of multi-value fields are added and removed one at a time
+			if d.NewValue != "" {
+				s.CustomFields[id] = slices.DeleteFunc(s.CustomFields[id], func(v string) bool { return v == d.NewValue })
+			}
+			if d.OldValue != "" {
+				s.CustomFields[id] = append(s.CustomFields[id], d.OldValue)
+			}
+		case JournalPropertyRelation:
+			if id, err := strconv.Atoi(d.NewValue); err == nil {
+				s.Relations = slices.DeleteFunc(s.Relations, func(r RelatedIssue) bool {
+					return r == RelatedIssue{Type: d.Name, IssueID: id}
+				})
+			}
+			if id, err := strconv.Atoi(d.OldValue); err == nil {
+				s.Relations = append(s.Relations, RelatedIssue{Type: d.Name, IssueID: id})
+			}
+		}
+	}
+}
+
+// FieldChange is a change to a field recorded in a journal.
+type FieldChange struct {
+	JournalID int
+	User      Resource
+	At        time.Time
+	// Name is the attribute name, custom field ID or relation type.
+	Name     string
+	OldValue string
+	NewValue string
+}
+
+// FieldSpan is a period during which a field kept the same value.
+// To is the end of the period given to Spans for the latest value.
+type FieldSpan struct {
+	Value string
+	From  time.Time
+	To    time.Time
+}
+
+// IssueHistory reconstructs past states of an issue by replaying its journals
+// backwards from its current state.
+type IssueHistory struct {
+	issue    Issue
+	journals []Journal
+	multiple map[int]bool
+	// states[i] is the state before journals[i]; the last is the current state
+	states []IssueState
+}
+
+// NewIssueHistory returns the history of issue, which must have been
+// retrieved with include=journals, and with relations to track relations.
+func NewIssueHistory(issue Issue) *IssueHistory {
+	journals := slices.Clone(issue.Journals)
+	slices.SortStableFunc(journals, func(a, b Journal) int {
+		return a.CreatedOn.Compare(b.CreatedOn.Time)
+	})
+
+	h := &IssueHistory{issue: issue, journals: journals, multiple: map[int]bool{}}
+	for _, cf := range issue.CustomFields {
+		h.multiple[cf.ID] = cf.Multiple
+	}
+
+	h.states = make([]IssueState, len(journals)+1)
+	h.states[len(journals)] = currentIssueState(issue)
+	for i := len(journals) - 1; i >= 0; i-- {
+		s := h.states[i+1].clone()
+		s.undo(journals[i], h.multiple)
+		s.At = issue.CreatedOn.Time
+		if i > 0 {
+			s.At = journals[i-1].CreatedOn.Time
+		}
+		h.states[i] = s
+	}
+
+	return h
+}
+
+// GetIssueHistory retrieves an issue with its journals and relations and returns its history
+func (c *Client) GetIssueHistory(ctx context.Context, id int) (*IssueHistory, error) {
+	result, err := c.ShowIssue(ctx, id, &ShowIssueOptions{Include: "journals,relations"})
+	if err != nil {
+		return nil, err
+	}
+	return NewIssueHistory(result.Issue), nil
+}
+
+// Current returns the current state of the issue.
+func (h *IssueHistory) Current() IssueState {
+	return h.states[len(h.states)-1].clone()
+}
+
+// Initial returns the state of the issue when it was created.
+func (h *IssueHistory) Initial() IssueState {
+	return h.states[0].clone()
+}
+
+// AsOf returns the state of the issue at t, including changes made exactly at
+// t. For a time before the issue was created, it returns the initial state.
+func (h *IssueHistory) AsOf(t time.Time) IssueState {
+	i := len(h.journals)
+	for i > 0 && h.journals[i-1].CreatedOn.After(t) {
+		i--
+	}
+	s := h.states[i].clone()
+	s.At = t
+	return s
+}
+
+// Timeline returns the changes to field, oldest first. field is an attribute
+// name such as "status_id", a custom field named as by CustomFieldFilter,
+// "relations" for relations added and removed, or "attachments".
+func (h *IssueHistory) Timeline(field string) []FieldChange {
+	var changes []FieldChange
+	for _, j := range h.journals {
+		for _, d := range j.Details {
+			if journalDetailField(d) != field {
+				continue
+			}
+			changes = append(changes, FieldChange{
+				JournalID: j.ID,
+				User:      j.User,
+				At:        j.CreatedOn.Time,
+				Name:      d.Name,
+				OldValue:  d.OldValue,
+				NewValue:  d.NewValue,
+			})
+		}
+	}
+	return changes
+}
+
+// Spans returns the periods during which field kept each value, from the
+// creation of the issue until until, oldest first.
+func (h *IssueHistory) Spans(field string, until time.Time) []FieldSpan {
+	spans := []FieldSpan{{Value: h.states[0].Value(field), From: h.issue.CreatedOn.Time}}
+	for i := 1; i < len(h.states); i++ {
+		value := h.states[i].Value(field)
+		last := &spans[len(spans)-1]
+		if value == last.Value {
+			continue
+		}
+		at := h.journals[i-1].CreatedOn.Time
+		last.To = at
+		spans = append(spans, FieldSpan{Value: value, From: at})
+	}
+	spans[len(spans)-1].To = until
+	return spans
+}
+
+// Durations returns how long field had each value, until until. For status_id,
+// this is the time spent in each status.
+func (h *IssueHistory) Durations(field string, until time.Time) map[string]time.Duration {
+	durations := map[string]time.Duration{}
+	for _, span := range h.Spans(field, until) {
+		if span.To.After(span.From) {
+			durations[span.Value] += span.To.Sub(span.From)
+		}
+	}
+	return durations
+}
+
+// currentIssueState returns the state described by the fields of issue.
+func currentIssueState(issue Issue) IssueState {
+	s := IssueState{
+		At: issue.UpdatedOn.Time,
+		Attributes: map[string]string{
+			"project_id":       journalID(issue.Project.ID),
+			"tracker_id":       journalID(issue.Tracker.ID),
+			"status_id":        journalID(issue.Status.ID),
+			"priority_id":      journalID(issue.Priority.ID),
+			"assigned_to_id":   journalID(issue.AssignedTo.ID),
+			"category_id":      journalID(issue.Category.ID),
+			"fixed_version_id": journalID(issue.FixedVersion.ID),
+			"parent_id":        journalID(issue.Parent.ID),
+			"subject":          issue.Subject,
+			"description":      issue.Description,
+			"start_date":       issue.StartDate.String(),
+			"due_date":         issue.DueDate.String(),
+			"done_ratio":       strconv.Itoa(issue.DoneRatio),
+			"estimated_hours":  journalFloat(issue.EstimatedHours),
+			"is_private":       "0",
+		},
+		CustomFields: map[int][]string{},
+	}
+	if issue.IsPrivate {
+		s.Attributes["is_private"] = "1"
+	}
+	for _, cf := range issue.CustomFields {
+		s.CustomFields[cf.ID] = cf.ListValues()
+	}
+	for _, r := range issue.Relations {
+		if r.IssueID == issue.ID {
+			s.Relations = append(s.Relations, RelatedIssue{Type: r.RelationType, IssueID: r.IssueToID})
+		} else {
+			s.Relations = append(s.Relations, RelatedIssue{Type: reverseRelations[r.RelationType], IssueID: r.IssueID})
+		}
+	}
+	return s
+}
+
+// journalDetailField returns the field a journal detail changes, as named by Timeline.
+func journalDetailField(d JournalDetail) string {
+	switch d.Property {
+	case JournalPropertyCustomField:
+		return "cf_" + d.Name
+	case JournalPropertyRelation:
+		return "relations"
+	case JournalPropertyAttachment:
+		return "attachments"
+	default:
+		return d.Name
+	}
+}
+
+func customFieldID(field string) (int, bool) {
+	s, ok := strings.CutPrefix(field, "cf_")
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.Atoi(s)
+	return id, err == nil
+}
+
+func journalID(id int) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
+
+// journalFloat formats f as Ruby does, such as "2.0" or "1.5".
+func journalFloat(f float64) string {
+	if f == 0 {
+		return ""
+	}
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return s
+}
@@ -0,0 +1,190 @@
+package redmine
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+)
+
+func day(d int) time.Time {
+	return time.Date(2024, time.March, d, 9, 0, 0, 0, time.UTC)
+}
+
+// testIssueWithJournals returns an issue created on March 1 that was
+// started on March 3, rescheduled and reassigned on March 5, and resolved on
+// March 10, with journals in reverse order as a sort check.
+func testIssueWithJournals() Issue {
+	return Issue{
+		ID:         7,
+		Status:     Resource{ID: 3, Name: "Resolved"},
+		AssignedTo: Resource{ID: 12},
+		DueDate:    NewDate(2024, time.March, 20),
+		DoneRatio:  100,
+		CreatedOn:  Timestamp{day(1)},
+		UpdatedOn:  Timestamp{day(10)},
+		CustomFields: []CustomField{
+			{ID: 4, Name: "Severity", Value: "High"},
+			{ID: 5, Name: "Platforms", Multiple: true, Value: []any{"Linux", "macOS"}},
+		},
+		Relations: []IssueRelation{
+			{IssueID: 7, IssueToID: 8, RelationType: RelationBlocks},
+			{IssueID: 9, IssueToID: 7, RelationType: RelationPrecedes},
+		},
+		Journals: []Journal{
+			{ID: 3, User: Resource{ID: 12}, CreatedOn: Timestamp{day(10)}, Details: []JournalDetail{
+				{Property: JournalPropertyAttr, Name: "status_id", OldValue: "2", NewValue: "3"},
+				{Property: JournalPropertyAttr, Name: "done_ratio", OldValue: "50", NewValue: "100"},
+				{Property: JournalPropertyRelation, Name: RelationFollows, NewValue: "9"},
+			}},
+			{ID: 2, User: Resource{ID: 11}, CreatedOn: Timestamp{day(5)}, Details: []JournalDetail{
+				{Property: JournalPropertyAttr, Name: "due_date", OldValue: "2024-03-08", NewValue: "2024-03-20"},
+				{Property: JournalPropertyAttr, Name: "assigned_to_id", OldValue: "11", NewValue: "12"},
+				{Property: JournalPropertyCustomField, Name: "4", OldValue: "Low", NewValue: "High"},
+				{Property: JournalPropertyCustomField, Name: "5", NewValue: "macOS"},
+			}},
+			{ID: 1, User: Resource{ID: 11}, CreatedOn: Timestamp{day(3)}, Details: []JournalDetail{
+				{Property: JournalPropertyAttr, Name: "status_id", OldValue: "1", NewValue: "2"},
+				{Property: JournalPropertyAttr, Name: "done_ratio", OldValue: "0", NewValue: "50"},
+				{Property: JournalPropertyAttr, Name: "assigned_to_id", OldValue: "", NewValue: "11"},
+			}},
+		},
+	}
+}
+
+func TestIssueHistoryAsOf(t *testing.T) {
+	h := NewIssueHistory(testIssueWithJournals())
+
+	tests := []struct {
+		name      string
+		at        time.Time
+		status    int
+		assignee  int
+		dueDate   string
+		doneRatio int
+		severity  string
+		platforms string
+		relations []RelatedIssue
+	}{
+		{
+			name: "at creation", at: day(1), status: 1, assignee: 0, dueDate: "2024-03-08", doneRatio: 0,
+			severity: "Low", platforms: "Linux", relations: []RelatedIssue{{RelationBlocks, 8}},
+		},
+		{
+			name: "when started", at: day(3), status: 2, assignee: 11, dueDate: "2024-03-08", doneRatio: 50,
+			severity: "Low", platforms: "Linux", relations: []RelatedIssue{{RelationBlocks, 8}},
+		},
+		{
+			name: "after rescheduling", at: day(7), status: 2, assignee: 12, dueDate: "2024-03-20", doneRatio: 50,
+			severity: "High", platforms: "Linux, macOS", relations: []RelatedIssue{{RelationBlocks, 8}},
+		},
+		{
+			name: "now", at: day(30), status: 3, assignee: 12, dueDate: "2024-03-20", doneRatio: 100,
+			severity: "High", platforms: "Linux, macOS", relations: []RelatedIssue{{RelationBlocks, 8}, {RelationFollows, 9}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := h.AsOf(tt.at)
+			if s.StatusID() != tt.status {
+				t.Errorf("Expected status %d, got %d", tt.status, s.StatusID())
+			}
+			if s.AssignedToID() != tt.assignee {
+				t.Errorf("Expected assignee %d, got %d", tt.assignee, s.AssignedToID())
+			}
+			if s.DueDate().String() != tt.dueDate {
+				t.Errorf("Expected due date %s, got %s", tt.dueDate, s.DueDate())
+			}
+			if s.DoneRatio() != tt.doneRatio {
+				t.Errorf("Expected done ratio %d, got %d", tt.doneRatio, s.DoneRatio())
+			}
+			if s.Value("cf_4") != tt.severity {
+				t.Errorf("Expected severity %s, got %s", tt.severity, s.Value("cf_4"))
+			}
+			if s.Value("cf_5") != tt.platforms {
+				t.Errorf("Expected platforms %s, got %s", tt.platforms, s.Value("cf_5"))
+			}
+			if !slices.Equal(s.Relations, tt.relations) {
+				t.Errorf("Expected relations %v, got %v", tt.relations, s.Relations)
+			}
+		})
+	}
+
+	if h.Initial().StatusID() != 1 || h.Current().StatusID() != 3 {
+		t.Errorf("Expected initial status 1 and current status 3, got %d and %d", h.Initial().StatusID(), h.Current().StatusID())
+	}
+}
+
+func TestIssueHistoryTimeline(t *testing.T) {
+	h := NewIssueHistory(testIssueWithJournals())
+
+	changes := h.Timeline("status_id")
+	if len(changes) != 2 {
+		t.Fatalf("Expected 2 status changes, got %d", len(changes))
+	}
+	if changes[0].JournalID != 1 || changes[0].NewValue != "2" || !changes[0].At.Equal(day(3)) {
+		t.Errorf("Unexpected first change: %+v", changes[0])
+	}
+	if changes[1].User.ID != 12 || changes[1].OldValue != "2" || changes[1].NewValue != "3" {
+		t.Errorf("Unexpected second change: %+v", changes[1])
+	}
+
+	if got := h.Timeline("cf_4"); len(got) != 1 || got[0].NewValue != "High" {
+		t.Errorf("Expected one Severity change, got %v", got)
+	}
+	if got := h.Timeline("relations"); len(got) != 1 || got[0].Name != RelationFollows {
+		t.Errorf("Expected one relation change, got %v", got)
+	}
+}
+
+func TestIssueHistoryDurations(t *testing.T) {
+	h := NewIssueHistory(testIssueWithJournals())
+
+	spans := h.Spans("status_id", day(12))
+	want := []FieldSpan{
+		{Value: "1", From: day(1), To: day(3)},
+		{Value: "2", From: day(3), To: day(10)},
+		{Value: "3", From: day(10), To: day(12)},
+	}
+	if !slices.Equal(spans, want) {
+		t.Errorf("Expected spans %v, got %v", want, spans)
+	}
+
+	durations := h.Durations("status_id", day(12))
+	if durations["2"] != 7*24*time.Hour {
+		t.Errorf("Expected 7 days in status 2, got %v", durations["2"])
+	}
+
+	// The due date changed once, so there are two spans
+	if got := h.Spans("due_date", day(12)); len(got) != 2 || got[0].Value != "2024-03-08" {
+		t.Errorf("Unexpected due date spans: %v", got)
+	}
+}
+
+func TestGetIssueHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/issues/7.json" {
+			t.Errorf("Expected path /issues/7.json, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("include"); got != "journals,relations" {
+			t.Errorf("Expected include journals,relations, got %s", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(IssueResponse{Issue: testIssueWithJournals()})
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	h, err := client.GetIssueHistory(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("GetIssueHistory failed: %v", err)
+	}
+
+	if got := h.AsOf(day(4)).DueDate().String(); got != "2024-03-08" {
+		t.Errorf("Expected due date 2024-03-08, got %s", got)
+	}
+}
@@ -6,39 +6,51 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
+	"maps"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 )
 
-// Issue represents an issue returned by GET endpoints
+// Issue represents an issue returned by GET endpoints.
+// Children holds subtasks, each with its tracker, subject and own children,
+// when requested with include=children. ClosedOn is kept when an issue is
+// reopened; IsClosed, returned by Redmine 5.1 and later, reflects the current state.
 type Issue struct {
-	ID              int             `json:"id,omitempty"`
-	Project         Resource        `json:"project,omitempty"`
-	Tracker         Resource        `json:"tracker,omitempty"`
-	Status          Resource        `json:"status,omitempty"`
-	Priority        Resource        `json:"priority,omitempty"`
-	Author          Resource        `json:"author,omitempty"`
-	AssignedTo      Resource        `json:"assigned_to,omitempty"`
-	Category        Resource        `json:"category,omitempty"`
-	Subject         string          `json:"subject,omitempty"`
-	Description     string          `json:"description,omitempty"`
-	StartDate       string          `json:"start_date,omitempty"`
-	DueDate         string          `json:"due_date,omitempty"`
-	DoneRatio       int             `json:"done_ratio,omitempty"`
-	IsPrivate       bool            `json:"is_private,omitempty"`
-	EstimatedHours  float64         `json:"estimated_hours,omitempty"`
-	CustomFields    []CustomField   `json:"custom_fields,omitempty"`
-	CreatedOn       string          `json:"created_on,omitempty"`
-	UpdatedOn       string          `json:"updated_on,omitempty"`
-	ClosedOn        string          `json:"closed_on,omitempty"`
-	Journals        []Journal       `json:"journals,omitempty"`
-	Children        []Issue         `json:"children,omitempty"`
-	Attachments     []Attachment    `json:"attachments,omitempty"`
-	Relations       []IssueRelation `json:"relations,omitempty"`
-	Changesets      []Changeset     `json:"changesets,omitempty"`
-	Watchers        []Watcher       `json:"watchers,omitempty"`
-	AllowedStatuses []IssueStatus   `json:"allowed_statuses,omitempty"`
+	ID                  int             `json:"id,omitempty"`
+	Project             Resource        `json:"project,omitempty"`
+	Tracker             Resource        `json:"tracker,omitempty"`
+	Status              Resource        `json:"status,omitempty"`
+	Priority            Resource        `json:"priority,omitempty"`
+	Author              Resource        `json:"author,omitempty"`
+	AssignedTo          Resource        `json:"assigned_to,omitempty"`
+	Category            Resource        `json:"category,omitempty"`
+	FixedVersion        Resource        `json:"fixed_version,omitempty"`
+	Parent              Resource        `json:"parent,omitempty"`
+	Subject             string          `json:"subject,omitempty"`
+	Description         string          `json:"description,omitempty"`
+	StartDate           Date            `json:"start_date,omitzero"`
+	DueDate             Date            `json:"due_date,omitzero"`
+	DoneRatio           int             `json:"done_ratio,omitempty"`
+	IsPrivate           bool            `json:"is_private,omitempty"`
+	EstimatedHours      float64         `json:"estimated_hours,omitempty"`
+	TotalEstimatedHours float64         `json:"total_estimated_hours,omitempty"`
+	SpentHours          float64         `json:"spent_hours,omitempty"`
+	TotalSpentHours     float64         `json:"total_spent_hours,omitempty"`
+	CustomFields        []CustomField   `json:"custom_fields,omitempty"`
+	CreatedOn           Timestamp       `json:"created_on,omitzero"`
+	UpdatedOn           Timestamp       `json:"updated_on,omitzero"`
+	ClosedOn            Timestamp       `json:"closed_on,omitzero"`
+	IsClosed            bool            `json:"is_closed,omitempty"`
+	Journals            []Journal       `json:"journals,omitempty"`
+	Children            []Issue         `json:"children,omitempty"`
+	Attachments         []Attachment    `json:"attachments,omitempty"`
+	Relations           []IssueRelation `json:"relations,omitempty"`
+	Changesets          []Changeset     `json:"changesets,omitempty"`
+	Watchers            []Watcher       `json:"watchers,omitempty"`
+	AllowedStatuses     []IssueStatus   `json:"allowed_statuses,omitempty"`
 }
 
 // IssueCreateRequest represents the request body for creating a new issue
@@ -53,8 +65,8 @@ type IssueCreateRequest struct {
 	AssignedToID   int           `json:"assigned_to_id,omitempty"`
 	ParentIssueID  int           `json:"parent_issue_id,omitempty"`
 	Description    string        `json:"description,omitempty"`
-	StartDate      string        `json:"start_date,omitempty"`
-	DueDate        string        `json:"due_date,omitempty"`
+	StartDate      Date          `json:"start_date,omitzero"`
+	DueDate        Date          `json:"due_date,omitzero"`
 	DoneRatio      int           `json:"done_ratio,omitempty"`
 	EstimatedHours float64       `json:"estimated_hours,omitempty"`
 	IsPrivate      bool          `json:"is_private,omitempty"`
@@ -75,8 +87,8 @@ type IssueUpdateRequest struct {
 	AssignedToID   int           `json:"assigned_to_id,omitempty"`
 	ParentIssueID  int           `json:"parent_issue_id,omitempty"`
 	Description    string        `json:"description,omitempty"`
-	StartDate      string        `json:"start_date,omitempty"`
-	DueDate        string        `json:"due_date,omitempty"`
+	StartDate      Date          `json:"start_date,omitzero"`
+	DueDate        Date          `json:"due_date,omitzero"`
 	DoneRatio      int           `json:"done_ratio,omitempty"`
 	EstimatedHours float64       `json:"estimated_hours,omitempty"`
 	IsPrivate      bool          `json:"is_private,omitempty"`
@@ -84,6 +96,19 @@ type IssueUpdateRequest struct {
 	PrivateNotes   bool          `json:"private_notes,omitempty"`
 	CustomFields   []CustomField `json:"custom_fields,omitempty"`
 	Uploads        []Upload      `json:"uploads,omitempty"`
+
+	// ForceSendFields lists JSON keys, such as "done_ratio", that are sent
+	// even when their value is zero.
+	ForceSendFields []string `json:"-"`
+	// ClearFields lists JSON keys, such as "assigned_to_id", that are sent
+	// as an empty value to unset them.
+	ClearFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the request, honouring ForceSendFields and ClearFields.
+func (r IssueUpdateRequest) MarshalJSON() ([]byte, error) {
+	type request IssueUpdateRequest
+	return marshalPatch(request(r), r.ForceSendFields, r.ClearFields)
 }
 
 type IssuesResponse struct {
@@ -111,6 +136,8 @@ type ListIssuesOptions struct {
 	TrackerID      int
 	StatusID       string
 	AssignedToID   string
+	AuthorID       string
+	WatcherID      string
 	PriorityID     int
 	CategoryID     int
 	FixedVersionID int
@@ -118,6 +145,7 @@ type ListIssuesOptions struct {
 	ParentID       int
 	Subject        string
 	Description    string
+	// Date fields take expressions such as ">=2025-01-01"; see DateRange and TimeRange
 	CreatedOn      string
 	UpdatedOn      string
 	ClosedOn       string
@@ -125,88 +153,107 @@ type ListIssuesOptions struct {
 	DueDate        string
 	EstimatedHours string
 	DoneRatio      string
-	Include        string
-	Limit          int
-	Offset         int
-	Sort           string
+	// CustomFields filters by custom field ID, e.g. {5: "foo|bar"}
+	CustomFields map[int]string
+	// Filter adds generic filter conditions. When set, the fields above are
+	// sent as Filter conditions too, since Redmine ignores them otherwise.
+	Filter *Filter
+	// QueryID runs a saved query. Redmine then applies the query's own
+	// filters instead of the ones above.
+	QueryID int
+	Include string
+	Limit   int
+	Offset  int
+	Sort    string
 }
 
-// ListIssues retrieves a list of issues
-func (c *Client) ListIssues(ctx context.Context, opts *ListIssuesOptions) (*IssuesResponse, error) {
-	endpoint := c.baseURL + "/issues.json"
-
-	if opts != nil {
-		params := url.Values{}
-		if opts.ProjectID > 0 {
-			params.Add("project_id", strconv.Itoa(opts.ProjectID))
-		}
-		if opts.SubprojectID != "" {
-			params.Add("subproject_id", opts.SubprojectID)
-		}
-		if opts.TrackerID > 0 {
-			params.Add("tracker_id", strconv.Itoa(opts.TrackerID))
-		}
-		if opts.StatusID != "" {
-			params.Add("status_id", opts.StatusID)
-		}
-		if opts.AssignedToID != "" {
-			params.Add("assigned_to_id", opts.AssignedToID)
-		}
-		if opts.PriorityID > 0 {
-			params.Add("priority_id", strconv.Itoa(opts.PriorityID))
+// shortFilters returns the shortcut filters set in o as field and expression pairs
+func (o *ListIssuesOptions) shortFilters() [][2]string {
+	var filters [][2]string
+	add := func(field, expr string) {
+		if expr != "" {
+			filters = append(filters, [2]string{field, expr})
 		}
-		if opts.CategoryID > 0 {
-			params.Add("category_id", strconv.Itoa(opts.CategoryID))
-		}
-		if opts.FixedVersionID > 0 {
-			params.Add("fixed_version_id", strconv.Itoa(opts.FixedVersionID))
-		}
-		if opts.IssueID != "" {
-			params.Add("issue_id", opts.IssueID)
-		}
-		if opts.ParentID > 0 {
-			params.Add("parent_id", strconv.Itoa(opts.ParentID))
-		}
-		if opts.Subject != "" {
-			params.Add("subject", opts.Subject)
-		}
-		if opts.Description != "" {
-			params.Add("description", opts.Description)
-		}
-		if opts.CreatedOn != "" {
-			params.Add("created_on", opts.CreatedOn)
-		}
-		if opts.UpdatedOn != "" {
-			params.Add("updated_on", opts.UpdatedOn)
-		}
-		if opts.ClosedOn != "" {
-			params.Add("closed_on", opts.ClosedOn)
-		}
-		if opts.StartDate != "" {
-			params.Add("start_date", opts.StartDate)
-		}
-		if opts.DueDate != "" {
-			params.Add("due_date", opts.DueDate)
-		}
-		if opts.EstimatedHours != "" {
-			params.Add("estimated_hours", opts.EstimatedHours)
-		}
-		if opts.DoneRatio != "" {
-			params.Add("done_ratio", opts.DoneRatio)
-		}
-		if opts.Include != "" {
-			params.Add("include", opts.Include)
+	}
+	addInt := func(field string, v int) {
+		if v > 0 {
+			add(field, strconv.Itoa(v))
 		}
-		if opts.Limit > 0 {
-			params.Add("limit", strconv.Itoa(opts.Limit))
+	}
+
+	add("subproject_id", o.SubprojectID)
+	addInt("tracker_id", o.TrackerID)
+	add("status_id", o.StatusID)
+	add("assigned_to_id", o.AssignedToID)
+	add("author_id", o.AuthorID)
+	add("watcher_id", o.WatcherID)
+	addInt("priority_id", o.PriorityID)
+	addInt("category_id", o.CategoryID)
+	addInt("fixed_version_id", o.FixedVersionID)
+	add("issue_id", o.IssueID)
+	addInt("parent_id", o.ParentID)
+	add("subject", o.Subject)
+	add("description", o.Description)
+	add("created_on", o.CreatedOn)
+	add("updated_on", o.UpdatedOn)
+	add("closed_on", o.ClosedOn)
+	add("start_date", o.StartDate)
+	add("due_date", o.DueDate)
+	add("estimated_hours", o.EstimatedHours)
+	add("done_ratio", o.DoneRatio)
+	for _, id := range slices.Sorted(maps.Keys(o.CustomFields)) {
+		add(CustomFieldFilter(id), o.CustomFields[id])
+	}
+
+	return filters
+}
+
+// values encodes o as query parameters
+func (o *ListIssuesOptions) values() url.Values {
+	params := url.Values{}
+	if o.ProjectID > 0 {
+		params.Add("project_id", strconv.Itoa(o.ProjectID))
+	}
+
+	if o.Filter.Len() > 0 {
+		filter := NewFilter()
+		for _, sf := range o.shortFilters() {
+			filter.whereShort(sf[0], sf[1])
 		}
-		if opts.Offset > 0 {
-			params.Add("offset", strconv.Itoa(opts.Offset))
+		for _, c := range o.Filter.conditions {
+			filter.Where(c.field, c.operator, c.values...)
 		}
-		if opts.Sort != "" {
-			params.Add("sort", opts.Sort)
+		filter.encode(params)
+	} else {
+		for _, sf := range o.shortFilters() {
+			params.Add(sf[0], sf[1])
 		}
-		if len(params) > 0 {
+	}
+
+	if o.QueryID > 0 {
+		params.Add("query_id", strconv.Itoa(o.QueryID))
+	}
+	if o.Include != "" {
+		params.Add("include", o.Include)
+	}
+	if o.Limit > 0 {
+		params.Add("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		params.Add("offset", strconv.Itoa(o.Offset))
+	}
+	if o.Sort != "" {
+		params.Add("sort", o.Sort)
+	}
+	return params
+}
+
+// ListIssues retrieves a list of issues
+func (c *Client) ListIssues(ctx context.Context, opts *ListIssuesOptions) (*IssuesResponse, error) {
+	endpoint := c.baseURL + "/issues.json"
+
+	if opts != nil {
+		if params := opts.values(); len(params) > 0 {
 			endpoint = fmt.Sprintf("%s?%s", endpoint, params.Encode())
 		}
 	}
@@ -283,8 +330,7 @@ func (c *Client) CreateIssue(ctx context.Context, req IssueCreateRequest) (*Issu
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create issue: %s", string(body))
+		return nil, fmt.Errorf("failed to create issue: %w", newAPIError(resp))
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -318,8 +364,7 @@ func (c *Client) UpdateIssue(ctx context.Context, id int, req IssueUpdateRequest
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to update issue: %s", string(body))
+		return fmt.Errorf("failed to update issue: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -337,8 +382,7 @@ func (c *Client) DeleteIssue(ctx context.Context, id int) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete issue: %s", string(body))
+		return fmt.Errorf("failed to delete issue: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -365,9 +409,9 @@ func (c *Client) AddWatcher(ctx context.Context, issueID int, userID int) error
 	//nolint:errcheck
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to add watcher: %s", string(body))
+	// Redmine answers 204 No Content; older versions answer 200 OK
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to add watcher: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -385,9 +429,32 @@ func (c *Client) RemoveWatcher(ctx context.Context, issueID int, userID int) err
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to remove watcher: %s", string(body))
+		return fmt.Errorf("failed to remove watcher: %w", newAPIError(resp))
 	}
 
 	return nil
 }
+
+// AllIssues returns an iterator over every issue matching opts, fetching pages as needed.
+// opts.Limit sets the page size and opts.Offset the starting position.
+func (c *Client) AllIssues(ctx context.Context, opts *ListIssuesOptions) iter.Seq2[Issue, error] {
+	var base ListIssuesOptions
+	if opts != nil {
+		base = *opts
+	}
+
+	return paginate(ctx, c, base.Offset, base.Limit, func(ctx context.Context, offset, limit int) page[Issue] {
+		o := base
+		o.Offset, o.Limit = offset, limit
+		resp, err := c.ListIssues(ctx, &o)
+		if err != nil {
+			return page[Issue]{err: err}
+		}
+		return page[Issue]{items: resp.Issues, total: resp.TotalCount, limit: resp.Limit}
+	})
+}
+
+// ListAllIssues retrieves every issue matching opts across all pages
+func (c *Client) ListAllIssues(ctx context.Context, opts *ListIssuesOptions) ([]Issue, error) {
+	return collect(c.AllIssues(ctx, opts))
+}
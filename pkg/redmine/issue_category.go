@@ -112,8 +112,7 @@ func (c *Client) CreateIssueCategory(ctx context.Context, projectIDOrIdentifier
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create issue category: %s", string(body))
+		return nil, fmt.Errorf("failed to create issue category: %w", newAPIError(resp))
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -147,8 +146,7 @@ func (c *Client) UpdateIssueCategory(ctx context.Context, id int, req IssueCateg
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to update issue category: %s", string(body))
+		return fmt.Errorf("failed to update issue category: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -174,8 +172,7 @@ func (c *Client) DeleteIssueCategory(ctx context.Context, id int, opts *DeleteIs
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete issue category: %s", string(body))
+		return fmt.Errorf("failed to delete issue category: %w", newAPIError(resp))
 	}
 
 	return nil
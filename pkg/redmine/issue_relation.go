@@ -9,6 +9,19 @@ import (
 	"net/http"
 )
 
+// Relation types accepted in IssueRelation.RelationType
+const (
+	RelationRelates    = "relates"
+	RelationDuplicates = "duplicates"
+	RelationDuplicated = "duplicated"
+	RelationBlocks     = "blocks"
+	RelationBlocked    = "blocked"
+	RelationPrecedes   = "precedes"
+	RelationFollows    = "follows"
+	RelationCopiedTo   = "copied_to"
+	RelationCopiedFrom = "copied_from"
+)
+
 type IssueRelation struct {
 	ID           int    `json:"id,omitempty"`
 	IssueID      int    `json:"issue_id,omitempty"`
@@ -95,8 +108,7 @@ func (c *Client) CreateIssueRelation(ctx context.Context, issueID int, relation
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create issue relation: %s", string(body))
+		return nil, fmt.Errorf("failed to create issue relation: %w", newAPIError(resp))
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -124,8 +136,7 @@ func (c *Client) DeleteIssueRelation(ctx context.Context, relationID int) error
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete issue relation: %s", string(body))
+		return fmt.Errorf("failed to delete issue relation: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -123,31 +123,118 @@ func TestCreateIssue(t *testing.T) {
 }
 
 func TestAddWatcher(t *testing.T) {
+	// Redmine answers 204 No Content; older versions answer 200 OK
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "no content", status: http.StatusNoContent},
+		{name: "created", status: http.StatusCreated},
+		{name: "ok", status: http.StatusOK},
+		{name: "accepted", status: http.StatusAccepted, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("Expected POST request, got %s", r.Method)
+				}
+				if r.URL.Path != "/issues/1/watchers.json" {
+					t.Errorf("Expected path /issues/1/watchers.json, got %s", r.URL.Path)
+				}
+
+				var req WatcherRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Errorf("Failed to decode request: %v", err)
+				}
+
+				if req.UserID != 5 {
+					t.Errorf("Expected user_id 5, got %d", req.UserID)
+				}
+
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			client := New(server.URL, "test-api-key")
+
+			err := client.AddWatcher(context.Background(), 1, 5)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRemoveWatcher(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			t.Errorf("Expected POST request, got %s", r.Method)
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
 		}
-		if r.URL.Path != "/issues/1/watchers.json" {
-			t.Errorf("Expected path /issues/1/watchers.json, got %s", r.URL.Path)
+		if r.URL.Path != "/issues/1/watchers/5.json" {
+			t.Errorf("Expected path /issues/1/watchers/5.json, got %s", r.URL.Path)
 		}
 
-		var req WatcherRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			t.Fatalf("Failed to decode request: %v", err)
-		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
 
-		if req.UserID != 5 {
-			t.Errorf("Expected user_id 5, got %d", req.UserID)
+	if err := client.RemoveWatcher(context.Background(), 1, 5); err != nil {
+		t.Fatalf("RemoveWatcher failed: %v", err)
+	}
+}
+
+func TestShowIssueHierarchy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include") != "children" {
+			t.Errorf("Expected include=children, got %s", r.URL.Query().Get("include"))
 		}
 
-		w.WriteHeader(http.StatusCreated)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"issue":{
+			"id":10,"subject":"Release 2.0",
+			"parent":{"id":1},
+			"fixed_version":{"id":3,"name":"2.0"},
+			"estimated_hours":4.0,"total_estimated_hours":12.5,
+			"spent_hours":2.0,"total_spent_hours":7.25,
+			"is_closed":true,"closed_on":"2024-01-15T09:00:00Z",
+			"children":[
+				{"id":11,"tracker":{"id":2,"name":"Feature"},"subject":"Packaging",
+				 "children":[{"id":12,"tracker":{"id":1,"name":"Bug"},"subject":"Fix installer"}]}
+			]
+		}}`))
 	}))
 	defer server.Close()
 
 	client := New(server.URL, "test-api-key")
 
-	err := client.AddWatcher(context.Background(), 1, 5)
+	result, err := client.ShowIssue(context.Background(), 10, &ShowIssueOptions{Include: "children"})
 	if err != nil {
-		t.Fatalf("AddWatcher failed: %v", err)
+		t.Fatalf("ShowIssue failed: %v", err)
+	}
+
+	issue := result.Issue
+	if issue.Parent.ID != 1 {
+		t.Errorf("Expected parent 1, got %d", issue.Parent.ID)
+	}
+	if issue.FixedVersion.Name != "2.0" {
+		t.Errorf("Expected fixed version 2.0, got %s", issue.FixedVersion.Name)
+	}
+	if issue.TotalEstimatedHours != 12.5 || issue.SpentHours != 2.0 || issue.TotalSpentHours != 7.25 {
+		t.Errorf("Unexpected hours: total estimated %v, spent %v, total spent %v", issue.TotalEstimatedHours, issue.SpentHours, issue.TotalSpentHours)
+	}
+	if !issue.IsClosed {
+		t.Error("Expected is_closed to be true")
+	}
+	if len(issue.Children) != 1 || len(issue.Children[0].Children) != 1 {
+		t.Fatalf("Expected nested children, got %+v", issue.Children)
+	}
+	grandchild := issue.Children[0].Children[0]
+	if grandchild.ID != 12 || grandchild.Tracker.Name != "Bug" || grandchild.Subject != "Fix installer" {
+		t.Errorf("Unexpected grandchild %+v", grandchild)
 	}
 }
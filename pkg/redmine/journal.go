@@ -12,10 +12,21 @@ type Journal struct {
 	ID        int             `json:"id,omitempty"`
 	User      Resource        `json:"user,omitempty"`
 	Notes     string          `json:"notes,omitempty"`
-	CreatedOn string          `json:"created_on,omitempty"`
+	CreatedOn Timestamp       `json:"created_on,omitzero"`
 	Details   []JournalDetail `json:"details,omitempty"`
 }
 
+// Properties of a JournalDetail. For attributes, Name is the attribute name
+// such as "status_id"; for custom fields, the custom field ID; for relations,
+// the relation type, with the related issue ID as the new value when the
+// relation was added and as the old value when it was removed.
+const (
+	JournalPropertyAttr        = "attr"
+	JournalPropertyCustomField = "cf"
+	JournalPropertyRelation    = "relation"
+	JournalPropertyAttachment  = "attachment"
+)
+
 type JournalDetail struct {
 	Property string `json:"property,omitempty"`
 	Name     string `json:"name,omitempty"`
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestShowJournal(t *testing.T) {
@@ -24,7 +25,7 @@ func TestShowJournal(t *testing.T) {
 			Journal: Journal{
 				ID:        123,
 				Notes:     "Test journal note",
-				CreatedOn: "2024-01-01T00:00:00Z",
+				CreatedOn: Timestamp{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
 			},
 		}
 		w.Header().Set("Content-Type", "application/json")
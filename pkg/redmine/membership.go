@@ -6,7 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 type Membership struct {
@@ -39,8 +42,24 @@ type MembershipCreateUpdate struct {
 
 // ListMemberships retrieves paginated list of project memberships
 func (c *Client) ListMemberships(ctx context.Context, projectIDOrIdentifier string) (*MembershipsResponse, error) {
+	return c.listMemberships(ctx, projectIDOrIdentifier, 0, 0)
+}
+
+// listMemberships retrieves a single page of project memberships
+func (c *Client) listMemberships(ctx context.Context, projectIDOrIdentifier string, offset, limit int) (*MembershipsResponse, error) {
 	endpoint := fmt.Sprintf("%s/projects/%s/memberships.json", c.baseURL, projectIDOrIdentifier)
 
+	params := url.Values{}
+	if limit > 0 {
+		params.Add("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		params.Add("offset", strconv.Itoa(offset))
+	}
+	if len(params) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	}
+
 	resp, err := c.do(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, err
@@ -61,6 +80,22 @@ func (c *Client) ListMemberships(ctx context.Context, projectIDOrIdentifier stri
 	return &result, nil
 }
 
+// AllMemberships returns an iterator over every membership of a project, fetching pages as needed.
+func (c *Client) AllMemberships(ctx context.Context, projectIDOrIdentifier string) iter.Seq2[Membership, error] {
+	return paginate(ctx, c, 0, 0, func(ctx context.Context, offset, limit int) page[Membership] {
+		resp, err := c.listMemberships(ctx, projectIDOrIdentifier, offset, limit)
+		if err != nil {
+			return page[Membership]{err: err}
+		}
+		return page[Membership]{items: resp.Memberships, total: resp.TotalCount, limit: resp.Limit}
+	})
+}
+
+// ListAllMemberships retrieves every membership of a project across all pages
+func (c *Client) ListAllMemberships(ctx context.Context, projectIDOrIdentifier string) ([]Membership, error) {
+	return collect(c.AllMemberships(ctx, projectIDOrIdentifier))
+}
+
 // ShowMembership retrieves specific membership details
 func (c *Client) ShowMembership(ctx context.Context, id int) (*MembershipResponse, error) {
 	endpoint := fmt.Sprintf("%s/memberships/%d.json", c.baseURL, id)
@@ -103,8 +138,7 @@ func (c *Client) CreateMembership(ctx context.Context, projectIDOrIdentifier str
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create membership: %s", string(body))
+		return nil, fmt.Errorf("failed to create membership: %w", newAPIError(resp))
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -142,8 +176,7 @@ func (c *Client) UpdateMembership(ctx context.Context, id int, roleIDs []int) er
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to update membership: %s", string(body))
+		return fmt.Errorf("failed to update membership: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -161,8 +194,7 @@ func (c *Client) DeleteMembership(ctx context.Context, id int) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete membership: %s", string(body))
+		return fmt.Errorf("failed to delete membership: %w", newAPIError(resp))
 	}
 
 	return nil
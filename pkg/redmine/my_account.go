@@ -59,8 +59,7 @@ func (c *Client) UpdateMyAccount(ctx context.Context, user User) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to update my account: %s", string(body))
+		return fmt.Errorf("failed to update my account: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -5,19 +5,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
 )
 
 type News struct {
-	ID          int      `json:"id,omitempty"`
-	Project     Resource `json:"project,omitempty"`
-	Author      Resource `json:"author,omitempty"`
-	Title       string   `json:"title,omitempty"`
-	Summary     string   `json:"summary,omitempty"`
-	Description string   `json:"description,omitempty"`
-	CreatedOn   string   `json:"created_on,omitempty"`
+	ID          int       `json:"id,omitempty"`
+	Project     Resource  `json:"project,omitempty"`
+	Author      Resource  `json:"author,omitempty"`
+	Title       string    `json:"title,omitempty"`
+	Summary     string    `json:"summary,omitempty"`
+	Description string    `json:"description,omitempty"`
+	CreatedOn   Timestamp `json:"created_on,omitzero"`
 }
 
 type NewsResponse struct {
@@ -105,3 +106,50 @@ func (c *Client) ListProjectNews(ctx context.Context, projectIDOrIdentifier stri
 
 	return &result, nil
 }
+
+// AllNews returns an iterator over every news item matching opts, fetching pages as needed.
+// opts.Limit sets the page size and opts.Offset the starting position.
+func (c *Client) AllNews(ctx context.Context, opts *ListNewsOptions) iter.Seq2[News, error] {
+	var base ListNewsOptions
+	if opts != nil {
+		base = *opts
+	}
+
+	return paginate(ctx, c, base.Offset, base.Limit, func(ctx context.Context, offset, limit int) page[News] {
+		o := base
+		o.Offset, o.Limit = offset, limit
+		resp, err := c.ListNews(ctx, &o)
+		if err != nil {
+			return page[News]{err: err}
+		}
+		return page[News]{items: resp.News, total: resp.TotalCount, limit: resp.Limit}
+	})
+}
+
+// ListAllNews retrieves every news item matching opts across all pages
+func (c *Client) ListAllNews(ctx context.Context, opts *ListNewsOptions) ([]News, error) {
+	return collect(c.AllNews(ctx, opts))
+}
+
+// AllProjectNews returns an iterator over every news item of a project, fetching pages as needed.
+func (c *Client) AllProjectNews(ctx context.Context, projectIDOrIdentifier string, opts *ListNewsOptions) iter.Seq2[News, error] {
+	var base ListNewsOptions
+	if opts != nil {
+		base = *opts
+	}
+
+	return paginate(ctx, c, base.Offset, base.Limit, func(ctx context.Context, offset, limit int) page[News] {
+		o := base
+		o.Offset, o.Limit = offset, limit
+		resp, err := c.ListProjectNews(ctx, projectIDOrIdentifier, &o)
+		if err != nil {
+			return page[News]{err: err}
+		}
+		return page[News]{items: resp.News, total: resp.TotalCount, limit: resp.Limit}
+	})
+}
+
+// ListAllProjectNews retrieves every news item of a project across all pages
+func (c *Client) ListAllProjectNews(ctx context.Context, projectIDOrIdentifier string, opts *ListNewsOptions) ([]News, error) {
+	return collect(c.AllProjectNews(ctx, projectIDOrIdentifier, opts))
+}
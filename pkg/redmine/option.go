@@ -0,0 +1,102 @@
+package redmine
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Option configures a Client created by New.
+type Option func(*Client)
+
+// WithHTTPClient replaces the underlying HTTP client.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.HTTPClient = httpClient
+	}
+}
+
+// WithTimeout sets the timeout of the underlying HTTP client. It applies to a
+// copy of the client given to WithHTTPClient, in either order.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithTransport sets the transport of the underlying HTTP client. It applies
+// to a copy of the client given to WithHTTPClient, in either order.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.transport = transport
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithHeader adds a header sent with every request.
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		c.headers.Add(key, value)
+	}
+}
+
+// WithAuthenticator replaces the API key authentication given to New.
+func WithAuthenticator(auth Authenticator) Option {
+	return func(c *Client) {
+		c.auth = auth
+	}
+}
+
+// WithSwitchUser impersonates the user with the given login on every request.
+// It wraps the authenticator configured so far, so apply it after WithAuthenticator.
+func WithSwitchUser(login string) Option {
+	return func(c *Client) {
+		c.auth = SwitchUserAuth(c.auth, login)
+	}
+}
+
+// WithLogger sets a logger that receives a debug record for every request.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithFeedKey sets the key authenticating Atom feeds, such as ListActivity.
+// Redmine does not accept the API key for feeds: use the "Atom access key"
+// shown on the My account page. The key is sent in the URL, and is hidden in
+// logs and errors.
+func WithFeedKey(key string) Option {
+	return func(c *Client) {
+		c.feedKey = key
+	}
+}
+
+// WithCache caches the responses for lookup resources, such as trackers,
+// statuses and versions, in cache. See Cache.
+func WithCache(cache *Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithPrefetch makes the All* iterators fetch up to n pages concurrently.
+// Values below 2 fetch pages one at a time.
+func WithPrefetch(n int) Option {
+	return func(c *Client) {
+		c.prefetch = n
+	}
+}
+
+// WithRetry sets the retry policy. See RetryPolicy.
+func WithRetry(policy *RetryPolicy) Option {
+	return func(c *Client) {
+		c.Retry = policy
+	}
+}
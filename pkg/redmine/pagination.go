@@ -0,0 +1,151 @@
+package redmine
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// maxPageSize is the largest limit Redmine accepts for list endpoints.
+const maxPageSize = 100
+
+// page is a single page returned by a list endpoint.
+type page[T any] struct {
+	items []T
+	total int
+	limit int
+	err   error
+}
+
+// pageFetcher fetches the page starting at offset.
+type pageFetcher[T any] func(ctx context.Context, offset, limit int) page[T]
+
+// paginate returns an iterator that walks every page from offset onwards.
+// limit is the requested page size; values outside 1-100 use 100.
+// When the client has prefetch enabled, pages after the first are fetched
+// concurrently and yielded in order.
+func paginate[T any](ctx context.Context, c *Client, offset, limit int, fetch pageFetcher[T]) iter.Seq2[T, error] {
+	if limit <= 0 || limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		first := fetch(ctx, offset, limit)
+		if first.err != nil {
+			yield(zero, first.err)
+			return
+		}
+		for _, item := range first.items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+
+		// Redmine may cap the limit below what was requested
+		if first.limit > 0 && first.limit < limit {
+			limit = first.limit
+		}
+		next := offset + len(first.items)
+		if len(first.items) == 0 || next >= first.total {
+			return
+		}
+
+		if c.prefetch > 1 {
+			paginateConcurrent(ctx, c.prefetch, next, limit, first.total, fetch, yield)
+			return
+		}
+
+		for next < first.total {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			p := fetch(ctx, next, limit)
+			if p.err != nil {
+				yield(zero, p.err)
+				return
+			}
+			for _, item := range p.items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(p.items) == 0 {
+				return
+			}
+			next += len(p.items)
+		}
+	}
+}
+
+// paginateConcurrent fetches the pages between offset and total with at most
+// concurrency requests in flight and yields their items in order.
+func paginateConcurrent[T any](ctx context.Context, concurrency, offset, limit, total int, fetch pageFetcher[T], yield func(T, error) bool) {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	offsets := []int{}
+	for o := offset; o < total; o += limit {
+		offsets = append(offsets, o)
+	}
+
+	results := make([]chan page[T], len(offsets))
+	for i := range results {
+		results[i] = make(chan page[T], 1)
+	}
+
+	// A slot is taken per fetched page and released once the page is yielded,
+	// which bounds both in-flight requests and buffered pages.
+	sem := make(chan struct{}, concurrency)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i, o := range offsets {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				for _, ch := range results[i:] {
+					ch <- page[T]{err: ctx.Err()}
+				}
+				return
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i] <- fetch(ctx, o, limit)
+			}()
+		}
+	}()
+
+	var zero T
+	for i := range offsets {
+		p := <-results[i]
+		if p.err != nil {
+			yield(zero, p.err)
+			return
+		}
+		for _, item := range p.items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+		<-sem
+	}
+}
+
+// collect drains seq into a slice, stopping at the first error.
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	items := []T{}
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
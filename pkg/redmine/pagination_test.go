@@ -0,0 +1,186 @@
+package redmine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// newPagedIssuesServer serves total issues, capping the page size at maxLimit.
+func newPagedIssuesServer(t *testing.T, total, maxLimit int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if limit <= 0 || limit > maxLimit {
+			limit = maxLimit
+		}
+
+		issues := []Issue{}
+		for id := offset + 1; id <= total && id <= offset+limit; id++ {
+			issues = append(issues, Issue{ID: id})
+		}
+		_ = json.NewEncoder(w).Encode(IssuesResponse{
+			Issues:     issues,
+			TotalCount: total,
+			Offset:     offset,
+			Limit:      limit,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func assertSequentialIDs(t *testing.T, issues []Issue, want int) {
+	t.Helper()
+
+	if len(issues) != want {
+		t.Fatalf("Expected %d issues, got %d", want, len(issues))
+	}
+	for i, issue := range issues {
+		if issue.ID != i+1 {
+			t.Fatalf("Expected issue %d at position %d, got %d", i+1, i, issue.ID)
+		}
+	}
+}
+
+func TestListAllIssues(t *testing.T) {
+	server, calls := newPagedIssuesServer(t, 250, 100)
+	client := New(server.URL, "test-api-key")
+
+	issues, err := client.ListAllIssues(context.Background(), &ListIssuesOptions{ProjectID: 1})
+	if err != nil {
+		t.Fatalf("ListAllIssues failed: %v", err)
+	}
+
+	assertSequentialIDs(t, issues, 250)
+	if calls.Load() != 3 {
+		t.Errorf("Expected 3 requests, got %d", calls.Load())
+	}
+}
+
+func TestAllIssuesServerCapsLimit(t *testing.T) {
+	server, _ := newPagedIssuesServer(t, 60, 25)
+	client := New(server.URL, "test-api-key", WithPrefetch(3))
+
+	issues, err := client.ListAllIssues(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListAllIssues failed: %v", err)
+	}
+
+	assertSequentialIDs(t, issues, 60)
+}
+
+func TestAllIssuesPrefetch(t *testing.T) {
+	server, calls := newPagedIssuesServer(t, 1000, 100)
+	client := New(server.URL, "test-api-key", WithPrefetch(4))
+
+	issues, err := client.ListAllIssues(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListAllIssues failed: %v", err)
+	}
+
+	assertSequentialIDs(t, issues, 1000)
+	if calls.Load() != 10 {
+		t.Errorf("Expected 10 requests, got %d", calls.Load())
+	}
+}
+
+func TestAllIssuesStopsOnBreak(t *testing.T) {
+	for _, prefetch := range []int{0, 4} {
+		server, calls := newPagedIssuesServer(t, 1000, 100)
+		client := New(server.URL, "test-api-key", WithPrefetch(prefetch))
+
+		count := 0
+		for _, err := range client.AllIssues(context.Background(), nil) {
+			if err != nil {
+				t.Fatalf("AllIssues failed: %v", err)
+			}
+			count++
+			if count == 150 {
+				break
+			}
+		}
+
+		if count != 150 {
+			t.Errorf("Expected 150 issues, got %d", count)
+		}
+		if got := calls.Load(); got > int32(2+prefetch) {
+			t.Errorf("prefetch %d: expected at most %d requests, got %d", prefetch, 2+prefetch, got)
+		}
+	}
+}
+
+func TestAllIssuesStopsOnContextCancel(t *testing.T) {
+	server, _ := newPagedIssuesServer(t, 500, 100)
+	client := New(server.URL, "test-api-key")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var gotErr error
+	count := 0
+	for _, err := range client.AllIssues(ctx, nil) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		count++
+		if count == 100 {
+			cancel()
+		}
+	}
+
+	if !errors.Is(gotErr, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", gotErr)
+	}
+	if count != 100 {
+		t.Errorf("Expected 100 issues before cancel, got %d", count)
+	}
+}
+
+func TestAllIssuesPropagatesError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	_, err := client.ListAllIssues(context.Background(), nil)
+	if !errors.Is(err, ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestListAllMemberships(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/projects/demo/memberships.json" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		memberships := []Membership{}
+		for id := offset + 1; id <= 120 && id <= offset+100; id++ {
+			memberships = append(memberships, Membership{ID: id})
+		}
+		_ = json.NewEncoder(w).Encode(MembershipsResponse{Memberships: memberships, TotalCount: 120, Offset: offset, Limit: 100})
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	memberships, err := client.ListAllMemberships(context.Background(), "demo")
+	if err != nil {
+		t.Fatalf("ListAllMemberships failed: %v", err)
+	}
+	if len(memberships) != 120 {
+		t.Errorf("Expected 120 memberships, got %d", len(memberships))
+	}
+}
@@ -0,0 +1,63 @@
+package redmine
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// marshalPatch encodes v, a struct whose fields are tagged omitempty, and then
+// adds the fields named in force with their current value, even when zero, and
+// the fields named in clear as an empty value, which Redmine treats as unset.
+// Names are JSON keys such as "done_ratio".
+func marshalPatch(v any, force, clear []string) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || (len(force) == 0 && len(clear) == 0) {
+		return data, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	values := jsonFields(reflect.ValueOf(v))
+	for _, name := range force {
+		fv, ok := values[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q in ForceSendFields", name)
+		}
+		if fv.Kind() == reflect.Slice && fv.IsNil() {
+			fields[name] = json.RawMessage("[]")
+			continue
+		}
+		raw, err := json.Marshal(fv.Interface())
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = raw
+	}
+	for _, name := range clear {
+		if _, ok := values[name]; !ok {
+			return nil, fmt.Errorf("unknown field %q in ClearFields", name)
+		}
+		fields[name] = json.RawMessage(`""`)
+	}
+
+	return json.Marshal(fields)
+}
+
+// jsonFields maps the JSON key of each encoded field of the struct v to its value.
+func jsonFields(v reflect.Value) map[string]reflect.Value {
+	fields := map[string]reflect.Value{}
+	t := v.Type()
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = v.Field(i)
+	}
+	return fields
+}
@@ -0,0 +1,105 @@
+package redmine
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpdateRequestJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		req  any
+		want string
+	}{
+		{
+			name: "issue without explicit fields",
+			req:  IssueUpdateRequest{Subject: "Triage", DoneRatio: 0},
+			want: `{"subject":"Triage"}`,
+		},
+		{
+			name: "issue zero values",
+			req: IssueUpdateRequest{
+				Notes:           "Reopened",
+				ForceSendFields: []string{"done_ratio", "is_private"},
+			},
+			want: `{"done_ratio":0,"is_private":false,"notes":"Reopened"}`,
+		},
+		{
+			name: "issue cleared fields",
+			req: IssueUpdateRequest{
+				StatusID:    1,
+				ClearFields: []string{"assigned_to_id", "due_date", "parent_issue_id"},
+			},
+			want: `{"assigned_to_id":"","due_date":"","parent_issue_id":"","status_id":1}`,
+		},
+		{
+			name: "project",
+			req: ProjectUpdateRequest{
+				ForceSendFields: []string{"is_public", "tracker_ids"},
+				ClearFields:     []string{"homepage", "parent_id"},
+			},
+			want: `{"homepage":"","is_public":false,"parent_id":"","tracker_ids":[]}`,
+		},
+		{
+			name: "time entry",
+			req: TimeEntryUpdateRequest{
+				Hours:       1.5,
+				ClearFields: []string{"comments"},
+			},
+			want: `{"comments":"","hours":1.5}`,
+		},
+		{
+			name: "version",
+			req: Version{
+				Name:        "v1.0",
+				ClearFields: []string{"due_date", "wiki_page_title"},
+			},
+			want: `{"due_date":"","name":"v1.0","project":{},"wiki_page_title":""}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.req)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUpdateRequestUnknownField(t *testing.T) {
+	_, err := json.Marshal(IssueUpdateRequest{ClearFields: []string{"assignee"}})
+	if err == nil {
+		t.Error("Expected error for unknown field, got nil")
+	}
+}
+
+func TestUpdateIssueClearFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		want := `{"issue":{"assigned_to_id":"","done_ratio":0}}`
+		if string(body) != want {
+			t.Errorf("Expected body %s, got %s", want, body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+
+	err := client.UpdateIssue(context.Background(), 1, IssueUpdateRequest{
+		ForceSendFields: []string{"done_ratio"},
+		ClearFields:     []string{"assigned_to_id"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateIssue failed: %v", err)
+	}
+}
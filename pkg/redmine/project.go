@@ -6,12 +6,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
 )
 
-// Project represents a project returned by GET endpoints
+// Project represents a project returned by GET endpoints.
+// Trackers, IssueCategories, EnabledModules, TimeEntryActivities and
+// IssueCustomFields are only returned when requested with include=.
 type Project struct {
 	ID                     int           `json:"id,omitempty"`
 	Name                   string        `json:"name,omitempty"`
@@ -28,8 +31,13 @@ type Project struct {
 	ActiveNewTicketMessage string        `json:"active_new_ticket_message,omitempty"`
 	EnableNewTicketMessage int           `json:"enable_new_ticket_message,omitempty"`
 	NewTicketMessage       string        `json:"new_ticket_message,omitempty"`
-	CreatedOn              string        `json:"created_on,omitempty"`
-	UpdatedOn              string        `json:"updated_on,omitempty"`
+	CreatedOn              Timestamp     `json:"created_on,omitzero"`
+	UpdatedOn              Timestamp     `json:"updated_on,omitzero"`
+	Trackers               []Resource    `json:"trackers,omitempty"`
+	IssueCategories        []Resource    `json:"issue_categories,omitempty"`
+	EnabledModules         []Resource    `json:"enabled_modules,omitempty"`
+	TimeEntryActivities    []Resource    `json:"time_entry_activities,omitempty"`
+	IssueCustomFields      []Resource    `json:"issue_custom_fields,omitempty"`
 }
 
 // ProjectCreateRequest represents the request body for creating a new project
@@ -63,6 +71,19 @@ type ProjectUpdateRequest struct {
 	EnabledModuleNames  []string          `json:"enabled_module_names,omitempty"`
 	IssueCustomFieldIDs []int             `json:"issue_custom_field_ids,omitempty"`
 	CustomFieldValues   map[string]string `json:"custom_field_values,omitempty"`
+
+	// ForceSendFields lists JSON keys that are sent even when their value is
+	// zero, such as "is_public" to make the project private.
+	ForceSendFields []string `json:"-"`
+	// ClearFields lists JSON keys that are sent as an empty value to unset
+	// them, such as "parent_id" to make the project a top-level one.
+	ClearFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the request, honouring ForceSendFields and ClearFields.
+func (r ProjectUpdateRequest) MarshalJSON() ([]byte, error) {
+	type request ProjectUpdateRequest
+	return marshalPatch(request(r), r.ForceSendFields, r.ClearFields)
 }
 
 type ProjectsResponse struct {
@@ -182,8 +203,7 @@ func (c *Client) CreateProject(ctx context.Context, req ProjectCreateRequest) (*
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create project: %s", string(body))
+		return nil, fmt.Errorf("failed to create project: %w", newAPIError(resp))
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -217,8 +237,7 @@ func (c *Client) UpdateProject(ctx context.Context, idOrIdentifier string, req P
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to update project: %s", string(body))
+		return fmt.Errorf("failed to update project: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -236,8 +255,7 @@ func (c *Client) DeleteProject(ctx context.Context, idOrIdentifier string) error
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete project: %s", string(body))
+		return fmt.Errorf("failed to delete project: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -255,8 +273,7 @@ func (c *Client) ArchiveProject(ctx context.Context, idOrIdentifier string) erro
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to archive project: %s", string(body))
+		return fmt.Errorf("failed to archive project: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -274,9 +291,32 @@ func (c *Client) UnarchiveProject(ctx context.Context, idOrIdentifier string) er
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to unarchive project: %s", string(body))
+		return fmt.Errorf("failed to unarchive project: %w", newAPIError(resp))
 	}
 
 	return nil
 }
+
+// AllProjects returns an iterator over every project matching opts, fetching pages as needed.
+// opts.Limit sets the page size and opts.Offset the starting position.
+func (c *Client) AllProjects(ctx context.Context, opts *ListProjectsOptions) iter.Seq2[Project, error] {
+	var base ListProjectsOptions
+	if opts != nil {
+		base = *opts
+	}
+
+	return paginate(ctx, c, base.Offset, base.Limit, func(ctx context.Context, offset, limit int) page[Project] {
+		o := base
+		o.Offset, o.Limit = offset, limit
+		resp, err := c.ListProjects(ctx, &o)
+		if err != nil {
+			return page[Project]{err: err}
+		}
+		return page[Project]{items: resp.Projects, total: resp.TotalCount, limit: resp.Limit}
+	})
+}
+
+// ListAllProjects retrieves every project matching opts across all pages
+func (c *Client) ListAllProjects(ctx context.Context, opts *ListProjectsOptions) ([]Project, error) {
+	return collect(c.AllProjects(ctx, opts))
+}
@@ -206,3 +206,49 @@ func TestDeleteProject(t *testing.T) {
 		t.Fatalf("DeleteProject failed: %v", err)
 	}
 }
+
+func TestShowProjectWithIncludes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := "trackers,issue_categories,enabled_modules,time_entry_activities,issue_custom_fields"
+		if got := r.URL.Query().Get("include"); got != want {
+			t.Errorf("Expected include=%s, got %s", want, got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"project":{
+			"id":1,"name":"Test Project",
+			"trackers":[{"id":1,"name":"Bug"},{"id":2,"name":"Feature"}],
+			"issue_categories":[{"id":5,"name":"UI"}],
+			"enabled_modules":[{"id":9,"name":"issue_tracking"}],
+			"time_entry_activities":[{"id":8,"name":"Design"}],
+			"issue_custom_fields":[{"id":3,"name":"Severity"}]
+		}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+
+	result, err := client.ShowProject(context.Background(), "1", &ShowProjectOptions{
+		Include: "trackers,issue_categories,enabled_modules,time_entry_activities,issue_custom_fields",
+	})
+	if err != nil {
+		t.Fatalf("ShowProject failed: %v", err)
+	}
+
+	p := result.Project
+	if len(p.Trackers) != 2 || p.Trackers[1].Name != "Feature" {
+		t.Errorf("Unexpected trackers %+v", p.Trackers)
+	}
+	if len(p.IssueCategories) != 1 || p.IssueCategories[0].Name != "UI" {
+		t.Errorf("Unexpected issue categories %+v", p.IssueCategories)
+	}
+	if len(p.EnabledModules) != 1 || p.EnabledModules[0].Name != "issue_tracking" {
+		t.Errorf("Unexpected enabled modules %+v", p.EnabledModules)
+	}
+	if len(p.TimeEntryActivities) != 1 || p.TimeEntryActivities[0].ID != 8 {
+		t.Errorf("Unexpected time entry activities %+v", p.TimeEntryActivities)
+	}
+	if len(p.IssueCustomFields) != 1 || p.IssueCustomFields[0].Name != "Severity" {
+		t.Errorf("Unexpected issue custom fields %+v", p.IssueCustomFields)
+	}
+}
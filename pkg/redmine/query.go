@@ -45,3 +45,29 @@ func (c *Client) ListQueries(ctx context.Context) (*QueriesResponse, error) {
 
 	return &result, nil
 }
+
+// ListIssuesByQuery runs a saved query returned by ListQueries.
+// opts may set paging, sorting and includes; Redmine applies the query's own filters.
+func (c *Client) ListIssuesByQuery(ctx context.Context, query Query, opts *ListIssuesOptions) (*IssuesResponse, error) {
+	o := queryIssuesOptions(query, opts)
+	return c.ListIssues(ctx, &o)
+}
+
+// ListAllIssuesByQuery runs a saved query and retrieves every matching issue across all pages
+func (c *Client) ListAllIssuesByQuery(ctx context.Context, query Query, opts *ListIssuesOptions) ([]Issue, error) {
+	o := queryIssuesOptions(query, opts)
+	return c.ListAllIssues(ctx, &o)
+}
+
+func queryIssuesOptions(query Query, opts *ListIssuesOptions) ListIssuesOptions {
+	o := ListIssuesOptions{}
+	if opts != nil {
+		o = ListIssuesOptions{ProjectID: opts.ProjectID, Include: opts.Include, Limit: opts.Limit, Offset: opts.Offset, Sort: opts.Sort}
+	}
+	o.QueryID = query.ID
+	// Project queries are only found within their project
+	if query.ProjectID > 0 {
+		o.ProjectID = query.ProjectID
+	}
+	return o
+}
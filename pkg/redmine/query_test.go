@@ -45,3 +45,39 @@ func TestListQueries(t *testing.T) {
 		t.Errorf("Expected name 'Query 1', got %s", result.Queries[0].Name)
 	}
 }
+
+func TestListIssuesByQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("query_id") != "7" {
+			t.Errorf("Expected query_id=7, got %s", query.Get("query_id"))
+		}
+		if query.Get("project_id") != "3" {
+			t.Errorf("Expected project_id=3, got %s", query.Get("project_id"))
+		}
+		if query.Get("sort") != "priority:desc" {
+			t.Errorf("Expected sort=priority:desc, got %s", query.Get("sort"))
+		}
+		if query.Has("status_id") {
+			t.Errorf("Expected no status_id, got %s", query.Get("status_id"))
+		}
+
+		response := IssuesResponse{Issues: []Issue{{ID: 1}}, TotalCount: 1}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	result, err := client.ListIssuesByQuery(context.Background(), Query{ID: 7, ProjectID: 3}, &ListIssuesOptions{
+		StatusID: "closed",
+		Sort:     "priority:desc",
+	})
+	if err != nil {
+		t.Fatalf("ListIssuesByQuery failed: %v", err)
+	}
+
+	if len(result.Issues) != 1 {
+		t.Errorf("Expected 1 issue, got %d", len(result.Issues))
+	}
+}
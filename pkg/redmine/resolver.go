@@ -0,0 +1,491 @@
+package redmine
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrAmbiguous is matched by a *ResolveError when a name matches several
+// objects. A *ResolveError for a name that matches nothing matches ErrNotFound.
+var ErrAmbiguous = errors.New("redmine: ambiguous name")
+
+// Kinds of objects resolved by a Resolver, as reported by ResolveError.
+const (
+	ResolveProject  = "project"
+	ResolveTracker  = "tracker"
+	ResolveStatus   = "status"
+	ResolvePriority = "priority"
+	ResolveUser     = "user"
+	ResolveVersion  = "version"
+)
+
+// ResolveError is returned by a Resolver when a name matches no object, or
+// several.
+type ResolveError struct {
+	Kind string
+	Name string
+	// Matches lists the objects matching Name when it is ambiguous.
+	Matches []Resource
+	// Suggestions lists the closest names when nothing matches.
+	Suggestions []string
+}
+
+func (e *ResolveError) Error() string {
+	if len(e.Matches) > 0 {
+		matches := make([]string, 0, len(e.Matches))
+		for _, m := range e.Matches {
+			matches = append(matches, fmt.Sprintf("%s (%d)", m.Name, m.ID))
+		}
+		return fmt.Sprintf("%s %q is ambiguous: %s", e.Kind, e.Name, strings.Join(matches, ", "))
+	}
+	msg := fmt.Sprintf("%s %q not found", e.Kind, e.Name)
+	if len(e.Suggestions) > 0 {
+		msg += fmt.Sprintf(", did you mean %s?", strings.Join(e.Suggestions, ", "))
+	}
+	return msg
+}
+
+// Is reports whether target is ErrAmbiguous or ErrNotFound, depending on e.
+func (e *ResolveError) Is(target error) bool {
+	if len(e.Matches) > 0 {
+		return target == ErrAmbiguous
+	}
+	return target == ErrNotFound
+}
+
+// Resolver turns the names people use into the IDs the API expects, and back:
+// tracker, status and priority names, project identifiers or names, user
+// logins, names or "me", and version names. A numeric string, such as a
+// version named "2025", is looked up as a name first and taken as an ID if no
+// object has that name or names cannot be listed; "#12" is always the ID 12.
+//
+// Names are compared exactly, then case-insensitively. A name matching several
+// objects, such as two users with the same name, returns a *ResolveError
+// listing them. Lookups are cached for the life of the Resolver; combine it
+// with WithCache to share them between processes. A Resolver is safe for
+// concurrent use.
+type Resolver struct {
+	client ResolverClient
+
+	mu    sync.Mutex
+	lists map[string][]resolverEntry
+	ids   map[string]int
+	names map[string]string
+}
+
+// resolverEntry is an object with the names it can be referred to by. The
+// first name is the one shown.
+type resolverEntry struct {
+	id    int
+	names []string
+}
+
+// ResolverClient is the part of the API used by a Resolver. Client satisfies it.
+type ResolverClient interface {
+	ProjectsService
+	TrackersService
+	IssueStatusesService
+	EnumerationsService
+	UsersService
+	VersionsService
+}
+
+// NewResolver returns a Resolver that looks up names with c.
+func NewResolver(c ResolverClient) *Resolver {
+	return &Resolver{
+		client: c,
+		lists:  map[string][]resolverEntry{},
+		ids:    map[string]int{},
+		names:  map[string]string{},
+	}
+}
+
+// ProjectID resolves a project ID, identifier or name.
+func (r *Resolver) ProjectID(ctx context.Context, name string) (int, error) {
+	return r.resolve(ctx, ResolveProject, "", name, func(ctx context.Context) ([]resolverEntry, error) {
+		// Identifiers are unique, and can be looked up without listing all projects
+		result, err := r.client.ShowProject(ctx, url.PathEscape(name), nil)
+		if err == nil {
+			return []resolverEntry{{id: result.Project.ID, names: []string{result.Project.Name, result.Project.Identifier}}}, nil
+		}
+		if !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+		return r.list(ctx, ResolveProject, func(ctx context.Context) ([]resolverEntry, error) {
+			projects, err := r.client.ListAllProjects(ctx, nil)
+			if err != nil {
+				return nil, err
+			}
+			entries := make([]resolverEntry, 0, len(projects))
+			for _, p := range projects {
+				entries = append(entries, resolverEntry{id: p.ID, names: []string{p.Name, p.Identifier}})
+			}
+			return entries, nil
+		})
+	})
+}
+
+// TrackerID resolves a tracker ID or name.
+func (r *Resolver) TrackerID(ctx context.Context, name string) (int, error) {
+	return r.resolve(ctx, ResolveTracker, "", name, r.trackers)
+}
+
+// StatusID resolves an issue status ID or name.
+func (r *Resolver) StatusID(ctx context.Context, name string) (int, error) {
+	return r.resolve(ctx, ResolveStatus, "", name, r.statuses)
+}
+
+// PriorityID resolves an issue priority ID or name.
+func (r *Resolver) PriorityID(ctx context.Context, name string) (int, error) {
+	return r.resolve(ctx, ResolvePriority, "", name, r.priorities)
+}
+
+// UserID resolves a user ID, login, full name ("John Smith"), email address
+// or "me", the authenticated user. Looking up users by name requires admin
+// privileges.
+func (r *Resolver) UserID(ctx context.Context, name string) (int, error) {
+	if strings.EqualFold(strings.TrimSpace(name), "me") {
+		return r.resolve(ctx, ResolveUser, "", "me", func(ctx context.Context) ([]resolverEntry, error) {
+			result, err := r.client.GetCurrentUser(ctx, nil)
+			if err != nil {
+				return nil, err
+			}
+			return []resolverEntry{{id: result.User.ID, names: []string{"me"}}}, nil
+		})
+	}
+	return r.resolve(ctx, ResolveUser, "", name, func(ctx context.Context) ([]resolverEntry, error) {
+		users, err := r.client.ListAllUsers(ctx, &ListUsersOptions{Name: strings.TrimSpace(name)})
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]resolverEntry, 0, len(users))
+		for _, u := range users {
+			entries = append(entries, userEntry(u))
+		}
+		return entries, nil
+	})
+}
+
+// VersionID resolves the ID or name of a version of a project, including the
+// versions shared with it.
+func (r *Resolver) VersionID(ctx context.Context, projectIDOrIdentifier string, name string) (int, error) {
+	return r.resolve(ctx, ResolveVersion, projectIDOrIdentifier, name, func(ctx context.Context) ([]resolverEntry, error) {
+		return r.list(ctx, ResolveVersion+":"+projectIDOrIdentifier, func(ctx context.Context) ([]resolverEntry, error) {
+			result, err := r.client.ListVersions(ctx, projectIDOrIdentifier)
+			if err != nil {
+				return nil, err
+			}
+			entries := make([]resolverEntry, 0, len(result.Versions))
+			for _, v := range result.Versions {
+				entries = append(entries, resolverEntry{id: v.ID, names: []string{v.Name}})
+			}
+			return entries, nil
+		})
+	})
+}
+
+// ProjectName returns the name of a project.
+func (r *Resolver) ProjectName(ctx context.Context, id int) (string, error) {
+	return r.name(ctx, ResolveProject, id, func(ctx context.Context) (string, error) {
+		result, err := r.client.ShowProject(ctx, strconv.Itoa(id), nil)
+		if err != nil {
+			return "", err
+		}
+		return result.Project.Name, nil
+	})
+}
+
+// TrackerName returns the name of a tracker.
+func (r *Resolver) TrackerName(ctx context.Context, id int) (string, error) {
+	return r.listedName(ctx, ResolveTracker, id, r.trackers)
+}
+
+// StatusName returns the name of an issue status.
+func (r *Resolver) StatusName(ctx context.Context, id int) (string, error) {
+	return r.listedName(ctx, ResolveStatus, id, r.statuses)
+}
+
+// PriorityName returns the name of an issue priority.
+func (r *Resolver) PriorityName(ctx context.Context, id int) (string, error) {
+	return r.listedName(ctx, ResolvePriority, id, r.priorities)
+}
+
+// UserName returns the full name of a user.
+func (r *Resolver) UserName(ctx context.Context, id int) (string, error) {
+	return r.name(ctx, ResolveUser, id, func(ctx context.Context) (string, error) {
+		result, err := r.client.ShowUser(ctx, id, nil)
+		if err != nil {
+			return "", err
+		}
+		return userEntry(result.User).names[0], nil
+	})
+}
+
+// VersionName returns the name of a version.
+func (r *Resolver) VersionName(ctx context.Context, id int) (string, error) {
+	return r.name(ctx, ResolveVersion, id, func(ctx context.Context) (string, error) {
+		result, err := r.client.ShowVersion(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return result.Version.Name, nil
+	})
+}
+
+func (r *Resolver) trackers(ctx context.Context) ([]resolverEntry, error) {
+	return r.list(ctx, ResolveTracker, func(ctx context.Context) ([]resolverEntry, error) {
+		result, err := r.client.ListTrackers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]resolverEntry, 0, len(result.Trackers))
+		for _, t := range result.Trackers {
+			entries = append(entries, resolverEntry{id: t.ID, names: []string{t.Name}})
+		}
+		return entries, nil
+	})
+}
+
+func (r *Resolver) statuses(ctx context.Context) ([]resolverEntry, error) {
+	return r.list(ctx, ResolveStatus, func(ctx context.Context) ([]resolverEntry, error) {
+		result, err := r.client.ListIssueStatuses(ctx)
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]resolverEntry, 0, len(result.IssueStatuses))
+		for _, s := range result.IssueStatuses {
+			entries = append(entries, resolverEntry{id: s.ID, names: []string{s.Name}})
+		}
+		return entries, nil
+	})
+}
+
+func (r *Resolver) priorities(ctx context.Context) ([]resolverEntry, error) {
+	return r.list(ctx, ResolvePriority, func(ctx context.Context) ([]resolverEntry, error) {
+		result, err := r.client.ListIssuePriorities(ctx)
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]resolverEntry, 0, len(result.Enumerations))
+		for _, p := range result.Enumerations {
+			entries = append(entries, resolverEntry{id: p.ID, names: []string{p.Name}})
+		}
+		return entries, nil
+	})
+}
+
+func userEntry(u User) resolverEntry {
+	names := []string{strings.TrimSpace(u.Firstname + " " + u.Lastname), u.Login}
+	if u.Mail != "" {
+		names = append(names, u.Mail)
+	}
+	if names[0] == "" {
+		names[0] = u.Login
+	}
+	return resolverEntry{id: u.ID, names: names}
+}
+
+// resolve returns the ID of the object of kind named name among those
+// returned by candidates. Names are unique within scope, such as the project
+// of a version, or within kind when scope is empty.
+func (r *Resolver) resolve(ctx context.Context, kind, scope, name string, candidates func(context.Context) ([]resolverEntry, error)) (int, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return 0, fmt.Errorf("empty %s name", kind)
+	}
+	if ref, ok := strings.CutPrefix(name, "#"); ok {
+		if id, err := strconv.Atoi(ref); err == nil && id > 0 {
+			return id, nil
+		}
+	}
+
+	key := kind + ":" + name
+	if scope != "" {
+		key = kind + ":" + scope + ":" + name
+	}
+	r.mu.Lock()
+	id, ok := r.ids[key]
+	r.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	// Names can be numeric, so an ID is only taken once no name matches
+	numericID, numericErr := strconv.Atoi(name)
+	numeric := numericErr == nil && numericID > 0
+
+	entries, err := candidates(ctx)
+	if err != nil && !numeric {
+		return 0, fmt.Errorf("failed to resolve %s %q: %w", kind, name, err)
+	}
+	matches := match(entries, name)
+	if numeric && len(matches) == 0 {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.ids[key] = numericID
+		return numericID, nil
+	}
+	switch len(matches) {
+	case 0:
+		return 0, &ResolveError{Kind: kind, Name: name, Suggestions: suggest(entries, name)}
+	case 1:
+	default:
+		resources := make([]Resource, 0, len(matches))
+		for _, e := range matches {
+			resources = append(resources, Resource{ID: e.id, Name: e.names[0]})
+		}
+		return 0, &ResolveError{Kind: kind, Name: name, Matches: resources}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ids[key] = matches[0].id
+	if name != "me" {
+		r.names[kind+":"+strconv.Itoa(matches[0].id)] = matches[0].names[0]
+	}
+	return matches[0].id, nil
+}
+
+// list returns the entries cached under key, loading them the first time.
+func (r *Resolver) list(ctx context.Context, key string, load func(context.Context) ([]resolverEntry, error)) ([]resolverEntry, error) {
+	r.mu.Lock()
+	entries, ok := r.lists[key]
+	r.mu.Unlock()
+	if ok {
+		return entries, nil
+	}
+
+	entries, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, _, _ := strings.Cut(key, ":")
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lists[key] = entries
+	for _, e := range entries {
+		r.names[kind+":"+strconv.Itoa(e.id)] = e.names[0]
+	}
+	return entries, nil
+}
+
+// name returns the name of the object of kind with id, fetching it with
+// fetch if it is not known yet.
+func (r *Resolver) name(ctx context.Context, kind string, id int, fetch func(context.Context) (string, error)) (string, error) {
+	key := kind + ":" + strconv.Itoa(id)
+	r.mu.Lock()
+	name, ok := r.names[key]
+	r.mu.Unlock()
+	if ok {
+		return name, nil
+	}
+
+	name, err := fetch(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up %s %d: %w", kind, id, err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.names[key] = name
+	return name, nil
+}
+
+// listedName returns the name of an object of a kind that is listed in full.
+func (r *Resolver) listedName(ctx context.Context, kind string, id int, list func(context.Context) ([]resolverEntry, error)) (string, error) {
+	return r.name(ctx, kind, id, func(ctx context.Context) (string, error) {
+		entries, err := list(ctx)
+		if err != nil {
+			return "", err
+		}
+		if i := slices.IndexFunc(entries, func(e resolverEntry) bool { return e.id == id }); i >= 0 {
+			return entries[i].names[0], nil
+		}
+		return "", &ResolveError{Kind: kind, Name: strconv.Itoa(id)}
+	})
+}
+
+// match returns the entries with a name equal to name, or equal ignoring case
+// if there are none.
+func match(entries []resolverEntry, name string) []resolverEntry {
+	for _, equal := range []func(a, b string) bool{
+		func(a, b string) bool { return a == b },
+		strings.EqualFold,
+	} {
+		var matches []resolverEntry
+		for _, e := range entries {
+			if slices.ContainsFunc(e.names, func(n string) bool { return equal(n, name) }) &&
+				!slices.ContainsFunc(matches, func(m resolverEntry) bool { return m.id == e.id }) {
+				matches = append(matches, e)
+			}
+		}
+		if len(matches) > 0 {
+			return matches
+		}
+	}
+	return nil
+}
+
+// suggest returns up to 3 names close to name: names containing it, or a
+// few typos away.
+func suggest(entries []resolverEntry, name string) []string {
+	type suggestion struct {
+		name     string
+		distance int
+	}
+	var suggestions []suggestion
+	lower := strings.ToLower(name)
+	for _, e := range entries {
+		for _, n := range e.names {
+			ln := strings.ToLower(n)
+			distance := levenshtein(ln, lower)
+			if strings.Contains(ln, lower) || strings.Contains(lower, ln) {
+				distance = min(distance, 1)
+			}
+			if distance <= max(2, len([]rune(lower))/3) {
+				suggestions = append(suggestions, suggestion{name: n, distance: distance})
+				break
+			}
+		}
+	}
+	slices.SortStableFunc(suggestions, func(a, b suggestion) int { return cmp.Compare(a.distance, b.distance) })
+
+	names := make([]string, 0, 3)
+	for _, s := range suggestions {
+		if len(names) == cap(names) {
+			break
+		}
+		if !slices.Contains(names, s.name) {
+			names = append(names, s.name)
+		}
+	}
+	return names
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	row := make([]int, len(rb)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			prev, row[j] = row[j], min(row[j]+1, row[j-1]+1, prev+cost)
+		}
+	}
+	return row[len(rb)]
+}
@@ -0,0 +1,219 @@
+package redmine
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func newResolverServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	handle := func(pattern, body string) {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			//nolint:errcheck
+			w.Write([]byte(body))
+		})
+	}
+	handle("GET /trackers.json", `{"trackers":[{"id":1,"name":"Bug"},{"id":2,"name":"Feature"},{"id":3,"name":"Support"}]}`)
+	handle("GET /issue_statuses.json", `{"issue_statuses":[{"id":1,"name":"New"},{"id":2,"name":"In Progress"},{"id":5,"name":"Closed","is_closed":true}]}`)
+	handle("GET /enumerations/issue_priorities.json", `{"issue_priorities":[{"id":1,"name":"Low"},{"id":2,"name":"Normal"},{"id":3,"name":"High"}]}`)
+	handle("GET /projects/web-app.json", `{"project":{"id":7,"name":"Web Application","identifier":"web-app"}}`)
+	handle("GET /projects/7.json", `{"project":{"id":7,"name":"Web Application","identifier":"web-app"}}`)
+	handle("GET /projects.json", `{"projects":[{"id":7,"name":"Web Application","identifier":"web-app"},{"id":8,"name":"API","identifier":"api"}],"total_count":2}`)
+	handle("GET /projects/web-app/versions.json", `{"versions":[{"id":11,"name":"1.0"},{"id":12,"name":"2.0"},{"id":13,"name":"2025"}],"total_count":3}`)
+	handle("GET /projects/api/versions.json", `{"versions":[{"id":21,"name":"1.0"}],"total_count":1}`)
+	handle("GET /users/current.json", `{"user":{"id":42,"login":"admin"}}`)
+	handle("GET /users/5.json", `{"user":{"id":5,"login":"tanaka","firstname":"Taro","lastname":"Tanaka"}}`)
+	mux.HandleFunc("GET /users.json", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		users := `{"users":[],"total_count":0}`
+		switch r.URL.Query().Get("name") {
+		case "tanaka":
+			users = `{"users":[{"id":5,"login":"tanaka","firstname":"Taro","lastname":"Tanaka"},{"id":6,"login":"tanaka2","firstname":"Hanako","lastname":"Tanaka"}],"total_count":2}`
+		case "John Smith":
+			users = `{"users":[{"id":8,"login":"jsmith","firstname":"John","lastname":"Smith"},{"id":9,"login":"jsmith2","firstname":"John","lastname":"Smith"}],"total_count":2}`
+		}
+		//nolint:errcheck
+		w.Write([]byte(users))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestResolver(t *testing.T) {
+	var requests atomic.Int32
+	server := newResolverServer(t, &requests)
+	r := NewResolver(New(server.URL, "test-api-key"))
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		resolve func() (int, error)
+		want    int
+	}{
+		{"tracker", func() (int, error) { return r.TrackerID(ctx, "Bug") }, 1},
+		{"tracker ignoring case", func() (int, error) { return r.TrackerID(ctx, "feature") }, 2},
+		{"status", func() (int, error) { return r.StatusID(ctx, "In Progress") }, 2},
+		{"priority", func() (int, error) { return r.PriorityID(ctx, " High ") }, 3},
+		{"numeric", func() (int, error) { return r.StatusID(ctx, "9") }, 9},
+		{"project identifier", func() (int, error) { return r.ProjectID(ctx, "web-app") }, 7},
+		{"project name", func() (int, error) { return r.ProjectID(ctx, "API") }, 8},
+		{"user login", func() (int, error) { return r.UserID(ctx, "tanaka") }, 5},
+		{"me", func() (int, error) { return r.UserID(ctx, "me") }, 42},
+		{"version", func() (int, error) { return r.VersionID(ctx, "web-app", "2.0") }, 12},
+		{"numeric version name", func() (int, error) { return r.VersionID(ctx, "web-app", "2025") }, 13},
+		{"version ID", func() (int, error) { return r.VersionID(ctx, "web-app", "12") }, 12},
+		{"explicit ID", func() (int, error) { return r.VersionID(ctx, "web-app", "#2025") }, 2025},
+		{"unlisted user ID", func() (int, error) { return r.UserID(ctx, "5") }, 5},
+		{"ID without versions", func() (int, error) { return r.VersionID(ctx, "missing", "3") }, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.resolve()
+			if err != nil {
+				t.Fatalf("Resolve failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected ID %d, got %d", tt.want, got)
+			}
+		})
+	}
+
+	// Lookups are cached
+	before := requests.Load()
+	for _, name := range []string{"Bug", "Support", "feature"} {
+		if _, err := r.TrackerID(ctx, name); err != nil {
+			t.Fatalf("TrackerID failed: %v", err)
+		}
+	}
+	if _, err := r.UserID(ctx, "ME"); err != nil {
+		t.Fatalf("UserID failed: %v", err)
+	}
+	if requests.Load() != before {
+		t.Errorf("Expected cached lookups, got %d requests", requests.Load()-before)
+	}
+}
+
+func TestResolverErrors(t *testing.T) {
+	var requests atomic.Int32
+	server := newResolverServer(t, &requests)
+	r := NewResolver(New(server.URL, "test-api-key"))
+	ctx := context.Background()
+
+	_, err := r.TrackerID(ctx, "Bgu")
+	var resolveErr *ResolveError
+	if !errors.As(err, &resolveErr) || !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected not found ResolveError, got %v", err)
+	}
+	if len(resolveErr.Suggestions) != 1 || resolveErr.Suggestions[0] != "Bug" {
+		t.Errorf("Expected suggestion Bug, got %v", resolveErr.Suggestions)
+	}
+	if !strings.Contains(err.Error(), `tracker "Bgu" not found, did you mean Bug?`) {
+		t.Errorf("Unexpected message: %v", err)
+	}
+
+	_, err = r.StatusID(ctx, "Progress")
+	if !errors.As(err, &resolveErr) || len(resolveErr.Suggestions) != 1 || resolveErr.Suggestions[0] != "In Progress" {
+		t.Errorf("Expected suggestion In Progress, got %v", err)
+	}
+
+	_, err = r.UserID(ctx, "John Smith")
+	if !errors.Is(err, ErrAmbiguous) || !errors.As(err, &resolveErr) {
+		t.Fatalf("Expected ambiguous ResolveError, got %v", err)
+	}
+	if len(resolveErr.Matches) != 2 || resolveErr.Matches[0] != (Resource{ID: 8, Name: "John Smith"}) {
+		t.Errorf("Unexpected matches: %+v", resolveErr.Matches)
+	}
+
+	if _, err := r.ProjectID(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	if _, err := r.VersionID(ctx, "missing", "1.0"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound from the API, got %v", err)
+	}
+	if _, err := r.PriorityID(ctx, ""); err == nil {
+		t.Error("Expected an error for an empty name")
+	}
+}
+
+func TestResolverNames(t *testing.T) {
+	var requests atomic.Int32
+	server := newResolverServer(t, &requests)
+	r := NewResolver(New(server.URL, "test-api-key"))
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		lookup func() (string, error)
+		want   string
+	}{
+		{"tracker", func() (string, error) { return r.TrackerName(ctx, 3) }, "Support"},
+		{"status", func() (string, error) { return r.StatusName(ctx, 5) }, "Closed"},
+		{"priority", func() (string, error) { return r.PriorityName(ctx, 1) }, "Low"},
+		{"project", func() (string, error) { return r.ProjectName(ctx, 7) }, "Web Application"},
+		{"user", func() (string, error) { return r.UserName(ctx, 5) }, "Taro Tanaka"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.lookup()
+			if err != nil {
+				t.Fatalf("Lookup failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	if _, err := r.TrackerName(ctx, 99); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+	// Resolved versions are known by name
+	if _, err := r.VersionID(ctx, "web-app", "1.0"); err != nil {
+		t.Fatalf("VersionID failed: %v", err)
+	}
+	before := requests.Load()
+	if name, err := r.VersionName(ctx, 12); err != nil || name != "2.0" {
+		t.Errorf("Expected 2.0, got %q, %v", name, err)
+	}
+	if requests.Load() != before {
+		t.Errorf("Expected the version name to be cached")
+	}
+}
+
+func TestResolverVersionsOfProjects(t *testing.T) {
+	var requests atomic.Int32
+	server := newResolverServer(t, &requests)
+	r := NewResolver(New(server.URL, "test-api-key"))
+	ctx := context.Background()
+
+	// The same version name resolves within each project
+	for _, tt := range []struct {
+		project string
+		want    int
+	}{{"web-app", 11}, {"api", 21}, {"web-app", 11}} {
+		id, err := r.VersionID(ctx, tt.project, "1.0")
+		if err != nil {
+			t.Fatalf("VersionID failed: %v", err)
+		}
+		if id != tt.want {
+			t.Errorf("Expected version %d in %s, got %d", tt.want, tt.project, id)
+		}
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("Expected 2 requests, got %d", got)
+	}
+}
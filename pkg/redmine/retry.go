@@ -0,0 +1,165 @@
+package redmine
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the client retries failed requests.
+// Only idempotent methods (GET, PUT, DELETE) are retried unless RetryPOST is set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// MinBackoff is the base delay used for exponential backoff.
+	MinBackoff time.Duration
+	// MaxBackoff caps the computed backoff. Retry-After values are not capped.
+	MaxBackoff time.Duration
+	// RetryPOST enables retrying POST requests, which are not idempotent.
+	RetryPOST bool
+	// RetryableStatuses lists the HTTP status codes that trigger a retry.
+	// If empty, 429, 502, 503 and 504 are retried.
+	RetryableStatuses []int
+}
+
+// DefaultRetryPolicy returns a policy with 3 attempts and 500ms to 10s backoff.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  10 * time.Second,
+	}
+}
+
+var defaultRetryableStatuses = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// maxAttempts returns how many times req may be sent under the policy.
+func (p *RetryPolicy) maxAttempts(req *http.Request) int {
+	if p == nil || p.MaxAttempts <= 1 {
+		return 1
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body cannot be replayed
+		return 1
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return p.MaxAttempts
+	case http.MethodPost:
+		if p.RetryPOST {
+			return p.MaxAttempts
+		}
+	}
+	return 1
+}
+
+// shouldRetry reports whether the outcome of an attempt is worth retrying.
+func (p *RetryPolicy) shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	statuses := p.RetryableStatuses
+	if len(statuses) == 0 {
+		statuses = defaultRetryableStatuses
+	}
+	for _, status := range statuses {
+		if resp.StatusCode == status {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the next attempt. attempt starts at 1.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return delay
+		}
+	}
+
+	delay := p.MinBackoff << (attempt - 1)
+	if p.MaxBackoff > 0 && (delay > p.MaxBackoff || delay <= 0) {
+		delay = p.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	// Equal jitter: keep half of the delay and randomize the rest
+	half := delay / 2
+	//nolint:gosec // Jitter does not need a cryptographically secure source
+	return half + rand.N(delay-half+1)
+}
+
+// parseRetryAfter parses a Retry-After header in seconds or HTTP-date form.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		delay := time.Until(t)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// send performs req, retrying according to the client's retry policy.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	attempts := c.Retry.maxAttempts(req)
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.HTTPClient.Do(req)
+		if attempt >= attempts || ctx.Err() != nil || !c.Retry.shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		delay := c.Retry.backoff(attempt, resp)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			// Waiting would outlive the caller's deadline
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			//nolint:errcheck
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+	}
+}
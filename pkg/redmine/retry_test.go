@@ -0,0 +1,183 @@
+package redmine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newFlakyServer(t *testing.T, failures int, status int, handler http.HandlerFunc) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if int(calls.Add(1)) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func testRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+	}
+}
+
+func TestRetrySucceedsAfterFailures(t *testing.T) {
+	server, calls := newFlakyServer(t, 2, http.StatusServiceUnavailable, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(IssuesResponse{Issues: []Issue{{ID: 1}}})
+	})
+
+	client := New(server.URL, "test-api-key")
+	client.Retry = testRetryPolicy()
+
+	result, err := client.ListIssues(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+	if len(result.Issues) != 1 {
+		t.Errorf("Expected 1 issue, got %d", len(result.Issues))
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls.Load())
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	server, calls := newFlakyServer(t, 10, http.StatusBadGateway, func(w http.ResponseWriter, r *http.Request) {})
+
+	client := New(server.URL, "test-api-key")
+	client.Retry = testRetryPolicy()
+
+	_, err := client.ListIssues(context.Background(), nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("Expected 502 APIError, got %v", err)
+	}
+	if calls.Load() != 4 {
+		t.Errorf("Expected 4 calls, got %d", calls.Load())
+	}
+}
+
+func TestRetryDisabledByDefault(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, http.StatusServiceUnavailable, func(w http.ResponseWriter, r *http.Request) {})
+
+	client := New(server.URL, "test-api-key")
+
+	if _, err := client.ListIssues(context.Background(), nil); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestRetrySkipsPOSTUnlessEnabled(t *testing.T) {
+	var bodies []string
+	server, calls := newFlakyServer(t, 1, http.StatusServiceUnavailable, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(IssueResponse{Issue: Issue{ID: 10}})
+	})
+
+	client := New(server.URL, "test-api-key")
+	client.Retry = testRetryPolicy()
+
+	req := IssueCreateRequest{ProjectID: 1, TrackerID: 1, Subject: "Retry"}
+	if _, err := client.CreateIssue(context.Background(), req); err == nil {
+		t.Fatal("Expected error without RetryPOST, got nil")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected 1 call, got %d", calls.Load())
+	}
+
+	calls.Store(0)
+	client.Retry.RetryPOST = true
+	result, err := client.CreateIssue(context.Background(), req)
+	if err != nil {
+		t.Fatalf("CreateIssue with RetryPOST failed: %v", err)
+	}
+	if result.Issue.ID != 10 {
+		t.Errorf("Expected issue ID 10, got %d", result.Issue.ID)
+	}
+	if len(bodies) != 1 || bodies[0] == "" {
+		t.Errorf("Expected request body to be replayed, got %q", bodies)
+	}
+}
+
+func TestRetryHonoursRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	var firstCall time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			firstCall = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		if elapsed := time.Since(firstCall); elapsed < time.Second {
+			t.Errorf("Expected retry after at least 1s, got %v", elapsed)
+		}
+		_ = json.NewEncoder(w).Encode(IssuesResponse{})
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	client.Retry = testRetryPolicy()
+
+	if _, err := client.ListIssues(context.Background(), nil); err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls.Load())
+	}
+}
+
+func TestRetryRespectsContextDeadline(t *testing.T) {
+	server, calls := newFlakyServer(t, 10, http.StatusServiceUnavailable, func(w http.ResponseWriter, r *http.Request) {})
+
+	client := New(server.URL, "test-api-key")
+	client.Retry = &RetryPolicy{MaxAttempts: 5, MinBackoff: time.Second, MaxBackoff: time.Second}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.ListIssues(ctx, nil)
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected to give up before the deadline, took %v", elapsed)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if d, ok := parseRetryAfter("3"); !ok || d != 3*time.Second {
+		t.Errorf("Expected 3s, got %v (ok=%v)", d, ok)
+	}
+	if _, ok := parseRetryAfter("invalid"); ok {
+		t.Error("Expected invalid value to be rejected")
+	}
+	date := time.Now().Add(2 * time.Second).UTC().Format(http.TimeFormat)
+	if d, ok := parseRetryAfter(date); !ok || d <= 0 || d > 2*time.Second {
+		t.Errorf("Expected up to 2s, got %v (ok=%v)", d, ok)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -12,12 +13,12 @@ import (
 )
 
 type SearchResult struct {
-	ID          int    `json:"id,omitempty"`
-	Title       string `json:"title,omitempty"`
-	Type        string `json:"type,omitempty"`
-	URL         string `json:"url,omitempty"`
-	Description string `json:"description,omitempty"`
-	Datetime    string `json:"datetime,omitempty"`
+	ID          int       `json:"id,omitempty"`
+	Title       string    `json:"title,omitempty"`
+	Type        string    `json:"type,omitempty"`
+	URL         string    `json:"url,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Datetime    Timestamp `json:"datetime,omitzero"`
 }
 
 type SearchResponse struct {
@@ -129,3 +130,27 @@ func (c *Client) SearchSimple(ctx context.Context, query string) (*SearchRespons
 		Query: strings.Split(query, " "),
 	})
 }
+
+// AllSearchResults returns an iterator over every search result matching opts, fetching pages as needed.
+// opts.Limit sets the page size and opts.Offset the starting position.
+func (c *Client) AllSearchResults(ctx context.Context, opts *SearchOptions) iter.Seq2[SearchResult, error] {
+	var base SearchOptions
+	if opts != nil {
+		base = *opts
+	}
+
+	return paginate(ctx, c, base.Offset, base.Limit, func(ctx context.Context, offset, limit int) page[SearchResult] {
+		o := base
+		o.Offset, o.Limit = offset, limit
+		resp, err := c.Search(ctx, &o)
+		if err != nil {
+			return page[SearchResult]{err: err}
+		}
+		return page[SearchResult]{items: resp.Results, total: resp.TotalCount, limit: resp.Limit}
+	})
+}
+
+// ListAllSearchResults retrieves every search result matching opts across all pages
+func (c *Client) ListAllSearchResults(ctx context.Context, opts *SearchOptions) ([]SearchResult, error) {
+	return collect(c.AllSearchResults(ctx, opts))
+}
@@ -0,0 +1,251 @@
+package redmine
+
+import (
+	"context"
+	"io"
+	"iter"
+	"net/url"
+)
+
+//go:generate go tool mockgen -source=service.go -destination=../redminemock/service.go -package=redminemock
+
+// The interfaces below group the methods of Client by resource, so code can
+// depend on the part of the API it uses and be tested with a fake or with the
+// mocks of package redminemock. Client satisfies all of them, and API.
+
+// IssuesService reads and writes issues.
+type IssuesService interface {
+	ListIssues(ctx context.Context, opts *ListIssuesOptions) (*IssuesResponse, error)
+	AllIssues(ctx context.Context, opts *ListIssuesOptions) iter.Seq2[Issue, error]
+	ListAllIssues(ctx context.Context, opts *ListIssuesOptions) ([]Issue, error)
+	ShowIssue(ctx context.Context, id int, opts *ShowIssueOptions) (*IssueResponse, error)
+	CreateIssue(ctx context.Context, req IssueCreateRequest) (*IssueResponse, error)
+	UpdateIssue(ctx context.Context, id int, req IssueUpdateRequest) error
+	UpdateIssueIfUnmodified(ctx context.Context, id int, lastUpdatedOn Timestamp, req IssueUpdateRequest) error
+	DeleteIssue(ctx context.Context, id int) error
+	GetIssueHistory(ctx context.Context, id int) (*IssueHistory, error)
+}
+
+// WatchersService adds and removes the watchers of issues.
+type WatchersService interface {
+	AddWatcher(ctx context.Context, issueID int, userID int) error
+	RemoveWatcher(ctx context.Context, issueID int, userID int) error
+}
+
+// IssueRelationsService reads and writes issue relations.
+type IssueRelationsService interface {
+	ListIssueRelations(ctx context.Context, issueID int) (*IssueRelationsResponse, error)
+	ShowIssueRelation(ctx context.Context, relationID int) (*IssueRelationResponse, error)
+	CreateIssueRelation(ctx context.Context, issueID int, relation IssueRelation) (*IssueRelationResponse, error)
+	DeleteIssueRelation(ctx context.Context, relationID int) error
+}
+
+// IssueCategoriesService reads and writes issue categories.
+type IssueCategoriesService interface {
+	ListIssueCategories(ctx context.Context, projectIDOrIdentifier string) (*IssueCategoriesResponse, error)
+	ShowIssueCategory(ctx context.Context, id int) (*IssueCategoryResponse, error)
+	CreateIssueCategory(ctx context.Context, projectIDOrIdentifier string, req IssueCategoryCreateRequest) (*IssueCategoryResponse, error)
+	UpdateIssueCategory(ctx context.Context, id int, req IssueCategoryUpdateRequest) error
+	DeleteIssueCategory(ctx context.Context, id int, opts *DeleteIssueCategoryOptions) error
+}
+
+// ProjectsService reads and writes projects.
+type ProjectsService interface {
+	ListProjects(ctx context.Context, opts *ListProjectsOptions) (*ProjectsResponse, error)
+	AllProjects(ctx context.Context, opts *ListProjectsOptions) iter.Seq2[Project, error]
+	ListAllProjects(ctx context.Context, opts *ListProjectsOptions) ([]Project, error)
+	ShowProject(ctx context.Context, idOrIdentifier string, opts *ShowProjectOptions) (*ProjectResponse, error)
+	CreateProject(ctx context.Context, req ProjectCreateRequest) (*ProjectResponse, error)
+	UpdateProject(ctx context.Context, idOrIdentifier string, req ProjectUpdateRequest) error
+	DeleteProject(ctx context.Context, idOrIdentifier string) error
+	ArchiveProject(ctx context.Context, idOrIdentifier string) error
+	UnarchiveProject(ctx context.Context, idOrIdentifier string) error
+}
+
+// VersionsService reads and writes project versions.
+type VersionsService interface {
+	ListVersions(ctx context.Context, projectIDOrIdentifier string) (*VersionsResponse, error)
+	ShowVersion(ctx context.Context, id int) (*VersionResponse, error)
+	CreateVersion(ctx context.Context, projectIDOrIdentifier string, version Version) (*VersionResponse, error)
+	UpdateVersion(ctx context.Context, id int, version Version) error
+	DeleteVersion(ctx context.Context, id int) error
+}
+
+// MembershipsService reads and writes project memberships.
+type MembershipsService interface {
+	ListMemberships(ctx context.Context, projectIDOrIdentifier string) (*MembershipsResponse, error)
+	AllMemberships(ctx context.Context, projectIDOrIdentifier string) iter.Seq2[Membership, error]
+	ListAllMemberships(ctx context.Context, projectIDOrIdentifier string) ([]Membership, error)
+	ShowMembership(ctx context.Context, id int) (*MembershipResponse, error)
+	CreateMembership(ctx context.Context, projectIDOrIdentifier string, membership MembershipCreateUpdate) (*MembershipResponse, error)
+	UpdateMembership(ctx context.Context, id int, roleIDs []int) error
+	DeleteMembership(ctx context.Context, id int) error
+}
+
+// UsersService reads and writes users.
+type UsersService interface {
+	ListUsers(ctx context.Context, opts *ListUsersOptions) (*UsersResponse, error)
+	AllUsers(ctx context.Context, opts *ListUsersOptions) iter.Seq2[User, error]
+	ListAllUsers(ctx context.Context, opts *ListUsersOptions) ([]User, error)
+	ShowUser(ctx context.Context, id int, opts *ShowUserOptions) (*UserResponse, error)
+	GetCurrentUser(ctx context.Context, opts *ShowUserOptions) (*UserResponse, error)
+	CreateUser(ctx context.Context, user User) (*UserResponse, error)
+	UpdateUser(ctx context.Context, id int, user User) error
+	DeleteUser(ctx context.Context, id int) error
+}
+
+// GroupsService reads and writes groups and their users.
+type GroupsService interface {
+	ListGroups(ctx context.Context, opts *ListGroupsOptions) (*GroupsResponse, error)
+	ShowGroup(ctx context.Context, id int, opts *ShowGroupOptions) (*GroupResponse, error)
+	CreateGroup(ctx context.Context, group Group) (*GroupResponse, error)
+	UpdateGroup(ctx context.Context, id int, group Group) error
+	DeleteGroup(ctx context.Context, id int) error
+	AddUserToGroup(ctx context.Context, groupID int, userID int) error
+	RemoveUserFromGroup(ctx context.Context, groupID int, userID int) error
+}
+
+// MyAccountService reads and writes the account of the current user.
+type MyAccountService interface {
+	GetMyAccount(ctx context.Context) (*MyAccountResponse, error)
+	UpdateMyAccount(ctx context.Context, user User) error
+}
+
+// TimeEntriesService reads and writes time entries.
+type TimeEntriesService interface {
+	ListTimeEntries(ctx context.Context, opts *ListTimeEntriesOptions) (*TimeEntriesResponse, error)
+	AllTimeEntries(ctx context.Context, opts *ListTimeEntriesOptions) iter.Seq2[TimeEntry, error]
+	ListAllTimeEntries(ctx context.Context, opts *ListTimeEntriesOptions) ([]TimeEntry, error)
+	ShowTimeEntry(ctx context.Context, id int) (*TimeEntryResponse, error)
+	CreateTimeEntry(ctx context.Context, req TimeEntryCreateRequest) (*TimeEntryResponse, error)
+	UpdateTimeEntry(ctx context.Context, id int, req TimeEntryUpdateRequest) error
+	DeleteTimeEntry(ctx context.Context, id int) error
+}
+
+// AttachmentsService uploads, downloads and edits attachments.
+type AttachmentsService interface {
+	Upload(ctx context.Context, filename string, r io.Reader, size int64) (*Upload, error)
+	UploadWithOptions(ctx context.Context, filename string, r io.Reader, size int64, opts *UploadOptions) (*Upload, error)
+	ShowAttachment(ctx context.Context, id int) (*AttachmentResponse, error)
+	UpdateAttachment(ctx context.Context, id int, attachment Attachment) error
+	DeleteAttachment(ctx context.Context, id int) error
+	DownloadAttachment(ctx context.Context, id int, w io.Writer) (int64, error)
+	DownloadAttachmentWithOptions(ctx context.Context, id int, w io.Writer, opts *DownloadOptions) (int64, error)
+	DownloadThumbnail(ctx context.Context, id int, size int, w io.Writer) (int64, error)
+}
+
+// FilesService lists, uploads and downloads project files.
+type FilesService interface {
+	ListFiles(ctx context.Context, projectIDOrIdentifier string) (*FilesResponse, error)
+	UploadFile(ctx context.Context, projectIDOrIdentifier string, fileUpload FileUpload) error
+	DownloadFile(ctx context.Context, file File, w io.Writer) error
+	DownloadFileToPath(ctx context.Context, file File, path string) error
+}
+
+// WikiService reads and writes wiki pages and their versions.
+type WikiService interface {
+	ListWikiPages(ctx context.Context, projectIDOrIdentifier string) (*WikiPagesResponse, error)
+	GetWikiPage(ctx context.Context, projectIDOrIdentifier string, pageName string, opts *GetWikiPageOptions) (*WikiPageResponse, error)
+	GetWikiPageVersion(ctx context.Context, projectIDOrIdentifier string, pageName string, version int) (*WikiPage, error)
+	ListWikiPageVersions(ctx context.Context, projectIDOrIdentifier string, pageName string, opts *ListWikiPageVersionsOptions) ([]WikiPageVersion, error)
+	DiffWikiPageVersions(ctx context.Context, projectIDOrIdentifier string, pageName string, from, to int) (string, error)
+	CreateOrUpdateWikiPage(ctx context.Context, projectIDOrIdentifier string, pageName string, page WikiPageUpdate) error
+	DeleteWikiPage(ctx context.Context, projectIDOrIdentifier string, pageName string) error
+}
+
+// NewsService lists news.
+type NewsService interface {
+	ListNews(ctx context.Context, opts *ListNewsOptions) (*NewsResponse, error)
+	AllNews(ctx context.Context, opts *ListNewsOptions) iter.Seq2[News, error]
+	ListAllNews(ctx context.Context, opts *ListNewsOptions) ([]News, error)
+	ListProjectNews(ctx context.Context, projectIDOrIdentifier string, opts *ListNewsOptions) (*NewsResponse, error)
+	AllProjectNews(ctx context.Context, projectIDOrIdentifier string, opts *ListNewsOptions) iter.Seq2[News, error]
+	ListAllProjectNews(ctx context.Context, projectIDOrIdentifier string, opts *ListNewsOptions) ([]News, error)
+}
+
+// QueriesService lists saved queries and the issues they match.
+type QueriesService interface {
+	ListQueries(ctx context.Context) (*QueriesResponse, error)
+	ListIssuesByQuery(ctx context.Context, query Query, opts *ListIssuesOptions) (*IssuesResponse, error)
+	ListAllIssuesByQuery(ctx context.Context, query Query, opts *ListIssuesOptions) ([]Issue, error)
+}
+
+// SearchService searches the content of Redmine.
+type SearchService interface {
+	Search(ctx context.Context, opts *SearchOptions) (*SearchResponse, error)
+	SearchSimple(ctx context.Context, query string) (*SearchResponse, error)
+	AllSearchResults(ctx context.Context, opts *SearchOptions) iter.Seq2[SearchResult, error]
+	ListAllSearchResults(ctx context.Context, opts *SearchOptions) ([]SearchResult, error)
+}
+
+// ActivityService reads the activity stream and other Atom feeds.
+type ActivityService interface {
+	ListActivity(ctx context.Context, opts *ListActivityOptions) ([]ActivityEvent, error)
+	GetFeed(ctx context.Context, path string, params url.Values) (*ActivityFeed, error)
+}
+
+// JournalsService reads issue journals.
+type JournalsService interface {
+	ShowJournal(ctx context.Context, id int) (*JournalResponse, error)
+}
+
+// RolesService reads roles.
+type RolesService interface {
+	ListRoles(ctx context.Context) (*RolesResponse, error)
+	ShowRole(ctx context.Context, id int) (*RoleResponse, error)
+}
+
+// TrackersService lists trackers.
+type TrackersService interface {
+	ListTrackers(ctx context.Context) (*TrackersResponse, error)
+}
+
+// IssueStatusesService lists issue statuses.
+type IssueStatusesService interface {
+	ListIssueStatuses(ctx context.Context) (*IssueStatusesResponse, error)
+}
+
+// EnumerationsService lists issue priorities, time entry activities and
+// document categories.
+type EnumerationsService interface {
+	ListIssuePriorities(ctx context.Context) (*EnumerationsResponse, error)
+	ListTimeEntryActivities(ctx context.Context) (*EnumerationsResponse, error)
+	ListDocumentCategories(ctx context.Context) (*EnumerationsResponse, error)
+}
+
+// CustomFieldsService lists custom field definitions.
+type CustomFieldsService interface {
+	ListCustomFields(ctx context.Context) (*CustomFieldsResponse, error)
+}
+
+// API is the whole API of Client, for code that uses many resources.
+//
+//nolint:interfacebloat // API is the union of the services
+type API interface {
+	IssuesService
+	WatchersService
+	IssueRelationsService
+	IssueCategoriesService
+	ProjectsService
+	VersionsService
+	MembershipsService
+	UsersService
+	GroupsService
+	MyAccountService
+	TimeEntriesService
+	AttachmentsService
+	FilesService
+	WikiService
+	NewsService
+	QueriesService
+	SearchService
+	ActivityService
+	JournalsService
+	RolesService
+	TrackersService
+	IssueStatusesService
+	EnumerationsService
+	CustomFieldsService
+}
+
+var _ API = (*Client)(nil)
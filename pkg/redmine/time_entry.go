@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -20,9 +21,9 @@ type TimeEntry struct {
 	Activity     Resource      `json:"activity,omitempty"`
 	Hours        float64       `json:"hours,omitempty"`
 	Comments     string        `json:"comments,omitempty"`
-	SpentOn      string        `json:"spent_on,omitempty"`
-	CreatedOn    string        `json:"created_on,omitempty"`
-	UpdatedOn    string        `json:"updated_on,omitempty"`
+	SpentOn      Date          `json:"spent_on,omitzero"`
+	CreatedOn    Timestamp     `json:"created_on,omitzero"`
+	UpdatedOn    Timestamp     `json:"updated_on,omitzero"`
 	CustomFields []CustomField `json:"custom_fields,omitempty"`
 }
 
@@ -30,7 +31,7 @@ type TimeEntry struct {
 type TimeEntryCreateRequest struct {
 	IssueID      int           `json:"issue_id,omitempty"`
 	ProjectID    int           `json:"project_id,omitempty"`
-	SpentOn      string        `json:"spent_on,omitempty"`
+	SpentOn      Date          `json:"spent_on,omitzero"`
 	Hours        float64       `json:"hours"`
 	ActivityID   int           `json:"activity_id,omitempty"`
 	Comments     string        `json:"comments,omitempty"`
@@ -42,12 +43,25 @@ type TimeEntryCreateRequest struct {
 type TimeEntryUpdateRequest struct {
 	IssueID      int           `json:"issue_id,omitempty"`
 	ProjectID    int           `json:"project_id,omitempty"`
-	SpentOn      string        `json:"spent_on,omitempty"`
+	SpentOn      Date          `json:"spent_on,omitzero"`
 	Hours        float64       `json:"hours,omitempty"`
 	ActivityID   int           `json:"activity_id,omitempty"`
 	Comments     string        `json:"comments,omitempty"`
 	UserID       int           `json:"user_id,omitempty"`
 	CustomFields []CustomField `json:"custom_fields,omitempty"`
+
+	// ForceSendFields lists JSON keys that are sent even when their value is
+	// zero, such as "comments" to remove the comment.
+	ForceSendFields []string `json:"-"`
+	// ClearFields lists JSON keys that are sent as an empty value to unset
+	// them, such as "issue_id" to log the time on the project only.
+	ClearFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the request, honouring ForceSendFields and ClearFields.
+func (r TimeEntryUpdateRequest) MarshalJSON() ([]byte, error) {
+	type request TimeEntryUpdateRequest
+	return marshalPatch(request(r), r.ForceSendFields, r.ClearFields)
 }
 
 type TimeEntriesResponse struct {
@@ -173,8 +187,7 @@ func (c *Client) CreateTimeEntry(ctx context.Context, req TimeEntryCreateRequest
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create time entry: %s", string(body))
+		return nil, fmt.Errorf("failed to create time entry: %w", newAPIError(resp))
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -208,8 +221,7 @@ func (c *Client) UpdateTimeEntry(ctx context.Context, id int, req TimeEntryUpdat
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to update time entry: %s", string(body))
+		return fmt.Errorf("failed to update time entry: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -227,9 +239,32 @@ func (c *Client) DeleteTimeEntry(ctx context.Context, id int) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete time entry: %s", string(body))
+		return fmt.Errorf("failed to delete time entry: %w", newAPIError(resp))
 	}
 
 	return nil
 }
+
+// AllTimeEntries returns an iterator over every time entry matching opts, fetching pages as needed.
+// opts.Limit sets the page size and opts.Offset the starting position.
+func (c *Client) AllTimeEntries(ctx context.Context, opts *ListTimeEntriesOptions) iter.Seq2[TimeEntry, error] {
+	var base ListTimeEntriesOptions
+	if opts != nil {
+		base = *opts
+	}
+
+	return paginate(ctx, c, base.Offset, base.Limit, func(ctx context.Context, offset, limit int) page[TimeEntry] {
+		o := base
+		o.Offset, o.Limit = offset, limit
+		resp, err := c.ListTimeEntries(ctx, &o)
+		if err != nil {
+			return page[TimeEntry]{err: err}
+		}
+		return page[TimeEntry]{items: resp.TimeEntries, total: resp.TotalCount, limit: resp.Limit}
+	})
+}
+
+// ListAllTimeEntries retrieves every time entry matching opts across all pages
+func (c *Client) ListAllTimeEntries(ctx context.Context, opts *ListTimeEntriesOptions) ([]TimeEntry, error) {
+	return collect(c.AllTimeEntries(ctx, opts))
+}
@@ -1,9 +1,12 @@
 package redmine
 
+// CustomField is a custom field value. Value is a string, or a []string for
+// fields that accept multiple values; see ListValues and the typed accessors.
 type CustomField struct {
-	ID    int    `json:"id,omitempty"`
-	Name  string `json:"name,omitempty"`
-	Value any    `json:"value,omitempty"`
+	ID       int    `json:"id,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Multiple bool   `json:"multiple,omitempty"`
+	Value    any    `json:"value,omitempty"`
 }
 
 type Resource struct {
@@ -17,10 +20,10 @@ type Watcher struct {
 }
 
 type Changeset struct {
-	Revision    string   `json:"revision,omitempty"`
-	User        Resource `json:"user,omitempty"`
-	Comments    string   `json:"comments,omitempty"`
-	CommittedOn string   `json:"committed_on,omitempty"`
+	Revision    string    `json:"revision,omitempty"`
+	User        Resource  `json:"user,omitempty"`
+	Comments    string    `json:"comments,omitempty"`
+	CommittedOn Timestamp `json:"committed_on,omitzero"`
 }
 
 type Upload struct {
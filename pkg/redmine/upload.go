@@ -0,0 +1,116 @@
+package redmine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+)
+
+// UploadOptions configures an upload made with UploadWithOptions.
+type UploadOptions struct {
+	// ContentType overrides the content type guessed from the file extension.
+	ContentType string
+	// Description is copied into the returned Upload.
+	Description string
+	// Progress, if set, is called as the body is sent with the number of bytes
+	// written so far and the total size.
+	Progress func(written, total int64)
+}
+
+type uploadResponse struct {
+	Upload struct {
+		ID    int    `json:"id,omitempty"`
+		Token string `json:"token"`
+	} `json:"upload"`
+}
+
+// Upload streams r to Redmine and returns an Upload whose token can be used in
+// IssueCreateRequest, IssueUpdateRequest, WikiPageUpdate or FileUpload.
+// size must be the exact number of bytes r will produce.
+func (c *Client) Upload(ctx context.Context, filename string, r io.Reader, size int64) (*Upload, error) {
+	return c.UploadWithOptions(ctx, filename, r, size, nil)
+}
+
+// UploadWithOptions is like Upload but accepts a content type, description and progress callback.
+func (c *Client) UploadWithOptions(ctx context.Context, filename string, r io.Reader, size int64, opts *UploadOptions) (*Upload, error) {
+	endpoint := c.baseURL + "/uploads.json"
+	if filename != "" {
+		params := url.Values{}
+		params.Add("filename", filename)
+		endpoint = fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	}
+
+	if opts == nil {
+		opts = &UploadOptions{}
+	}
+
+	var body io.Reader = r
+	if opts.Progress != nil {
+		body = &progressReader{r: r, total: size, progress: opts.Progress}
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.ContentLength = size
+	if size == 0 {
+		req.Body = http.NoBody
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:errcheck
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("failed to upload: %w", newAPIError(resp))
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result uploadResponse
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	contentType := opts.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(filename))
+	}
+
+	return &Upload{
+		Token:       result.Upload.Token,
+		Filename:    filename,
+		Description: opts.Description,
+		ContentType: contentType,
+	}, nil
+}
+
+// progressReader reports the number of bytes read to a callback.
+type progressReader struct {
+	r        io.Reader
+	written  int64
+	total    int64
+	progress func(written, total int64)
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.written += int64(n)
+		p.progress(p.written, p.total)
+	}
+	return n, err
+}
@@ -0,0 +1,90 @@
+package redmine
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUpload(t *testing.T) {
+	content := strings.Repeat("x", 64*1024)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/uploads.json" {
+			t.Errorf("Expected path /uploads.json, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("filename"); got != "report.pdf" {
+			t.Errorf("Expected filename report.pdf, got %s", got)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/octet-stream" {
+			t.Errorf("Expected Content-Type application/octet-stream, got %s", got)
+		}
+		if r.ContentLength != int64(len(content)) {
+			t.Errorf("Expected Content-Length %d, got %d", len(content), r.ContentLength)
+		}
+		if got := r.Header.Get("X-Redmine-Api-Key"); got != "test-api-key" {
+			t.Errorf("Expected X-Redmine-API-Key test-api-key, got %s", got)
+		}
+
+		b, _ := io.ReadAll(r.Body)
+		if string(b) != content {
+			t.Errorf("Expected %d bytes of content, got %d", len(content), len(b))
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"upload":{"id":7,"token":"7.ed32257a2ab0f7526c0d72c32994c58b"}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+
+	var lastWritten, lastTotal int64
+	upload, err := client.UploadWithOptions(context.Background(), "report.pdf", io.NopCloser(strings.NewReader(content)), int64(len(content)), &UploadOptions{
+		Description: "Monthly report",
+		Progress: func(written, total int64) {
+			lastWritten, lastTotal = written, total
+		},
+	})
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+
+	if upload.Token != "7.ed32257a2ab0f7526c0d72c32994c58b" {
+		t.Errorf("Unexpected token %s", upload.Token)
+	}
+	if upload.Filename != "report.pdf" {
+		t.Errorf("Expected filename report.pdf, got %s", upload.Filename)
+	}
+	if upload.ContentType != "application/pdf" {
+		t.Errorf("Expected content type application/pdf, got %s", upload.ContentType)
+	}
+	if upload.Description != "Monthly report" {
+		t.Errorf("Expected description to be kept, got %s", upload.Description)
+	}
+	if lastWritten != int64(len(content)) || lastTotal != int64(len(content)) {
+		t.Errorf("Expected final progress %d/%d, got %d/%d", len(content), len(content), lastWritten, lastTotal)
+	}
+}
+
+func TestUploadError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"errors":["This file cannot be uploaded because it exceeds the maximum allowed file size (5 MB)"]}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	_, err := client.Upload(context.Background(), "big.bin", strings.NewReader("data"), 4)
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "maximum allowed file size") {
+		t.Errorf("Expected validation message in error, got %v", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -18,10 +19,10 @@ type User struct {
 	Firstname        string        `json:"firstname,omitempty"`
 	Lastname         string        `json:"lastname,omitempty"`
 	Mail             string        `json:"mail,omitempty"`
-	CreatedOn        string        `json:"created_on,omitempty"`
-	UpdatedOn        string        `json:"updated_on,omitempty"`
-	LastLoginOn      string        `json:"last_login_on,omitempty"`
-	PasswdChangedOn  string        `json:"passwd_changed_on,omitempty"`
+	CreatedOn        Timestamp     `json:"created_on,omitzero"`
+	UpdatedOn        Timestamp     `json:"updated_on,omitzero"`
+	LastLoginOn      Timestamp     `json:"last_login_on,omitzero"`
+	PasswdChangedOn  Timestamp     `json:"passwd_changed_on,omitzero"`
 	TwofaScheme      string        `json:"twofa_scheme,omitempty"`
 	APIKey           string        `json:"api_key,omitempty"`
 	Status           int           `json:"status,omitempty"`
@@ -189,8 +190,7 @@ func (c *Client) CreateUser(ctx context.Context, user User) (*UserResponse, erro
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create user: %s", string(body))
+		return nil, fmt.Errorf("failed to create user: %w", newAPIError(resp))
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -224,8 +224,7 @@ func (c *Client) UpdateUser(ctx context.Context, id int, user User) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to update user: %s", string(body))
+		return fmt.Errorf("failed to update user: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -243,9 +242,32 @@ func (c *Client) DeleteUser(ctx context.Context, id int) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete user: %s", string(body))
+		return fmt.Errorf("failed to delete user: %w", newAPIError(resp))
 	}
 
 	return nil
 }
+
+// AllUsers returns an iterator over every user matching opts, fetching pages as needed.
+// opts.Limit sets the page size and opts.Offset the starting position.
+func (c *Client) AllUsers(ctx context.Context, opts *ListUsersOptions) iter.Seq2[User, error] {
+	var base ListUsersOptions
+	if opts != nil {
+		base = *opts
+	}
+
+	return paginate(ctx, c, base.Offset, base.Limit, func(ctx context.Context, offset, limit int) page[User] {
+		o := base
+		o.Offset, o.Limit = offset, limit
+		resp, err := c.ListUsers(ctx, &o)
+		if err != nil {
+			return page[User]{err: err}
+		}
+		return page[User]{items: resp.Users, total: resp.TotalCount, limit: resp.Limit}
+	})
+}
+
+// ListAllUsers retrieves every user matching opts across all pages
+func (c *Client) ListAllUsers(ctx context.Context, opts *ListUsersOptions) ([]User, error) {
+	return collect(c.AllUsers(ctx, opts))
+}
@@ -10,18 +10,31 @@ import (
 )
 
 type Version struct {
-	ID             int      `json:"id,omitempty"`
-	Project        Resource `json:"project,omitempty"`
-	Name           string   `json:"name,omitempty"`
-	Description    string   `json:"description,omitempty"`
-	Status         string   `json:"status,omitempty"`
-	DueDate        string   `json:"due_date,omitempty"`
-	Sharing        string   `json:"sharing,omitempty"`
-	WikiPageTitle  string   `json:"wiki_page_title,omitempty"`
-	EstimatedHours float64  `json:"estimated_hours,omitempty"`
-	SpentHours     float64  `json:"spent_hours,omitempty"`
-	CreatedOn      string   `json:"created_on,omitempty"`
-	UpdatedOn      string   `json:"updated_on,omitempty"`
+	ID             int       `json:"id,omitempty"`
+	Project        Resource  `json:"project,omitempty"`
+	Name           string    `json:"name,omitempty"`
+	Description    string    `json:"description,omitempty"`
+	Status         string    `json:"status,omitempty"`
+	DueDate        Date      `json:"due_date,omitzero"`
+	Sharing        string    `json:"sharing,omitempty"`
+	WikiPageTitle  string    `json:"wiki_page_title,omitempty"`
+	EstimatedHours float64   `json:"estimated_hours,omitempty"`
+	SpentHours     float64   `json:"spent_hours,omitempty"`
+	CreatedOn      Timestamp `json:"created_on,omitzero"`
+	UpdatedOn      Timestamp `json:"updated_on,omitzero"`
+
+	// ForceSendFields lists JSON keys that are sent even when their value is
+	// zero. Only used by UpdateVersion.
+	ForceSendFields []string `json:"-"`
+	// ClearFields lists JSON keys, such as "due_date", that are sent as an
+	// empty value to unset them. Only used by UpdateVersion.
+	ClearFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the version, honouring ForceSendFields and ClearFields.
+func (v Version) MarshalJSON() ([]byte, error) {
+	type version Version
+	return marshalPatch(version(v), v.ForceSendFields, v.ClearFields)
 }
 
 type VersionsResponse struct {
@@ -103,8 +116,7 @@ func (c *Client) CreateVersion(ctx context.Context, projectIDOrIdentifier string
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create version: %s", string(body))
+		return nil, fmt.Errorf("failed to create version: %w", newAPIError(resp))
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -138,8 +150,7 @@ func (c *Client) UpdateVersion(ctx context.Context, id int, version Version) err
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to update version: %s", string(body))
+		return fmt.Errorf("failed to update version: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -157,8 +168,7 @@ func (c *Client) DeleteVersion(ctx context.Context, id int) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete version: %s", string(body))
+		return fmt.Errorf("failed to delete version: %w", newAPIError(resp))
 	}
 
 	return nil
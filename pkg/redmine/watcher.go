@@ -0,0 +1,597 @@
+package redmine
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EventType is the kind of change an Event reports.
+type EventType string
+
+// Event types.
+const (
+	EventCreated EventType = "created"
+	EventUpdated EventType = "updated"
+	// EventClosed is reported instead of EventUpdated when an issue is closed.
+	EventClosed  EventType = "closed"
+	EventDeleted EventType = "deleted"
+)
+
+// Resources reported in Event.Resource.
+const (
+	ResourceIssue     = "issue"
+	ResourceTimeEntry = "time_entry"
+	ResourceWikiPage  = "wiki_page"
+	ResourceNews      = "news"
+)
+
+// Change is a field whose value changed between two polls. Fields are named
+// and valued as in IssueState, such as "status_id" or "cf_5".
+type Change struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value,omitempty"`
+	NewValue string `json:"new_value,omitempty"`
+}
+
+// JournalDetail returns c as a journal detail, which ChangelogResolver can
+// describe. Custom fields ("cf_5") become details with the "cf" property.
+func (c Change) JournalDetail() JournalDetail {
+	d := JournalDetail{Property: JournalPropertyAttr, Name: c.Field, OldValue: c.OldValue, NewValue: c.NewValue}
+	if id, ok := strings.CutPrefix(c.Field, "cf_"); ok {
+		d.Property, d.Name = JournalPropertyCustomField, id
+	}
+	return d
+}
+
+// Event is a change detected by a ChangeFeed. Exactly one of Issue, TimeEntry,
+// WikiPage and News is set, except for deletions, which only carry the last
+// known Fields.
+type Event struct {
+	Type     EventType `json:"type"`
+	Resource string    `json:"resource"`
+	// Key identifies the item: its ID, or "project/Title" for wiki pages.
+	Key string `json:"key"`
+	// At is when the item was updated, or when a deletion was detected.
+	At time.Time `json:"at"`
+	// Changes lists the fields changed by an update. It is empty for items
+	// updated before the ChangeFeed first saw them.
+	Changes []Change `json:"changes,omitempty"`
+	// Fields holds the current field values, or the last known for deletions.
+	Fields map[string]string `json:"fields,omitempty"`
+
+	Issue     *Issue         `json:"issue,omitempty"`
+	TimeEntry *TimeEntry     `json:"time_entry,omitempty"`
+	WikiPage  *WikiPageIndex `json:"wiki_page,omitempty"`
+	News      *News          `json:"news,omitempty"`
+}
+
+// Change returns the change to field, if any.
+func (e Event) Change(field string) (Change, bool) {
+	i := slices.IndexFunc(e.Changes, func(c Change) bool { return c.Field == field })
+	if i < 0 {
+		return Change{}, false
+	}
+	return e.Changes[i], true
+}
+
+// watchItem is an item listed by a WatchSource.
+type watchItem struct {
+	key       string
+	createdOn time.Time
+	updatedOn time.Time
+	fields    map[string]string
+	event     Event
+}
+
+// WatchSource is a resource polled by a ChangeFeed. Create one with WatchIssues,
+// WatchTimeEntries, WatchWikiPages or WatchNews.
+type WatchSource interface {
+	// Key identifies the source in checkpoints.
+	Key() string
+	resource() string
+	// list returns the items changed since state.Since, or all items if full
+	// is set or state is nil. It reports whether the listing is complete,
+	// which is needed to detect deletions.
+	list(ctx context.Context, c *Client, state *SourceState, full bool) ([]watchItem, bool, error)
+	// confirmDeleted checks the deletion e of an item missing from a
+	// complete listing. It returns e if the item is gone, or the event to
+	// report if the item only stopped matching the source.
+	confirmDeleted(ctx context.Context, c *Client, e Event) (Event, error)
+}
+
+// ChangeFeedOptions configures a ChangeFeed.
+type ChangeFeedOptions struct {
+	// Interval is the time between polls. It defaults to one minute.
+	Interval time.Duration
+	// Store persists the checkpoint. It defaults to a MemoryCheckpointStore.
+	Store CheckpointStore
+	// ResyncEvery makes every n-th poll of issues list all issues instead of
+	// the ones updated since the last poll, which is needed to detect deleted
+	// issues. It defaults to 10; a negative value disables deletion detection.
+	ResyncEvery int
+	// OnError, if set, receives poll errors and Run keeps polling. Otherwise
+	// Run returns the first error.
+	OnError func(error)
+}
+
+// ChangeFeed polls Redmine and reports changes as Events. Redmine has no
+// webhooks, so changes are found by comparing each poll with the state saved
+// in a checkpoint. The first poll of a source records its state without
+// reporting events. A ChangeFeed must not be used concurrently.
+type ChangeFeed struct {
+	client     *Client
+	sources    []WatchSource
+	opts       ChangeFeedOptions
+	checkpoint *Checkpoint
+}
+
+// NewChangeFeed returns a ChangeFeed polling sources with c.
+func NewChangeFeed(c *Client, opts *ChangeFeedOptions, sources ...WatchSource) *ChangeFeed {
+	f := &ChangeFeed{client: c, sources: sources}
+	if opts != nil {
+		f.opts = *opts
+	}
+	if f.opts.Interval <= 0 {
+		f.opts.Interval = time.Minute
+	}
+	if f.opts.Store == nil {
+		f.opts.Store = NewMemoryCheckpointStore()
+	}
+	if f.opts.ResyncEvery == 0 {
+		f.opts.ResyncEvery = 10
+	}
+	return f
+}
+
+// Run polls every Interval until ctx is done, sending events to events. The
+// checkpoint is saved once the events of a poll have been received from
+// events, which may be before the receiver has handled the last of them; use
+// RunFunc to save it only once they are handled. It returns ctx.Err() when ctx
+// is done, or the first poll error if OnError is not set.
+func (f *ChangeFeed) Run(ctx context.Context, events chan<- Event) error {
+	return f.RunFunc(ctx, func(ctx context.Context, batch []Event) error {
+		for _, e := range batch {
+			select {
+			case events <- e:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+}
+
+// RunFunc polls every Interval until ctx is done, calling handle with the
+// events of each poll that found any. The checkpoint is saved only once handle
+// returns nil, so events are delivered at least once across restarts. An error
+// from handle is treated as a poll error, and the events are found again by
+// the next poll. It returns ctx.Err() when ctx is done, or the first error if
+// OnError is not set.
+func (f *ChangeFeed) RunFunc(ctx context.Context, handle func(context.Context, []Event) error) error {
+	ticker := time.NewTicker(f.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := f.runOnce(ctx, handle); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if f.opts.OnError == nil {
+				return err
+			}
+			f.opts.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (f *ChangeFeed) runOnce(ctx context.Context, handle func(context.Context, []Event) error) error {
+	batch, cp, err := f.poll(ctx)
+	if err != nil {
+		return err
+	}
+	if len(batch) > 0 {
+		if err := handle(ctx, batch); err != nil {
+			return err
+		}
+	}
+	return f.commit(ctx, cp)
+}
+
+// Poll polls every source once, saves the checkpoint and returns the events found.
+func (f *ChangeFeed) Poll(ctx context.Context) ([]Event, error) {
+	events, cp, err := f.poll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.commit(ctx, cp); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (f *ChangeFeed) poll(ctx context.Context) ([]Event, *Checkpoint, error) {
+	if f.checkpoint == nil {
+		cp, err := f.opts.Store.Load(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if cp == nil {
+			cp = &Checkpoint{}
+		}
+		f.checkpoint = cp
+	}
+
+	next := &Checkpoint{Sources: maps.Clone(f.checkpoint.Sources)}
+	if next.Sources == nil {
+		next.Sources = map[string]*SourceState{}
+	}
+
+	var events []Event
+	for _, source := range f.sources {
+		state := f.checkpoint.Sources[source.Key()]
+		full := state != nil && f.opts.ResyncEvery > 0 && state.Polls+1 >= f.opts.ResyncEvery
+		items, complete, err := source.list(ctx, f.client, state, full)
+		if err != nil {
+			return nil, nil, err
+		}
+		sourceEvents, nextState := diffSource(source.resource(), state, items, complete)
+		for i, e := range sourceEvents {
+			if e.Type != EventDeleted {
+				continue
+			}
+			if sourceEvents[i], err = source.confirmDeleted(ctx, f.client, e); err != nil {
+				return nil, nil, err
+			}
+		}
+		events = append(events, sourceEvents...)
+		next.Sources[source.Key()] = nextState
+	}
+
+	return events, next, nil
+}
+
+func (f *ChangeFeed) commit(ctx context.Context, cp *Checkpoint) error {
+	if err := f.opts.Store.Save(ctx, cp); err != nil {
+		return err
+	}
+	f.checkpoint = cp
+	return nil
+}
+
+// diffSource compares the items listed by a source with its previous state.
+func diffSource(resource string, prev *SourceState, items []watchItem, complete bool) ([]Event, *SourceState) {
+	next := &SourceState{Items: map[string]map[string]string{}}
+	if prev != nil {
+		next.Since = prev.Since
+		next.Polls = prev.Polls + 1
+		if !complete {
+			maps.Copy(next.Items, prev.Items)
+		}
+	}
+	if complete {
+		next.Polls = 0
+	}
+
+	var events []Event
+	listed := map[string]bool{}
+	for _, item := range items {
+		listed[item.key] = true
+		next.Items[item.key] = item.fields
+		if item.updatedOn.After(next.Since.Time) {
+			next.Since = Timestamp{item.updatedOn}
+		}
+		if prev == nil {
+			continue
+		}
+
+		e := item.event
+		e.Key = item.key
+		e.At = item.updatedOn
+		e.Fields = item.fields
+
+		old, known := prev.Items[item.key]
+		switch {
+		case !known && !item.createdOn.Before(prev.Since.Time):
+			e.Type = EventCreated
+		case !known:
+			e.Type = EventUpdated
+		default:
+			e.Changes = diffFields(old, item.fields)
+			if len(e.Changes) == 0 {
+				continue
+			}
+			e.Type = EventUpdated
+			if c, ok := e.Change("closed_on"); ok && c.NewValue != "" {
+				e.Type = EventClosed
+			}
+		}
+		events = append(events, e)
+	}
+
+	if prev != nil && complete {
+		now := time.Now()
+		for _, key := range slices.Sorted(maps.Keys(prev.Items)) {
+			if listed[key] {
+				continue
+			}
+			events = append(events, Event{
+				Type:     EventDeleted,
+				Resource: resource,
+				Key:      key,
+				At:       now,
+				Fields:   prev.Items[key],
+			})
+		}
+	}
+
+	return events, next
+}
+
+func diffFields(old, current map[string]string) []Change {
+	var changes []Change
+	keys := slices.Sorted(maps.Keys(current))
+	for _, key := range slices.Sorted(maps.Keys(old)) {
+		if _, ok := current[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	for _, key := range keys {
+		if old[key] != current[key] {
+			changes = append(changes, Change{Field: key, OldValue: old[key], NewValue: current[key]})
+		}
+	}
+	return changes
+}
+
+type issueSource struct {
+	key  string
+	opts ListIssuesOptions
+}
+
+// WatchIssues watches the issues matching opts, open and closed unless
+// opts.StatusID says otherwise. Each poll lists the issues updated since the
+// previous one. Events report the fields of IssueState and "closed_on".
+//
+// An issue that stops matching opts, such as an issue closed while watching
+// open issues or reassigned while watching the issues of a user, is missing
+// from the full listing made every ChangeFeedOptions.ResyncEvery polls. It is
+// then looked up and reported as updated or closed, with the changes since it
+// was last seen. Only issues that Redmine no longer shows are reported as
+// deleted.
+func WatchIssues(opts *ListIssuesOptions) WatchSource {
+	var o ListIssuesOptions
+	if opts != nil {
+		o = *opts
+	}
+	o.Limit, o.Offset, o.UpdatedOn, o.Sort = 0, 0, "", ""
+	if o.StatusID == "" {
+		o.StatusID = "*"
+	}
+	return &issueSource{key: "issues?" + o.values().Encode(), opts: o}
+}
+
+func (s *issueSource) Key() string {
+	return s.key
+}
+
+func (s *issueSource) resource() string {
+	return ResourceIssue
+}
+
+func (s *issueSource) list(ctx context.Context, c *Client, state *SourceState, full bool) ([]watchItem, bool, error) {
+	o := s.opts
+	complete := state == nil || full || state.Since.IsZero()
+	if !complete {
+		o.UpdatedOn = TimeRange(state.Since.Time, time.Time{})
+	}
+
+	var items []watchItem
+	for issue, err := range c.AllIssues(ctx, &o) {
+		if err != nil {
+			return nil, false, err
+		}
+		items = append(items, issueItem(issue))
+	}
+	return items, complete, nil
+}
+
+func (s *issueSource) confirmDeleted(ctx context.Context, c *Client, e Event) (Event, error) {
+	id, err := strconv.Atoi(e.Key)
+	if err != nil {
+		return e, nil
+	}
+	result, err := c.ShowIssue(ctx, id, nil)
+	// An issue moved to a project the user cannot see is gone for the feed too
+	if errors.Is(err, ErrNotFound) || errors.Is(err, ErrForbidden) {
+		return e, nil
+	}
+	if err != nil {
+		return Event{}, err
+	}
+
+	item := issueItem(result.Issue)
+	left := item.event
+	left.Type = EventUpdated
+	left.Key, left.At, left.Fields = e.Key, item.updatedOn, item.fields
+	left.Changes = diffFields(e.Fields, item.fields)
+	if change, ok := left.Change("closed_on"); ok && change.NewValue != "" {
+		left.Type = EventClosed
+	}
+	return left, nil
+}
+
+func issueItem(issue Issue) watchItem {
+	fields := currentIssueState(issue).flatten()
+	fields["closed_on"] = issue.ClosedOn.String()
+	return watchItem{
+		key:       strconv.Itoa(issue.ID),
+		createdOn: issue.CreatedOn.Time,
+		updatedOn: issue.UpdatedOn.Time,
+		fields:    fields,
+		event:     Event{Resource: ResourceIssue, Issue: &issue},
+	}
+}
+
+type timeEntrySource struct {
+	opts ListTimeEntriesOptions
+}
+
+// WatchTimeEntries watches the time entries matching opts. Redmine cannot list
+// time entries by update time, so each poll lists all of them; set opts.From
+// to bound the listing.
+func WatchTimeEntries(opts *ListTimeEntriesOptions) WatchSource {
+	var o ListTimeEntriesOptions
+	if opts != nil {
+		o = *opts
+	}
+	o.Limit, o.Offset = 0, 0
+	return &timeEntrySource{opts: o}
+}
+
+func (s *timeEntrySource) Key() string {
+	params := url.Values{}
+	params.Set("project_id", s.opts.ProjectID)
+	params.Set("user_id", strconv.Itoa(s.opts.UserID))
+	params.Set("spent_on", s.opts.SpentOn)
+	params.Set("from", s.opts.From)
+	params.Set("to", s.opts.To)
+	return "time_entries?" + params.Encode()
+}
+
+func (s *timeEntrySource) confirmDeleted(ctx context.Context, c *Client, e Event) (Event, error) {
+	return e, nil
+}
+
+func (s *timeEntrySource) resource() string {
+	return ResourceTimeEntry
+}
+
+func (s *timeEntrySource) list(ctx context.Context, c *Client, state *SourceState, full bool) ([]watchItem, bool, error) {
+	var items []watchItem
+	for entry, err := range c.AllTimeEntries(ctx, &s.opts) {
+		if err != nil {
+			return nil, false, err
+		}
+		items = append(items, watchItem{
+			key:       strconv.Itoa(entry.ID),
+			createdOn: entry.CreatedOn.Time,
+			updatedOn: entry.UpdatedOn.Time,
+			fields: map[string]string{
+				"project_id":  journalID(entry.Project.ID),
+				"issue_id":    journalID(entry.Issue.ID),
+				"user_id":     journalID(entry.User.ID),
+				"activity_id": journalID(entry.Activity.ID),
+				"hours":       journalFloat(entry.Hours),
+				"comments":    entry.Comments,
+				"spent_on":    entry.SpentOn.String(),
+			},
+			event: Event{Resource: ResourceTimeEntry, TimeEntry: &entry},
+		})
+	}
+	return items, true, nil
+}
+
+type wikiPageSource struct {
+	project string
+}
+
+// WatchWikiPages watches the wiki pages of a project. Events report changes
+// to "version".
+func WatchWikiPages(projectIDOrIdentifier string) WatchSource {
+	return &wikiPageSource{project: projectIDOrIdentifier}
+}
+
+func (s *wikiPageSource) Key() string {
+	return "wiki_pages?project_id=" + s.project
+}
+
+func (s *wikiPageSource) confirmDeleted(ctx context.Context, c *Client, e Event) (Event, error) {
+	return e, nil
+}
+
+func (s *wikiPageSource) resource() string {
+	return ResourceWikiPage
+}
+
+func (s *wikiPageSource) list(ctx context.Context, c *Client, state *SourceState, full bool) ([]watchItem, bool, error) {
+	result, err := c.ListWikiPages(ctx, s.project)
+	if err != nil {
+		return nil, false, err
+	}
+
+	items := make([]watchItem, 0, len(result.WikiPages))
+	for _, page := range result.WikiPages {
+		items = append(items, watchItem{
+			key:       s.project + "/" + page.Title,
+			createdOn: page.CreatedOn.Time,
+			updatedOn: page.UpdatedOn.Time,
+			fields: map[string]string{
+				"project_id": s.project,
+				"version":    strconv.Itoa(page.Version),
+			},
+			event: Event{Resource: ResourceWikiPage, WikiPage: &page},
+		})
+	}
+	return items, true, nil
+}
+
+type newsSource struct {
+	project string
+}
+
+// WatchNews watches the news of a project, or of all projects if
+// projectIDOrIdentifier is empty.
+func WatchNews(projectIDOrIdentifier string) WatchSource {
+	return &newsSource{project: projectIDOrIdentifier}
+}
+
+func (s *newsSource) Key() string {
+	return "news?project_id=" + s.project
+}
+
+func (s *newsSource) confirmDeleted(ctx context.Context, c *Client, e Event) (Event, error) {
+	return e, nil
+}
+
+func (s *newsSource) resource() string {
+	return ResourceNews
+}
+
+func (s *newsSource) list(ctx context.Context, c *Client, state *SourceState, full bool) ([]watchItem, bool, error) {
+	all := c.AllNews(ctx, nil)
+	if s.project != "" {
+		all = c.AllProjectNews(ctx, s.project, nil)
+	}
+
+	var items []watchItem
+	for news, err := range all {
+		if err != nil {
+			return nil, false, err
+		}
+		items = append(items, watchItem{
+			key:       strconv.Itoa(news.ID),
+			createdOn: news.CreatedOn.Time,
+			updatedOn: news.CreatedOn.Time,
+			fields: map[string]string{
+				"project_id":  journalID(news.Project.ID),
+				"title":       news.Title,
+				"summary":     news.Summary,
+				"description": news.Description,
+			},
+			event: Event{Resource: ResourceNews, News: &news},
+		})
+	}
+	return items, true, nil
+}
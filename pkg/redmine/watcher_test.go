@@ -0,0 +1,356 @@
+package redmine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// feedServer is a Redmine stand-in whose issues and wiki pages can be changed between polls.
+type feedServer struct {
+	mu        sync.Mutex
+	issues    map[int]Issue
+	wikiPages []WikiPageIndex
+	queries   []string
+}
+
+func (s *feedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/issues.json":
+		s.queries = append(s.queries, r.URL.RawQuery)
+		var since time.Time
+		if expr := r.URL.Query().Get("updated_on"); expr != "" {
+			since, _ = time.Parse(time.RFC3339, strings.TrimPrefix(expr, OpGreaterOrEqual))
+		}
+		openOnly := r.URL.Query().Get("status_id") == "open"
+		issues := []Issue{}
+		for id := 1; id <= 10; id++ {
+			if issue, ok := s.issues[id]; ok && !issue.UpdatedOn.Before(since) && (!openOnly || issue.ClosedOn.IsZero()) {
+				issues = append(issues, issue)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(IssuesResponse{Issues: issues, TotalCount: len(issues), Limit: 25})
+	case "/projects/docs/wiki/index.json":
+		_ = json.NewEncoder(w).Encode(WikiPagesResponse{WikiPages: s.wikiPages})
+	default:
+		var id int
+		if _, err := fmt.Sscanf(r.URL.Path, "/issues/%d.json", &id); err == nil {
+			if issue, ok := s.issues[id]; ok {
+				_ = json.NewEncoder(w).Encode(IssueResponse{Issue: issue})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *feedServer) update(f func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f()
+}
+
+func feedTime(minute int) Timestamp {
+	return Timestamp{time.Date(2024, time.May, 1, 10, minute, 0, 0, time.UTC)}
+}
+
+func newFeedServer() *feedServer {
+	return &feedServer{
+		issues: map[int]Issue{
+			1: {ID: 1, Status: Resource{ID: 1}, Subject: "Login fails", CreatedOn: feedTime(0), UpdatedOn: feedTime(0)},
+			2: {ID: 2, Status: Resource{ID: 1}, Subject: "Add export", CreatedOn: feedTime(1), UpdatedOn: feedTime(1)},
+		},
+		wikiPages: []WikiPageIndex{{Title: "Guide", Version: 1, CreatedOn: feedTime(0), UpdatedOn: feedTime(0)}},
+	}
+}
+
+func TestChangeFeedPoll(t *testing.T) {
+	s := newFeedServer()
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	feed := NewChangeFeed(client, &ChangeFeedOptions{ResyncEvery: 2},
+		WatchIssues(&ListIssuesOptions{ProjectID: 1}),
+		WatchWikiPages("docs"),
+	)
+	ctx := context.Background()
+
+	// The first poll records the current state
+	events, err := feed.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Expected no events on the first poll, got %v", events)
+	}
+	if q := s.queries[0]; !strings.Contains(q, "status_id=%2A") || strings.Contains(q, "updated_on") {
+		t.Errorf("Expected a full listing of open and closed issues, got %s", q)
+	}
+
+	s.update(func() {
+		s.issues[1] = Issue{ID: 1, Status: Resource{ID: 2}, Subject: "Login fails", CreatedOn: feedTime(0), UpdatedOn: feedTime(5)}
+		s.issues[3] = Issue{ID: 3, Status: Resource{ID: 1}, Subject: "New", CreatedOn: feedTime(6), UpdatedOn: feedTime(6)}
+		s.wikiPages[0].Version, s.wikiPages[0].UpdatedOn = 2, feedTime(7)
+	})
+
+	events, err = feed.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if q := s.queries[1]; !strings.Contains(q, "updated_on=%3E%3D2024-05-01T10%3A01%3A00Z") {
+		t.Errorf("Expected issues updated since the last poll, got %s", q)
+	}
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %d: %v", len(events), events)
+	}
+	if e := events[0]; e.Type != EventUpdated || e.Key != "1" || e.Issue == nil || len(e.Changes) != 1 {
+		t.Errorf("Expected update of #1, got %+v", e)
+	} else if c, _ := e.Change("status_id"); c.OldValue != "1" || c.NewValue != "2" {
+		t.Errorf("Expected status change from 1 to 2, got %+v", c)
+	}
+	if e := events[1]; e.Type != EventCreated || e.Key != "3" || e.Fields["subject"] != "New" {
+		t.Errorf("Expected creation of #3, got %+v", e)
+	}
+	if e := events[2]; e.Type != EventUpdated || e.Resource != ResourceWikiPage || e.Key != "docs/Guide" || e.WikiPage.Version != 2 {
+		t.Errorf("Expected update of the Guide page, got %+v", e)
+	}
+
+	s.update(func() {
+		s.issues[2] = Issue{ID: 2, Status: Resource{ID: 5}, Subject: "Add export", CreatedOn: feedTime(1), UpdatedOn: feedTime(8), ClosedOn: feedTime(8)}
+		delete(s.issues, 3)
+	})
+
+	// The third poll lists all issues, which reveals the deletion
+	events, err = feed.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %d: %v", len(events), events)
+	}
+	if e := events[0]; e.Type != EventClosed || e.Key != "2" {
+		t.Errorf("Expected #2 to be closed, got %+v", e)
+	}
+	if e := events[1]; e.Type != EventDeleted || e.Resource != ResourceIssue || e.Key != "3" || e.Fields["subject"] != "New" {
+		t.Errorf("Expected deletion of #3, got %+v", e)
+	}
+
+	// Nothing changed since
+	events, err = feed.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Expected no events, got %v", events)
+	}
+}
+
+func TestChangeFeedFilteredIssues(t *testing.T) {
+	s := newFeedServer()
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	feed := NewChangeFeed(New(server.URL, "test-api-key"), &ChangeFeedOptions{ResyncEvery: 2},
+		WatchIssues(&ListIssuesOptions{StatusID: "open"}),
+	)
+	ctx := context.Background()
+
+	if _, err := feed.Poll(ctx); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+
+	// Closing an issue takes it out of the open issues
+	s.update(func() {
+		s.issues[1] = Issue{ID: 1, Status: Resource{ID: 5}, Subject: "Login fails", CreatedOn: feedTime(0), UpdatedOn: feedTime(5), ClosedOn: feedTime(5)}
+	})
+	events, err := feed.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Expected no events before the full listing, got %v", events)
+	}
+
+	// The full listing misses #1, which is looked up and found closed, and #2,
+	// which is gone
+	s.update(func() {
+		delete(s.issues, 2)
+	})
+	events, err = feed.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %d: %v", len(events), events)
+	}
+	if e := events[0]; e.Type != EventClosed || e.Key != "1" || e.Issue == nil || !e.At.Equal(feedTime(5).Time) {
+		t.Errorf("Expected #1 to be closed, got %+v", e)
+	} else if c, _ := e.Change("status_id"); c.OldValue != "1" || c.NewValue != "5" {
+		t.Errorf("Expected status change from 1 to 5, got %+v", c)
+	}
+	if e := events[1]; e.Type != EventDeleted || e.Key != "2" {
+		t.Errorf("Expected deletion of #2, got %+v", e)
+	}
+
+	// #1 is no longer watched
+	events, err = feed.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Expected no events, got %v", events)
+	}
+}
+
+func TestChangeFeedRun(t *testing.T) {
+	s := newFeedServer()
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	store := NewFileCheckpointStore(t.TempDir() + "/checkpoint.json")
+
+	// Record the state, then change an issue while the feed is stopped
+	if _, err := NewChangeFeed(client, &ChangeFeedOptions{Store: store}, WatchIssues(nil)).Poll(context.Background()); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	s.update(func() {
+		s.issues[2] = Issue{ID: 2, Status: Resource{ID: 1}, Subject: "Add CSV export", CreatedOn: feedTime(1), UpdatedOn: feedTime(9)}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := make(chan Event)
+	done := make(chan error, 1)
+	feed := NewChangeFeed(client, &ChangeFeedOptions{Store: store, Interval: 10 * time.Millisecond}, WatchIssues(nil))
+	go func() {
+		done <- feed.Run(ctx, events)
+	}()
+
+	select {
+	case e := <-events:
+		if c, ok := e.Change("subject"); e.Type != EventUpdated || !ok || c.NewValue != "Add CSV export" {
+			t.Errorf("Expected subject change of #2, got %+v", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for an event")
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+// savedSince returns the time the checkpoint in store lists issue changes from.
+func savedSince(t *testing.T, store CheckpointStore) time.Time {
+	t.Helper()
+	cp, err := store.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	for _, state := range cp.Sources {
+		return state.Since.Time
+	}
+	return time.Time{}
+}
+
+func TestChangeFeedRunFunc(t *testing.T) {
+	s := newFeedServer()
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	store := NewMemoryCheckpointStore()
+	if _, err := NewChangeFeed(client, &ChangeFeedOptions{Store: store}, WatchIssues(nil)).Poll(context.Background()); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	s.update(func() {
+		s.issues[2] = Issue{ID: 2, Status: Resource{ID: 1}, Subject: "Add CSV export", CreatedOn: feedTime(1), UpdatedOn: feedTime(9)}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The first handling fails, so the checkpoint is kept and the change found again
+	var calls int
+	var errs []error
+	opts := &ChangeFeedOptions{Store: store, Interval: time.Millisecond, OnError: func(err error) { errs = append(errs, err) }}
+	err := NewChangeFeed(client, opts, WatchIssues(nil)).RunFunc(ctx, func(ctx context.Context, events []Event) error {
+		calls++
+		if len(events) != 1 || events[0].Key != "2" {
+			t.Errorf("Expected the change of #2, got %+v", events)
+		}
+		if calls == 1 {
+			return errors.New("relay unavailable")
+		}
+		if since := savedSince(t, store); !since.Before(feedTime(9).Time) {
+			t.Errorf("Expected the checkpoint to be saved after handling, got since %v", since)
+		}
+		cancel()
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if since := savedSince(t, store); !since.Equal(feedTime(9).Time) {
+		t.Errorf("Expected the checkpoint to be saved since %v, got %v", feedTime(9), since)
+	}
+	if calls != 2 || len(errs) != 1 {
+		t.Errorf("Expected 2 calls and 1 error, got %d and %v", calls, errs)
+	}
+}
+
+func TestChangeFeedOnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+
+	err := NewChangeFeed(client, nil, WatchNews("")).Run(context.Background(), make(chan Event))
+	if !errors.Is(err, ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int
+	opts := &ChangeFeedOptions{Interval: time.Millisecond, OnError: func(err error) {
+		calls++
+		if calls == 3 {
+			cancel()
+		}
+	}}
+	if err := NewChangeFeed(client, opts, WatchNews("")).Run(ctx, make(chan Event)); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 errors, got %d", calls)
+	}
+}
+
+func TestChangeJournalDetail(t *testing.T) {
+	d := Change{Field: "status_id", OldValue: "1", NewValue: "2"}.JournalDetail()
+	if d.Property != JournalPropertyAttr || d.Name != "status_id" || d.OldValue != "1" || d.NewValue != "2" {
+		t.Errorf("Unexpected detail: %+v", d)
+	}
+
+	d = Change{Field: "cf_5", NewValue: "High"}.JournalDetail()
+	if d.Property != JournalPropertyCustomField || d.Name != "5" || d.NewValue != "High" {
+		t.Errorf("Unexpected detail: %+v", d)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -17,8 +18,8 @@ type WikiPage struct {
 	Author      Resource     `json:"author,omitempty"`
 	Parent      Resource     `json:"parent,omitempty"`
 	Comments    string       `json:"comments,omitempty"`
-	CreatedOn   string       `json:"created_on,omitempty"`
-	UpdatedOn   string       `json:"updated_on,omitempty"`
+	CreatedOn   Timestamp    `json:"created_on,omitzero"`
+	UpdatedOn   Timestamp    `json:"updated_on,omitzero"`
 	Attachments []Attachment `json:"attachments,omitempty"`
 }
 
@@ -27,11 +28,11 @@ type WikiPagesResponse struct {
 }
 
 type WikiPageIndex struct {
-	Title     string   `json:"title,omitempty"`
-	Version   int      `json:"version,omitempty"`
-	CreatedOn string   `json:"created_on,omitempty"`
-	UpdatedOn string   `json:"updated_on,omitempty"`
-	Parent    Resource `json:"parent,omitempty"`
+	Title     string    `json:"title,omitempty"`
+	Version   int       `json:"version,omitempty"`
+	CreatedOn Timestamp `json:"created_on,omitzero"`
+	UpdatedOn Timestamp `json:"updated_on,omitzero"`
+	Parent    Resource  `json:"parent,omitempty"`
 }
 
 type WikiPageResponse struct {
@@ -42,11 +43,26 @@ type WikiPageRequest struct {
 	WikiPage WikiPageUpdate `json:"wiki_page"`
 }
 
+// WikiPageUpdate creates or updates a wiki page. ParentTitle moves the page
+// under another page; list "parent_title" in ClearFields to make it a root page.
 type WikiPageUpdate struct {
-	Text     string   `json:"text,omitempty"`
-	Comments string   `json:"comments,omitempty"`
-	Version  int      `json:"version,omitempty"`
-	Uploads  []Upload `json:"uploads,omitempty"`
+	Text        string   `json:"text,omitempty"`
+	Comments    string   `json:"comments,omitempty"`
+	Version     int      `json:"version,omitempty"`
+	ParentTitle string   `json:"parent_title,omitempty"`
+	Uploads     []Upload `json:"uploads,omitempty"`
+
+	// ForceSendFields lists JSON keys that are sent even when their value is zero.
+	ForceSendFields []string `json:"-"`
+	// ClearFields lists JSON keys, such as "parent_title", that are sent as an
+	// empty value to unset them.
+	ClearFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the page, honouring ForceSendFields and ClearFields.
+func (r WikiPageUpdate) MarshalJSON() ([]byte, error) {
+	type request WikiPageUpdate
+	return marshalPatch(request(r), r.ForceSendFields, r.ClearFields)
 }
 
 // ListWikiPages retrieves wiki pages index for a project
@@ -114,7 +130,87 @@ func (c *Client) GetWikiPage(ctx context.Context, projectIDOrIdentifier string,
 	return &result, nil
 }
 
-// CreateOrUpdateWikiPage creates or updates a wiki page
+// GetWikiPageVersion retrieves a specific version of a wiki page
+func (c *Client) GetWikiPageVersion(ctx context.Context, projectIDOrIdentifier string, pageName string, version int) (*WikiPage, error) {
+	result, err := c.GetWikiPage(ctx, projectIDOrIdentifier, pageName, &GetWikiPageOptions{Version: version})
+	if err != nil {
+		return nil, err
+	}
+	return &result.WikiPage, nil
+}
+
+// WikiPageVersion is an entry in the history of a wiki page.
+type WikiPageVersion struct {
+	Version   int       `json:"version"`
+	Author    Resource  `json:"author,omitempty"`
+	Comments  string    `json:"comments,omitempty"`
+	UpdatedOn Timestamp `json:"updated_on,omitzero"`
+}
+
+type ListWikiPageVersionsOptions struct {
+	// Limit caps the number of versions returned, newest first. 0 returns all.
+	Limit int
+}
+
+// ListWikiPageVersions retrieves the history of a wiki page, newest first.
+// Redmine has no history endpoint, so each version is fetched in turn;
+// versions deleted from the history are skipped.
+func (c *Client) ListWikiPageVersions(ctx context.Context, projectIDOrIdentifier string, pageName string, opts *ListWikiPageVersionsOptions) ([]WikiPageVersion, error) {
+	current, err := c.GetWikiPage(ctx, projectIDOrIdentifier, pageName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	page := current.WikiPage
+	versions := []WikiPageVersion{{Version: page.Version, Author: page.Author, Comments: page.Comments, UpdatedOn: page.UpdatedOn}}
+	for v := page.Version - 1; v > 0; v-- {
+		if opts != nil && opts.Limit > 0 && len(versions) >= opts.Limit {
+			break
+		}
+
+		p, err := c.GetWikiPageVersion(ctx, projectIDOrIdentifier, pageName, v)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get version %d: %w", v, err)
+		}
+		versions = append(versions, WikiPageVersion{Version: p.Version, Author: p.Author, Comments: p.Comments, UpdatedOn: p.UpdatedOn})
+	}
+
+	return versions, nil
+}
+
+// DiffWikiPageVersions returns a unified diff of the text of two versions of
+// a wiki page. A version of 0 means the current version. The result is empty
+// when the texts are equal.
+func (c *Client) DiffWikiPageVersions(ctx context.Context, projectIDOrIdentifier string, pageName string, from, to int) (string, error) {
+	get := func(version int) (*WikiPage, error) {
+		if version == 0 {
+			result, err := c.GetWikiPage(ctx, projectIDOrIdentifier, pageName, nil)
+			if err != nil {
+				return nil, err
+			}
+			return &result.WikiPage, nil
+		}
+		return c.GetWikiPageVersion(ctx, projectIDOrIdentifier, pageName, version)
+	}
+
+	a, err := get(from)
+	if err != nil {
+		return "", err
+	}
+	b, err := get(to)
+	if err != nil {
+		return "", err
+	}
+
+	return UnifiedDiff(fmt.Sprintf("%s@%d", pageName, a.Version), fmt.Sprintf("%s@%d", pageName, b.Version), a.Text, b.Text), nil
+}
+
+// CreateOrUpdateWikiPage creates or updates a wiki page. If page.Version is
+// set and the page has been changed since that version, it returns a
+// *ConflictError carrying the current version.
 func (c *Client) CreateOrUpdateWikiPage(ctx context.Context, projectIDOrIdentifier string, pageName string, page WikiPageUpdate) error {
 	endpoint := fmt.Sprintf("%s/projects/%s/wiki/%s.json", c.baseURL, projectIDOrIdentifier, pageName)
 
@@ -125,6 +221,9 @@ func (c *Client) CreateOrUpdateWikiPage(ctx context.Context, projectIDOrIdentifi
 	}
 
 	resp, err := c.do(ctx, http.MethodPut, endpoint, bytes.NewBuffer(jsonData))
+	if errors.Is(err, ErrConflict) {
+		return c.wikiConflict(ctx, projectIDOrIdentifier, pageName, err)
+	}
 	if err != nil {
 		return err
 	}
@@ -132,8 +231,7 @@ func (c *Client) CreateOrUpdateWikiPage(ctx context.Context, projectIDOrIdentifi
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to create/update wiki page: %s", string(body))
+		return fmt.Errorf("failed to create/update wiki page: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -151,8 +249,7 @@ func (c *Client) DeleteWikiPage(ctx context.Context, projectIDOrIdentifier strin
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete wiki page: %s", string(body))
+		return fmt.Errorf("failed to delete wiki page: %w", newAPIError(resp))
 	}
 
 	return nil
@@ -3,9 +3,15 @@ package redmine
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestListWikiPages(t *testing.T) {
@@ -130,3 +136,106 @@ func TestDeleteWikiPage(t *testing.T) {
 		t.Fatalf("DeleteWikiPage failed: %v", err)
 	}
 }
+
+func TestMoveWikiPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		want := `{"wiki_page":{"parent_title":"","text":"Content"}}`
+		if string(body) != want {
+			t.Errorf("Expected body %s, got %s", want, body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	page := WikiPageUpdate{Text: "Content", ClearFields: []string{"parent_title"}}
+	if err := client.CreateOrUpdateWikiPage(context.Background(), "test-project", "Page", page); err != nil {
+		t.Fatalf("CreateOrUpdateWikiPage failed: %v", err)
+	}
+}
+
+// newWikiHistoryServer serves versions 1 to 4 of the page "Guide", where
+// version 2 has been deleted from the history.
+func newWikiHistoryServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	texts := map[int]string{1: "Intro\r\n", 3: "Intro\r\nSetup\r\n", 4: "Intro\r\nInstall\r\n"}
+	page := func(v int) WikiPage {
+		return WikiPage{
+			Title:     "Guide",
+			Text:      texts[v],
+			Version:   v,
+			Author:    Resource{ID: v, Name: "User " + strconv.Itoa(v)},
+			Comments:  "Edit " + strconv.Itoa(v),
+			UpdatedOn: Timestamp{time.Date(2024, 1, v, 0, 0, 0, 0, time.UTC)},
+		}
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := 4
+		if r.URL.Path != "/projects/docs/wiki/Guide.json" {
+			n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/projects/docs/wiki/Guide/"), ".json"))
+			if err != nil {
+				t.Errorf("Unexpected path %s", r.URL.Path)
+			}
+			v = n
+		}
+		if _, ok := texts[v]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(WikiPageResponse{WikiPage: page(v)})
+	}))
+}
+
+func TestListWikiPageVersions(t *testing.T) {
+	server := newWikiHistoryServer(t)
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	versions, err := client.ListWikiPageVersions(context.Background(), "docs", "Guide", nil)
+	if err != nil {
+		t.Fatalf("ListWikiPageVersions failed: %v", err)
+	}
+
+	var got []int
+	for _, v := range versions {
+		got = append(got, v.Version)
+	}
+	if !slices.Equal(got, []int{4, 3, 1}) {
+		t.Errorf("Expected versions [4 3 1], got %v", got)
+	}
+	if versions[1].Author.Name != "User 3" || versions[1].Comments != "Edit 3" {
+		t.Errorf("Expected author User 3 and comments Edit 3, got %+v", versions[1])
+	}
+
+	versions, err = client.ListWikiPageVersions(context.Background(), "docs", "Guide", &ListWikiPageVersionsOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("ListWikiPageVersions failed: %v", err)
+	}
+	if len(versions) != 2 {
+		t.Errorf("Expected 2 versions, got %d", len(versions))
+	}
+}
+
+func TestDiffWikiPageVersions(t *testing.T) {
+	server := newWikiHistoryServer(t)
+	defer server.Close()
+
+	client := New(server.URL, "test-api-key")
+	diff, err := client.DiffWikiPageVersions(context.Background(), "docs", "Guide", 3, 0)
+	if err != nil {
+		t.Fatalf("DiffWikiPageVersions failed: %v", err)
+	}
+
+	want := "--- Guide@3\n+++ Guide@4\n@@ -1,2 +1,2 @@\n Intro\n-Setup\n+Install\n"
+	if diff != want {
+		t.Errorf("Expected diff:\n%s\ngot:\n%s", want, diff)
+	}
+
+	if _, err := client.DiffWikiPageVersions(context.Background(), "docs", "Guide", 2, 4); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
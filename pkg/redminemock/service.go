@@ -0,0 +1,3781 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=../redminemock/service.go -package=redminemock
+//
+
+// Package redminemock is a generated GoMock package.
+package redminemock
+
+import (
+	context "context"
+	io "io"
+	iter "iter"
+	url "net/url"
+	reflect "reflect"
+
+	redmine "github.com/kqns91/redmine-go/pkg/redmine"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIssuesService is a mock of IssuesService interface.
+type MockIssuesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIssuesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockIssuesServiceMockRecorder is the mock recorder for MockIssuesService.
+type MockIssuesServiceMockRecorder struct {
+	mock *MockIssuesService
+}
+
+// NewMockIssuesService creates a new mock instance.
+func NewMockIssuesService(ctrl *gomock.Controller) *MockIssuesService {
+	mock := &MockIssuesService{ctrl: ctrl}
+	mock.recorder = &MockIssuesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIssuesService) EXPECT() *MockIssuesServiceMockRecorder {
+	return m.recorder
+}
+
+// AllIssues mocks base method.
+func (m *MockIssuesService) AllIssues(ctx context.Context, opts *redmine.ListIssuesOptions) iter.Seq2[redmine.Issue, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllIssues", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[redmine.Issue, error])
+	return ret0
+}
+
+// AllIssues indicates an expected call of AllIssues.
+func (mr *MockIssuesServiceMockRecorder) AllIssues(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllIssues", reflect.TypeOf((*MockIssuesService)(nil).AllIssues), ctx, opts)
+}
+
+// CreateIssue mocks base method.
+func (m *MockIssuesService) CreateIssue(ctx context.Context, req redmine.IssueCreateRequest) (*redmine.IssueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssue", ctx, req)
+	ret0, _ := ret[0].(*redmine.IssueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIssue indicates an expected call of CreateIssue.
+func (mr *MockIssuesServiceMockRecorder) CreateIssue(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssue", reflect.TypeOf((*MockIssuesService)(nil).CreateIssue), ctx, req)
+}
+
+// DeleteIssue mocks base method.
+func (m *MockIssuesService) DeleteIssue(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIssue", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIssue indicates an expected call of DeleteIssue.
+func (mr *MockIssuesServiceMockRecorder) DeleteIssue(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIssue", reflect.TypeOf((*MockIssuesService)(nil).DeleteIssue), ctx, id)
+}
+
+// GetIssueHistory mocks base method.
+func (m *MockIssuesService) GetIssueHistory(ctx context.Context, id int) (*redmine.IssueHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIssueHistory", ctx, id)
+	ret0, _ := ret[0].(*redmine.IssueHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssueHistory indicates an expected call of GetIssueHistory.
+func (mr *MockIssuesServiceMockRecorder) GetIssueHistory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssueHistory", reflect.TypeOf((*MockIssuesService)(nil).GetIssueHistory), ctx, id)
+}
+
+// ListAllIssues mocks base method.
+func (m *MockIssuesService) ListAllIssues(ctx context.Context, opts *redmine.ListIssuesOptions) ([]redmine.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllIssues", ctx, opts)
+	ret0, _ := ret[0].([]redmine.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllIssues indicates an expected call of ListAllIssues.
+func (mr *MockIssuesServiceMockRecorder) ListAllIssues(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllIssues", reflect.TypeOf((*MockIssuesService)(nil).ListAllIssues), ctx, opts)
+}
+
+// ListIssues mocks base method.
+func (m *MockIssuesService) ListIssues(ctx context.Context, opts *redmine.ListIssuesOptions) (*redmine.IssuesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssues", ctx, opts)
+	ret0, _ := ret[0].(*redmine.IssuesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssues indicates an expected call of ListIssues.
+func (mr *MockIssuesServiceMockRecorder) ListIssues(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssues", reflect.TypeOf((*MockIssuesService)(nil).ListIssues), ctx, opts)
+}
+
+// ShowIssue mocks base method.
+func (m *MockIssuesService) ShowIssue(ctx context.Context, id int, opts *redmine.ShowIssueOptions) (*redmine.IssueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowIssue", ctx, id, opts)
+	ret0, _ := ret[0].(*redmine.IssueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowIssue indicates an expected call of ShowIssue.
+func (mr *MockIssuesServiceMockRecorder) ShowIssue(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowIssue", reflect.TypeOf((*MockIssuesService)(nil).ShowIssue), ctx, id, opts)
+}
+
+// UpdateIssue mocks base method.
+func (m *MockIssuesService) UpdateIssue(ctx context.Context, id int, req redmine.IssueUpdateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIssue", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIssue indicates an expected call of UpdateIssue.
+func (mr *MockIssuesServiceMockRecorder) UpdateIssue(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIssue", reflect.TypeOf((*MockIssuesService)(nil).UpdateIssue), ctx, id, req)
+}
+
+// UpdateIssueIfUnmodified mocks base method.
+func (m *MockIssuesService) UpdateIssueIfUnmodified(ctx context.Context, id int, lastUpdatedOn redmine.Timestamp, req redmine.IssueUpdateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIssueIfUnmodified", ctx, id, lastUpdatedOn, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIssueIfUnmodified indicates an expected call of UpdateIssueIfUnmodified.
+func (mr *MockIssuesServiceMockRecorder) UpdateIssueIfUnmodified(ctx, id, lastUpdatedOn, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIssueIfUnmodified", reflect.TypeOf((*MockIssuesService)(nil).UpdateIssueIfUnmodified), ctx, id, lastUpdatedOn, req)
+}
+
+// MockWatchersService is a mock of WatchersService interface.
+type MockWatchersService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatchersServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWatchersServiceMockRecorder is the mock recorder for MockWatchersService.
+type MockWatchersServiceMockRecorder struct {
+	mock *MockWatchersService
+}
+
+// NewMockWatchersService creates a new mock instance.
+func NewMockWatchersService(ctrl *gomock.Controller) *MockWatchersService {
+	mock := &MockWatchersService{ctrl: ctrl}
+	mock.recorder = &MockWatchersServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatchersService) EXPECT() *MockWatchersServiceMockRecorder {
+	return m.recorder
+}
+
+// AddWatcher mocks base method.
+func (m *MockWatchersService) AddWatcher(ctx context.Context, issueID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWatcher", ctx, issueID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWatcher indicates an expected call of AddWatcher.
+func (mr *MockWatchersServiceMockRecorder) AddWatcher(ctx, issueID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWatcher", reflect.TypeOf((*MockWatchersService)(nil).AddWatcher), ctx, issueID, userID)
+}
+
+// RemoveWatcher mocks base method.
+func (m *MockWatchersService) RemoveWatcher(ctx context.Context, issueID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWatcher", ctx, issueID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveWatcher indicates an expected call of RemoveWatcher.
+func (mr *MockWatchersServiceMockRecorder) RemoveWatcher(ctx, issueID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWatcher", reflect.TypeOf((*MockWatchersService)(nil).RemoveWatcher), ctx, issueID, userID)
+}
+
+// MockIssueRelationsService is a mock of IssueRelationsService interface.
+type MockIssueRelationsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIssueRelationsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockIssueRelationsServiceMockRecorder is the mock recorder for MockIssueRelationsService.
+type MockIssueRelationsServiceMockRecorder struct {
+	mock *MockIssueRelationsService
+}
+
+// NewMockIssueRelationsService creates a new mock instance.
+func NewMockIssueRelationsService(ctrl *gomock.Controller) *MockIssueRelationsService {
+	mock := &MockIssueRelationsService{ctrl: ctrl}
+	mock.recorder = &MockIssueRelationsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIssueRelationsService) EXPECT() *MockIssueRelationsServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateIssueRelation mocks base method.
+func (m *MockIssueRelationsService) CreateIssueRelation(ctx context.Context, issueID int, relation redmine.IssueRelation) (*redmine.IssueRelationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssueRelation", ctx, issueID, relation)
+	ret0, _ := ret[0].(*redmine.IssueRelationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIssueRelation indicates an expected call of CreateIssueRelation.
+func (mr *MockIssueRelationsServiceMockRecorder) CreateIssueRelation(ctx, issueID, relation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssueRelation", reflect.TypeOf((*MockIssueRelationsService)(nil).CreateIssueRelation), ctx, issueID, relation)
+}
+
+// DeleteIssueRelation mocks base method.
+func (m *MockIssueRelationsService) DeleteIssueRelation(ctx context.Context, relationID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIssueRelation", ctx, relationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIssueRelation indicates an expected call of DeleteIssueRelation.
+func (mr *MockIssueRelationsServiceMockRecorder) DeleteIssueRelation(ctx, relationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIssueRelation", reflect.TypeOf((*MockIssueRelationsService)(nil).DeleteIssueRelation), ctx, relationID)
+}
+
+// ListIssueRelations mocks base method.
+func (m *MockIssueRelationsService) ListIssueRelations(ctx context.Context, issueID int) (*redmine.IssueRelationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssueRelations", ctx, issueID)
+	ret0, _ := ret[0].(*redmine.IssueRelationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssueRelations indicates an expected call of ListIssueRelations.
+func (mr *MockIssueRelationsServiceMockRecorder) ListIssueRelations(ctx, issueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueRelations", reflect.TypeOf((*MockIssueRelationsService)(nil).ListIssueRelations), ctx, issueID)
+}
+
+// ShowIssueRelation mocks base method.
+func (m *MockIssueRelationsService) ShowIssueRelation(ctx context.Context, relationID int) (*redmine.IssueRelationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowIssueRelation", ctx, relationID)
+	ret0, _ := ret[0].(*redmine.IssueRelationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowIssueRelation indicates an expected call of ShowIssueRelation.
+func (mr *MockIssueRelationsServiceMockRecorder) ShowIssueRelation(ctx, relationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowIssueRelation", reflect.TypeOf((*MockIssueRelationsService)(nil).ShowIssueRelation), ctx, relationID)
+}
+
+// MockIssueCategoriesService is a mock of IssueCategoriesService interface.
+type MockIssueCategoriesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIssueCategoriesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockIssueCategoriesServiceMockRecorder is the mock recorder for MockIssueCategoriesService.
+type MockIssueCategoriesServiceMockRecorder struct {
+	mock *MockIssueCategoriesService
+}
+
+// NewMockIssueCategoriesService creates a new mock instance.
+func NewMockIssueCategoriesService(ctrl *gomock.Controller) *MockIssueCategoriesService {
+	mock := &MockIssueCategoriesService{ctrl: ctrl}
+	mock.recorder = &MockIssueCategoriesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIssueCategoriesService) EXPECT() *MockIssueCategoriesServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateIssueCategory mocks base method.
+func (m *MockIssueCategoriesService) CreateIssueCategory(ctx context.Context, projectIDOrIdentifier string, req redmine.IssueCategoryCreateRequest) (*redmine.IssueCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssueCategory", ctx, projectIDOrIdentifier, req)
+	ret0, _ := ret[0].(*redmine.IssueCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIssueCategory indicates an expected call of CreateIssueCategory.
+func (mr *MockIssueCategoriesServiceMockRecorder) CreateIssueCategory(ctx, projectIDOrIdentifier, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssueCategory", reflect.TypeOf((*MockIssueCategoriesService)(nil).CreateIssueCategory), ctx, projectIDOrIdentifier, req)
+}
+
+// DeleteIssueCategory mocks base method.
+func (m *MockIssueCategoriesService) DeleteIssueCategory(ctx context.Context, id int, opts *redmine.DeleteIssueCategoryOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIssueCategory", ctx, id, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIssueCategory indicates an expected call of DeleteIssueCategory.
+func (mr *MockIssueCategoriesServiceMockRecorder) DeleteIssueCategory(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIssueCategory", reflect.TypeOf((*MockIssueCategoriesService)(nil).DeleteIssueCategory), ctx, id, opts)
+}
+
+// ListIssueCategories mocks base method.
+func (m *MockIssueCategoriesService) ListIssueCategories(ctx context.Context, projectIDOrIdentifier string) (*redmine.IssueCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssueCategories", ctx, projectIDOrIdentifier)
+	ret0, _ := ret[0].(*redmine.IssueCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssueCategories indicates an expected call of ListIssueCategories.
+func (mr *MockIssueCategoriesServiceMockRecorder) ListIssueCategories(ctx, projectIDOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueCategories", reflect.TypeOf((*MockIssueCategoriesService)(nil).ListIssueCategories), ctx, projectIDOrIdentifier)
+}
+
+// ShowIssueCategory mocks base method.
+func (m *MockIssueCategoriesService) ShowIssueCategory(ctx context.Context, id int) (*redmine.IssueCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowIssueCategory", ctx, id)
+	ret0, _ := ret[0].(*redmine.IssueCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowIssueCategory indicates an expected call of ShowIssueCategory.
+func (mr *MockIssueCategoriesServiceMockRecorder) ShowIssueCategory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowIssueCategory", reflect.TypeOf((*MockIssueCategoriesService)(nil).ShowIssueCategory), ctx, id)
+}
+
+// UpdateIssueCategory mocks base method.
+func (m *MockIssueCategoriesService) UpdateIssueCategory(ctx context.Context, id int, req redmine.IssueCategoryUpdateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIssueCategory", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIssueCategory indicates an expected call of UpdateIssueCategory.
+func (mr *MockIssueCategoriesServiceMockRecorder) UpdateIssueCategory(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIssueCategory", reflect.TypeOf((*MockIssueCategoriesService)(nil).UpdateIssueCategory), ctx, id, req)
+}
+
+// MockProjectsService is a mock of ProjectsService interface.
+type MockProjectsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectsServiceMockRecorder is the mock recorder for MockProjectsService.
+type MockProjectsServiceMockRecorder struct {
+	mock *MockProjectsService
+}
+
+// NewMockProjectsService creates a new mock instance.
+func NewMockProjectsService(ctrl *gomock.Controller) *MockProjectsService {
+	mock := &MockProjectsService{ctrl: ctrl}
+	mock.recorder = &MockProjectsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectsService) EXPECT() *MockProjectsServiceMockRecorder {
+	return m.recorder
+}
+
+// AllProjects mocks base method.
+func (m *MockProjectsService) AllProjects(ctx context.Context, opts *redmine.ListProjectsOptions) iter.Seq2[redmine.Project, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllProjects", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[redmine.Project, error])
+	return ret0
+}
+
+// AllProjects indicates an expected call of AllProjects.
+func (mr *MockProjectsServiceMockRecorder) AllProjects(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllProjects", reflect.TypeOf((*MockProjectsService)(nil).AllProjects), ctx, opts)
+}
+
+// ArchiveProject mocks base method.
+func (m *MockProjectsService) ArchiveProject(ctx context.Context, idOrIdentifier string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveProject", ctx, idOrIdentifier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveProject indicates an expected call of ArchiveProject.
+func (mr *MockProjectsServiceMockRecorder) ArchiveProject(ctx, idOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveProject", reflect.TypeOf((*MockProjectsService)(nil).ArchiveProject), ctx, idOrIdentifier)
+}
+
+// CreateProject mocks base method.
+func (m *MockProjectsService) CreateProject(ctx context.Context, req redmine.ProjectCreateRequest) (*redmine.ProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", ctx, req)
+	ret0, _ := ret[0].(*redmine.ProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockProjectsServiceMockRecorder) CreateProject(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectsService)(nil).CreateProject), ctx, req)
+}
+
+// DeleteProject mocks base method.
+func (m *MockProjectsService) DeleteProject(ctx context.Context, idOrIdentifier string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", ctx, idOrIdentifier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockProjectsServiceMockRecorder) DeleteProject(ctx, idOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectsService)(nil).DeleteProject), ctx, idOrIdentifier)
+}
+
+// ListAllProjects mocks base method.
+func (m *MockProjectsService) ListAllProjects(ctx context.Context, opts *redmine.ListProjectsOptions) ([]redmine.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllProjects", ctx, opts)
+	ret0, _ := ret[0].([]redmine.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllProjects indicates an expected call of ListAllProjects.
+func (mr *MockProjectsServiceMockRecorder) ListAllProjects(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllProjects", reflect.TypeOf((*MockProjectsService)(nil).ListAllProjects), ctx, opts)
+}
+
+// ListProjects mocks base method.
+func (m *MockProjectsService) ListProjects(ctx context.Context, opts *redmine.ListProjectsOptions) (*redmine.ProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", ctx, opts)
+	ret0, _ := ret[0].(*redmine.ProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockProjectsServiceMockRecorder) ListProjects(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockProjectsService)(nil).ListProjects), ctx, opts)
+}
+
+// ShowProject mocks base method.
+func (m *MockProjectsService) ShowProject(ctx context.Context, idOrIdentifier string, opts *redmine.ShowProjectOptions) (*redmine.ProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowProject", ctx, idOrIdentifier, opts)
+	ret0, _ := ret[0].(*redmine.ProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowProject indicates an expected call of ShowProject.
+func (mr *MockProjectsServiceMockRecorder) ShowProject(ctx, idOrIdentifier, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowProject", reflect.TypeOf((*MockProjectsService)(nil).ShowProject), ctx, idOrIdentifier, opts)
+}
+
+// UnarchiveProject mocks base method.
+func (m *MockProjectsService) UnarchiveProject(ctx context.Context, idOrIdentifier string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveProject", ctx, idOrIdentifier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnarchiveProject indicates an expected call of UnarchiveProject.
+func (mr *MockProjectsServiceMockRecorder) UnarchiveProject(ctx, idOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveProject", reflect.TypeOf((*MockProjectsService)(nil).UnarchiveProject), ctx, idOrIdentifier)
+}
+
+// UpdateProject mocks base method.
+func (m *MockProjectsService) UpdateProject(ctx context.Context, idOrIdentifier string, req redmine.ProjectUpdateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", ctx, idOrIdentifier, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProject indicates an expected call of UpdateProject.
+func (mr *MockProjectsServiceMockRecorder) UpdateProject(ctx, idOrIdentifier, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockProjectsService)(nil).UpdateProject), ctx, idOrIdentifier, req)
+}
+
+// MockVersionsService is a mock of VersionsService interface.
+type MockVersionsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVersionsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockVersionsServiceMockRecorder is the mock recorder for MockVersionsService.
+type MockVersionsServiceMockRecorder struct {
+	mock *MockVersionsService
+}
+
+// NewMockVersionsService creates a new mock instance.
+func NewMockVersionsService(ctrl *gomock.Controller) *MockVersionsService {
+	mock := &MockVersionsService{ctrl: ctrl}
+	mock.recorder = &MockVersionsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVersionsService) EXPECT() *MockVersionsServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateVersion mocks base method.
+func (m *MockVersionsService) CreateVersion(ctx context.Context, projectIDOrIdentifier string, version redmine.Version) (*redmine.VersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVersion", ctx, projectIDOrIdentifier, version)
+	ret0, _ := ret[0].(*redmine.VersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVersion indicates an expected call of CreateVersion.
+func (mr *MockVersionsServiceMockRecorder) CreateVersion(ctx, projectIDOrIdentifier, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVersion", reflect.TypeOf((*MockVersionsService)(nil).CreateVersion), ctx, projectIDOrIdentifier, version)
+}
+
+// DeleteVersion mocks base method.
+func (m *MockVersionsService) DeleteVersion(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVersion", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVersion indicates an expected call of DeleteVersion.
+func (mr *MockVersionsServiceMockRecorder) DeleteVersion(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVersion", reflect.TypeOf((*MockVersionsService)(nil).DeleteVersion), ctx, id)
+}
+
+// ListVersions mocks base method.
+func (m *MockVersionsService) ListVersions(ctx context.Context, projectIDOrIdentifier string) (*redmine.VersionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", ctx, projectIDOrIdentifier)
+	ret0, _ := ret[0].(*redmine.VersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions.
+func (mr *MockVersionsServiceMockRecorder) ListVersions(ctx, projectIDOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockVersionsService)(nil).ListVersions), ctx, projectIDOrIdentifier)
+}
+
+// ShowVersion mocks base method.
+func (m *MockVersionsService) ShowVersion(ctx context.Context, id int) (*redmine.VersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowVersion", ctx, id)
+	ret0, _ := ret[0].(*redmine.VersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowVersion indicates an expected call of ShowVersion.
+func (mr *MockVersionsServiceMockRecorder) ShowVersion(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowVersion", reflect.TypeOf((*MockVersionsService)(nil).ShowVersion), ctx, id)
+}
+
+// UpdateVersion mocks base method.
+func (m *MockVersionsService) UpdateVersion(ctx context.Context, id int, version redmine.Version) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVersion", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVersion indicates an expected call of UpdateVersion.
+func (mr *MockVersionsServiceMockRecorder) UpdateVersion(ctx, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVersion", reflect.TypeOf((*MockVersionsService)(nil).UpdateVersion), ctx, id, version)
+}
+
+// MockMembershipsService is a mock of MembershipsService interface.
+type MockMembershipsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMembershipsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMembershipsServiceMockRecorder is the mock recorder for MockMembershipsService.
+type MockMembershipsServiceMockRecorder struct {
+	mock *MockMembershipsService
+}
+
+// NewMockMembershipsService creates a new mock instance.
+func NewMockMembershipsService(ctrl *gomock.Controller) *MockMembershipsService {
+	mock := &MockMembershipsService{ctrl: ctrl}
+	mock.recorder = &MockMembershipsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMembershipsService) EXPECT() *MockMembershipsServiceMockRecorder {
+	return m.recorder
+}
+
+// AllMemberships mocks base method.
+func (m *MockMembershipsService) AllMemberships(ctx context.Context, projectIDOrIdentifier string) iter.Seq2[redmine.Membership, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllMemberships", ctx, projectIDOrIdentifier)
+	ret0, _ := ret[0].(iter.Seq2[redmine.Membership, error])
+	return ret0
+}
+
+// AllMemberships indicates an expected call of AllMemberships.
+func (mr *MockMembershipsServiceMockRecorder) AllMemberships(ctx, projectIDOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllMemberships", reflect.TypeOf((*MockMembershipsService)(nil).AllMemberships), ctx, projectIDOrIdentifier)
+}
+
+// CreateMembership mocks base method.
+func (m *MockMembershipsService) CreateMembership(ctx context.Context, projectIDOrIdentifier string, membership redmine.MembershipCreateUpdate) (*redmine.MembershipResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMembership", ctx, projectIDOrIdentifier, membership)
+	ret0, _ := ret[0].(*redmine.MembershipResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMembership indicates an expected call of CreateMembership.
+func (mr *MockMembershipsServiceMockRecorder) CreateMembership(ctx, projectIDOrIdentifier, membership any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMembership", reflect.TypeOf((*MockMembershipsService)(nil).CreateMembership), ctx, projectIDOrIdentifier, membership)
+}
+
+// DeleteMembership mocks base method.
+func (m *MockMembershipsService) DeleteMembership(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMembership", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMembership indicates an expected call of DeleteMembership.
+func (mr *MockMembershipsServiceMockRecorder) DeleteMembership(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMembership", reflect.TypeOf((*MockMembershipsService)(nil).DeleteMembership), ctx, id)
+}
+
+// ListAllMemberships mocks base method.
+func (m *MockMembershipsService) ListAllMemberships(ctx context.Context, projectIDOrIdentifier string) ([]redmine.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllMemberships", ctx, projectIDOrIdentifier)
+	ret0, _ := ret[0].([]redmine.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllMemberships indicates an expected call of ListAllMemberships.
+func (mr *MockMembershipsServiceMockRecorder) ListAllMemberships(ctx, projectIDOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllMemberships", reflect.TypeOf((*MockMembershipsService)(nil).ListAllMemberships), ctx, projectIDOrIdentifier)
+}
+
+// ListMemberships mocks base method.
+func (m *MockMembershipsService) ListMemberships(ctx context.Context, projectIDOrIdentifier string) (*redmine.MembershipsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberships", ctx, projectIDOrIdentifier)
+	ret0, _ := ret[0].(*redmine.MembershipsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberships indicates an expected call of ListMemberships.
+func (mr *MockMembershipsServiceMockRecorder) ListMemberships(ctx, projectIDOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberships", reflect.TypeOf((*MockMembershipsService)(nil).ListMemberships), ctx, projectIDOrIdentifier)
+}
+
+// ShowMembership mocks base method.
+func (m *MockMembershipsService) ShowMembership(ctx context.Context, id int) (*redmine.MembershipResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowMembership", ctx, id)
+	ret0, _ := ret[0].(*redmine.MembershipResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowMembership indicates an expected call of ShowMembership.
+func (mr *MockMembershipsServiceMockRecorder) ShowMembership(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowMembership", reflect.TypeOf((*MockMembershipsService)(nil).ShowMembership), ctx, id)
+}
+
+// UpdateMembership mocks base method.
+func (m *MockMembershipsService) UpdateMembership(ctx context.Context, id int, roleIDs []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMembership", ctx, id, roleIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMembership indicates an expected call of UpdateMembership.
+func (mr *MockMembershipsServiceMockRecorder) UpdateMembership(ctx, id, roleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMembership", reflect.TypeOf((*MockMembershipsService)(nil).UpdateMembership), ctx, id, roleIDs)
+}
+
+// MockUsersService is a mock of UsersService interface.
+type MockUsersService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsersServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockUsersServiceMockRecorder is the mock recorder for MockUsersService.
+type MockUsersServiceMockRecorder struct {
+	mock *MockUsersService
+}
+
+// NewMockUsersService creates a new mock instance.
+func NewMockUsersService(ctrl *gomock.Controller) *MockUsersService {
+	mock := &MockUsersService{ctrl: ctrl}
+	mock.recorder = &MockUsersServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsersService) EXPECT() *MockUsersServiceMockRecorder {
+	return m.recorder
+}
+
+// AllUsers mocks base method.
+func (m *MockUsersService) AllUsers(ctx context.Context, opts *redmine.ListUsersOptions) iter.Seq2[redmine.User, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllUsers", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[redmine.User, error])
+	return ret0
+}
+
+// AllUsers indicates an expected call of AllUsers.
+func (mr *MockUsersServiceMockRecorder) AllUsers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllUsers", reflect.TypeOf((*MockUsersService)(nil).AllUsers), ctx, opts)
+}
+
+// CreateUser mocks base method.
+func (m *MockUsersService) CreateUser(ctx context.Context, user redmine.User) (*redmine.UserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
+	ret0, _ := ret[0].(*redmine.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockUsersServiceMockRecorder) CreateUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUsersService)(nil).CreateUser), ctx, user)
+}
+
+// DeleteUser mocks base method.
+func (m *MockUsersService) DeleteUser(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockUsersServiceMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUsersService)(nil).DeleteUser), ctx, id)
+}
+
+// GetCurrentUser mocks base method.
+func (m *MockUsersService) GetCurrentUser(ctx context.Context, opts *redmine.ShowUserOptions) (*redmine.UserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentUser", ctx, opts)
+	ret0, _ := ret[0].(*redmine.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentUser indicates an expected call of GetCurrentUser.
+func (mr *MockUsersServiceMockRecorder) GetCurrentUser(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUser", reflect.TypeOf((*MockUsersService)(nil).GetCurrentUser), ctx, opts)
+}
+
+// ListAllUsers mocks base method.
+func (m *MockUsersService) ListAllUsers(ctx context.Context, opts *redmine.ListUsersOptions) ([]redmine.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllUsers", ctx, opts)
+	ret0, _ := ret[0].([]redmine.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllUsers indicates an expected call of ListAllUsers.
+func (mr *MockUsersServiceMockRecorder) ListAllUsers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllUsers", reflect.TypeOf((*MockUsersService)(nil).ListAllUsers), ctx, opts)
+}
+
+// ListUsers mocks base method.
+func (m *MockUsersService) ListUsers(ctx context.Context, opts *redmine.ListUsersOptions) (*redmine.UsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, opts)
+	ret0, _ := ret[0].(*redmine.UsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockUsersServiceMockRecorder) ListUsers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUsersService)(nil).ListUsers), ctx, opts)
+}
+
+// ShowUser mocks base method.
+func (m *MockUsersService) ShowUser(ctx context.Context, id int, opts *redmine.ShowUserOptions) (*redmine.UserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowUser", ctx, id, opts)
+	ret0, _ := ret[0].(*redmine.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowUser indicates an expected call of ShowUser.
+func (mr *MockUsersServiceMockRecorder) ShowUser(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowUser", reflect.TypeOf((*MockUsersService)(nil).ShowUser), ctx, id, opts)
+}
+
+// UpdateUser mocks base method.
+func (m *MockUsersService) UpdateUser(ctx context.Context, id int, user redmine.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, id, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockUsersServiceMockRecorder) UpdateUser(ctx, id, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUsersService)(nil).UpdateUser), ctx, id, user)
+}
+
+// MockGroupsService is a mock of GroupsService interface.
+type MockGroupsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockGroupsServiceMockRecorder is the mock recorder for MockGroupsService.
+type MockGroupsServiceMockRecorder struct {
+	mock *MockGroupsService
+}
+
+// NewMockGroupsService creates a new mock instance.
+func NewMockGroupsService(ctrl *gomock.Controller) *MockGroupsService {
+	mock := &MockGroupsService{ctrl: ctrl}
+	mock.recorder = &MockGroupsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupsService) EXPECT() *MockGroupsServiceMockRecorder {
+	return m.recorder
+}
+
+// AddUserToGroup mocks base method.
+func (m *MockGroupsService) AddUserToGroup(ctx context.Context, groupID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserToGroup", ctx, groupID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUserToGroup indicates an expected call of AddUserToGroup.
+func (mr *MockGroupsServiceMockRecorder) AddUserToGroup(ctx, groupID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToGroup", reflect.TypeOf((*MockGroupsService)(nil).AddUserToGroup), ctx, groupID, userID)
+}
+
+// CreateGroup mocks base method.
+func (m *MockGroupsService) CreateGroup(ctx context.Context, group redmine.Group) (*redmine.GroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroup", ctx, group)
+	ret0, _ := ret[0].(*redmine.GroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroup indicates an expected call of CreateGroup.
+func (mr *MockGroupsServiceMockRecorder) CreateGroup(ctx, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockGroupsService)(nil).CreateGroup), ctx, group)
+}
+
+// DeleteGroup mocks base method.
+func (m *MockGroupsService) DeleteGroup(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroup", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroup indicates an expected call of DeleteGroup.
+func (mr *MockGroupsServiceMockRecorder) DeleteGroup(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockGroupsService)(nil).DeleteGroup), ctx, id)
+}
+
+// ListGroups mocks base method.
+func (m *MockGroupsService) ListGroups(ctx context.Context, opts *redmine.ListGroupsOptions) (*redmine.GroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroups", ctx, opts)
+	ret0, _ := ret[0].(*redmine.GroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroups indicates an expected call of ListGroups.
+func (mr *MockGroupsServiceMockRecorder) ListGroups(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroups", reflect.TypeOf((*MockGroupsService)(nil).ListGroups), ctx, opts)
+}
+
+// RemoveUserFromGroup mocks base method.
+func (m *MockGroupsService) RemoveUserFromGroup(ctx context.Context, groupID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserFromGroup", ctx, groupID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUserFromGroup indicates an expected call of RemoveUserFromGroup.
+func (mr *MockGroupsServiceMockRecorder) RemoveUserFromGroup(ctx, groupID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserFromGroup", reflect.TypeOf((*MockGroupsService)(nil).RemoveUserFromGroup), ctx, groupID, userID)
+}
+
+// ShowGroup mocks base method.
+func (m *MockGroupsService) ShowGroup(ctx context.Context, id int, opts *redmine.ShowGroupOptions) (*redmine.GroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowGroup", ctx, id, opts)
+	ret0, _ := ret[0].(*redmine.GroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowGroup indicates an expected call of ShowGroup.
+func (mr *MockGroupsServiceMockRecorder) ShowGroup(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowGroup", reflect.TypeOf((*MockGroupsService)(nil).ShowGroup), ctx, id, opts)
+}
+
+// UpdateGroup mocks base method.
+func (m *MockGroupsService) UpdateGroup(ctx context.Context, id int, group redmine.Group) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroup", ctx, id, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGroup indicates an expected call of UpdateGroup.
+func (mr *MockGroupsServiceMockRecorder) UpdateGroup(ctx, id, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroup", reflect.TypeOf((*MockGroupsService)(nil).UpdateGroup), ctx, id, group)
+}
+
+// MockMyAccountService is a mock of MyAccountService interface.
+type MockMyAccountService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMyAccountServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMyAccountServiceMockRecorder is the mock recorder for MockMyAccountService.
+type MockMyAccountServiceMockRecorder struct {
+	mock *MockMyAccountService
+}
+
+// NewMockMyAccountService creates a new mock instance.
+func NewMockMyAccountService(ctrl *gomock.Controller) *MockMyAccountService {
+	mock := &MockMyAccountService{ctrl: ctrl}
+	mock.recorder = &MockMyAccountServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMyAccountService) EXPECT() *MockMyAccountServiceMockRecorder {
+	return m.recorder
+}
+
+// GetMyAccount mocks base method.
+func (m *MockMyAccountService) GetMyAccount(ctx context.Context) (*redmine.MyAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMyAccount", ctx)
+	ret0, _ := ret[0].(*redmine.MyAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMyAccount indicates an expected call of GetMyAccount.
+func (mr *MockMyAccountServiceMockRecorder) GetMyAccount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyAccount", reflect.TypeOf((*MockMyAccountService)(nil).GetMyAccount), ctx)
+}
+
+// UpdateMyAccount mocks base method.
+func (m *MockMyAccountService) UpdateMyAccount(ctx context.Context, user redmine.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMyAccount", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMyAccount indicates an expected call of UpdateMyAccount.
+func (mr *MockMyAccountServiceMockRecorder) UpdateMyAccount(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMyAccount", reflect.TypeOf((*MockMyAccountService)(nil).UpdateMyAccount), ctx, user)
+}
+
+// MockTimeEntriesService is a mock of TimeEntriesService interface.
+type MockTimeEntriesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeEntriesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTimeEntriesServiceMockRecorder is the mock recorder for MockTimeEntriesService.
+type MockTimeEntriesServiceMockRecorder struct {
+	mock *MockTimeEntriesService
+}
+
+// NewMockTimeEntriesService creates a new mock instance.
+func NewMockTimeEntriesService(ctrl *gomock.Controller) *MockTimeEntriesService {
+	mock := &MockTimeEntriesService{ctrl: ctrl}
+	mock.recorder = &MockTimeEntriesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeEntriesService) EXPECT() *MockTimeEntriesServiceMockRecorder {
+	return m.recorder
+}
+
+// AllTimeEntries mocks base method.
+func (m *MockTimeEntriesService) AllTimeEntries(ctx context.Context, opts *redmine.ListTimeEntriesOptions) iter.Seq2[redmine.TimeEntry, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllTimeEntries", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[redmine.TimeEntry, error])
+	return ret0
+}
+
+// AllTimeEntries indicates an expected call of AllTimeEntries.
+func (mr *MockTimeEntriesServiceMockRecorder) AllTimeEntries(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllTimeEntries", reflect.TypeOf((*MockTimeEntriesService)(nil).AllTimeEntries), ctx, opts)
+}
+
+// CreateTimeEntry mocks base method.
+func (m *MockTimeEntriesService) CreateTimeEntry(ctx context.Context, req redmine.TimeEntryCreateRequest) (*redmine.TimeEntryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTimeEntry", ctx, req)
+	ret0, _ := ret[0].(*redmine.TimeEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTimeEntry indicates an expected call of CreateTimeEntry.
+func (mr *MockTimeEntriesServiceMockRecorder) CreateTimeEntry(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTimeEntry", reflect.TypeOf((*MockTimeEntriesService)(nil).CreateTimeEntry), ctx, req)
+}
+
+// DeleteTimeEntry mocks base method.
+func (m *MockTimeEntriesService) DeleteTimeEntry(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTimeEntry", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTimeEntry indicates an expected call of DeleteTimeEntry.
+func (mr *MockTimeEntriesServiceMockRecorder) DeleteTimeEntry(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTimeEntry", reflect.TypeOf((*MockTimeEntriesService)(nil).DeleteTimeEntry), ctx, id)
+}
+
+// ListAllTimeEntries mocks base method.
+func (m *MockTimeEntriesService) ListAllTimeEntries(ctx context.Context, opts *redmine.ListTimeEntriesOptions) ([]redmine.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllTimeEntries", ctx, opts)
+	ret0, _ := ret[0].([]redmine.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllTimeEntries indicates an expected call of ListAllTimeEntries.
+func (mr *MockTimeEntriesServiceMockRecorder) ListAllTimeEntries(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllTimeEntries", reflect.TypeOf((*MockTimeEntriesService)(nil).ListAllTimeEntries), ctx, opts)
+}
+
+// ListTimeEntries mocks base method.
+func (m *MockTimeEntriesService) ListTimeEntries(ctx context.Context, opts *redmine.ListTimeEntriesOptions) (*redmine.TimeEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTimeEntries", ctx, opts)
+	ret0, _ := ret[0].(*redmine.TimeEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTimeEntries indicates an expected call of ListTimeEntries.
+func (mr *MockTimeEntriesServiceMockRecorder) ListTimeEntries(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTimeEntries", reflect.TypeOf((*MockTimeEntriesService)(nil).ListTimeEntries), ctx, opts)
+}
+
+// ShowTimeEntry mocks base method.
+func (m *MockTimeEntriesService) ShowTimeEntry(ctx context.Context, id int) (*redmine.TimeEntryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowTimeEntry", ctx, id)
+	ret0, _ := ret[0].(*redmine.TimeEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowTimeEntry indicates an expected call of ShowTimeEntry.
+func (mr *MockTimeEntriesServiceMockRecorder) ShowTimeEntry(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowTimeEntry", reflect.TypeOf((*MockTimeEntriesService)(nil).ShowTimeEntry), ctx, id)
+}
+
+// UpdateTimeEntry mocks base method.
+func (m *MockTimeEntriesService) UpdateTimeEntry(ctx context.Context, id int, req redmine.TimeEntryUpdateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimeEntry", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTimeEntry indicates an expected call of UpdateTimeEntry.
+func (mr *MockTimeEntriesServiceMockRecorder) UpdateTimeEntry(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimeEntry", reflect.TypeOf((*MockTimeEntriesService)(nil).UpdateTimeEntry), ctx, id, req)
+}
+
+// MockAttachmentsService is a mock of AttachmentsService interface.
+type MockAttachmentsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentsServiceMockRecorder is the mock recorder for MockAttachmentsService.
+type MockAttachmentsServiceMockRecorder struct {
+	mock *MockAttachmentsService
+}
+
+// NewMockAttachmentsService creates a new mock instance.
+func NewMockAttachmentsService(ctrl *gomock.Controller) *MockAttachmentsService {
+	mock := &MockAttachmentsService{ctrl: ctrl}
+	mock.recorder = &MockAttachmentsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentsService) EXPECT() *MockAttachmentsServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockAttachmentsService) DeleteAttachment(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockAttachmentsServiceMockRecorder) DeleteAttachment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockAttachmentsService)(nil).DeleteAttachment), ctx, id)
+}
+
+// DownloadAttachment mocks base method.
+func (m *MockAttachmentsService) DownloadAttachment(ctx context.Context, id int, w io.Writer) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadAttachment", ctx, id, w)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadAttachment indicates an expected call of DownloadAttachment.
+func (mr *MockAttachmentsServiceMockRecorder) DownloadAttachment(ctx, id, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadAttachment", reflect.TypeOf((*MockAttachmentsService)(nil).DownloadAttachment), ctx, id, w)
+}
+
+// DownloadAttachmentWithOptions mocks base method.
+func (m *MockAttachmentsService) DownloadAttachmentWithOptions(ctx context.Context, id int, w io.Writer, opts *redmine.DownloadOptions) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadAttachmentWithOptions", ctx, id, w, opts)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadAttachmentWithOptions indicates an expected call of DownloadAttachmentWithOptions.
+func (mr *MockAttachmentsServiceMockRecorder) DownloadAttachmentWithOptions(ctx, id, w, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadAttachmentWithOptions", reflect.TypeOf((*MockAttachmentsService)(nil).DownloadAttachmentWithOptions), ctx, id, w, opts)
+}
+
+// DownloadThumbnail mocks base method.
+func (m *MockAttachmentsService) DownloadThumbnail(ctx context.Context, id, size int, w io.Writer) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadThumbnail", ctx, id, size, w)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadThumbnail indicates an expected call of DownloadThumbnail.
+func (mr *MockAttachmentsServiceMockRecorder) DownloadThumbnail(ctx, id, size, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadThumbnail", reflect.TypeOf((*MockAttachmentsService)(nil).DownloadThumbnail), ctx, id, size, w)
+}
+
+// ShowAttachment mocks base method.
+func (m *MockAttachmentsService) ShowAttachment(ctx context.Context, id int) (*redmine.AttachmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowAttachment", ctx, id)
+	ret0, _ := ret[0].(*redmine.AttachmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowAttachment indicates an expected call of ShowAttachment.
+func (mr *MockAttachmentsServiceMockRecorder) ShowAttachment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowAttachment", reflect.TypeOf((*MockAttachmentsService)(nil).ShowAttachment), ctx, id)
+}
+
+// UpdateAttachment mocks base method.
+func (m *MockAttachmentsService) UpdateAttachment(ctx context.Context, id int, attachment redmine.Attachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttachment", ctx, id, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttachment indicates an expected call of UpdateAttachment.
+func (mr *MockAttachmentsServiceMockRecorder) UpdateAttachment(ctx, id, attachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttachment", reflect.TypeOf((*MockAttachmentsService)(nil).UpdateAttachment), ctx, id, attachment)
+}
+
+// Upload mocks base method.
+func (m *MockAttachmentsService) Upload(ctx context.Context, filename string, r io.Reader, size int64) (*redmine.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, filename, r, size)
+	ret0, _ := ret[0].(*redmine.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockAttachmentsServiceMockRecorder) Upload(ctx, filename, r, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockAttachmentsService)(nil).Upload), ctx, filename, r, size)
+}
+
+// UploadWithOptions mocks base method.
+func (m *MockAttachmentsService) UploadWithOptions(ctx context.Context, filename string, r io.Reader, size int64, opts *redmine.UploadOptions) (*redmine.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadWithOptions", ctx, filename, r, size, opts)
+	ret0, _ := ret[0].(*redmine.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadWithOptions indicates an expected call of UploadWithOptions.
+func (mr *MockAttachmentsServiceMockRecorder) UploadWithOptions(ctx, filename, r, size, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadWithOptions", reflect.TypeOf((*MockAttachmentsService)(nil).UploadWithOptions), ctx, filename, r, size, opts)
+}
+
+// MockFilesService is a mock of FilesService interface.
+type MockFilesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFilesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockFilesServiceMockRecorder is the mock recorder for MockFilesService.
+type MockFilesServiceMockRecorder struct {
+	mock *MockFilesService
+}
+
+// NewMockFilesService creates a new mock instance.
+func NewMockFilesService(ctrl *gomock.Controller) *MockFilesService {
+	mock := &MockFilesService{ctrl: ctrl}
+	mock.recorder = &MockFilesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFilesService) EXPECT() *MockFilesServiceMockRecorder {
+	return m.recorder
+}
+
+// DownloadFile mocks base method.
+func (m *MockFilesService) DownloadFile(ctx context.Context, file redmine.File, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadFile", ctx, file, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadFile indicates an expected call of DownloadFile.
+func (mr *MockFilesServiceMockRecorder) DownloadFile(ctx, file, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadFile", reflect.TypeOf((*MockFilesService)(nil).DownloadFile), ctx, file, w)
+}
+
+// DownloadFileToPath mocks base method.
+func (m *MockFilesService) DownloadFileToPath(ctx context.Context, file redmine.File, path string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadFileToPath", ctx, file, path)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadFileToPath indicates an expected call of DownloadFileToPath.
+func (mr *MockFilesServiceMockRecorder) DownloadFileToPath(ctx, file, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadFileToPath", reflect.TypeOf((*MockFilesService)(nil).DownloadFileToPath), ctx, file, path)
+}
+
+// ListFiles mocks base method.
+func (m *MockFilesService) ListFiles(ctx context.Context, projectIDOrIdentifier string) (*redmine.FilesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFiles", ctx, projectIDOrIdentifier)
+	ret0, _ := ret[0].(*redmine.FilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFiles indicates an expected call of ListFiles.
+func (mr *MockFilesServiceMockRecorder) ListFiles(ctx, projectIDOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockFilesService)(nil).ListFiles), ctx, projectIDOrIdentifier)
+}
+
+// UploadFile mocks base method.
+func (m *MockFilesService) UploadFile(ctx context.Context, projectIDOrIdentifier string, fileUpload redmine.FileUpload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadFile", ctx, projectIDOrIdentifier, fileUpload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadFile indicates an expected call of UploadFile.
+func (mr *MockFilesServiceMockRecorder) UploadFile(ctx, projectIDOrIdentifier, fileUpload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*MockFilesService)(nil).UploadFile), ctx, projectIDOrIdentifier, fileUpload)
+}
+
+// MockWikiService is a mock of WikiService interface.
+type MockWikiService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWikiServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWikiServiceMockRecorder is the mock recorder for MockWikiService.
+type MockWikiServiceMockRecorder struct {
+	mock *MockWikiService
+}
+
+// NewMockWikiService creates a new mock instance.
+func NewMockWikiService(ctrl *gomock.Controller) *MockWikiService {
+	mock := &MockWikiService{ctrl: ctrl}
+	mock.recorder = &MockWikiServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWikiService) EXPECT() *MockWikiServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdateWikiPage mocks base method.
+func (m *MockWikiService) CreateOrUpdateWikiPage(ctx context.Context, projectIDOrIdentifier, pageName string, page redmine.WikiPageUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateWikiPage", ctx, projectIDOrIdentifier, pageName, page)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateWikiPage indicates an expected call of CreateOrUpdateWikiPage.
+func (mr *MockWikiServiceMockRecorder) CreateOrUpdateWikiPage(ctx, projectIDOrIdentifier, pageName, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateWikiPage", reflect.TypeOf((*MockWikiService)(nil).CreateOrUpdateWikiPage), ctx, projectIDOrIdentifier, pageName, page)
+}
+
+// DeleteWikiPage mocks base method.
+func (m *MockWikiService) DeleteWikiPage(ctx context.Context, projectIDOrIdentifier, pageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWikiPage", ctx, projectIDOrIdentifier, pageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWikiPage indicates an expected call of DeleteWikiPage.
+func (mr *MockWikiServiceMockRecorder) DeleteWikiPage(ctx, projectIDOrIdentifier, pageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWikiPage", reflect.TypeOf((*MockWikiService)(nil).DeleteWikiPage), ctx, projectIDOrIdentifier, pageName)
+}
+
+// DiffWikiPageVersions mocks base method.
+func (m *MockWikiService) DiffWikiPageVersions(ctx context.Context, projectIDOrIdentifier, pageName string, from, to int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffWikiPageVersions", ctx, projectIDOrIdentifier, pageName, from, to)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffWikiPageVersions indicates an expected call of DiffWikiPageVersions.
+func (mr *MockWikiServiceMockRecorder) DiffWikiPageVersions(ctx, projectIDOrIdentifier, pageName, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffWikiPageVersions", reflect.TypeOf((*MockWikiService)(nil).DiffWikiPageVersions), ctx, projectIDOrIdentifier, pageName, from, to)
+}
+
+// GetWikiPage mocks base method.
+func (m *MockWikiService) GetWikiPage(ctx context.Context, projectIDOrIdentifier, pageName string, opts *redmine.GetWikiPageOptions) (*redmine.WikiPageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWikiPage", ctx, projectIDOrIdentifier, pageName, opts)
+	ret0, _ := ret[0].(*redmine.WikiPageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWikiPage indicates an expected call of GetWikiPage.
+func (mr *MockWikiServiceMockRecorder) GetWikiPage(ctx, projectIDOrIdentifier, pageName, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWikiPage", reflect.TypeOf((*MockWikiService)(nil).GetWikiPage), ctx, projectIDOrIdentifier, pageName, opts)
+}
+
+// GetWikiPageVersion mocks base method.
+func (m *MockWikiService) GetWikiPageVersion(ctx context.Context, projectIDOrIdentifier, pageName string, version int) (*redmine.WikiPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWikiPageVersion", ctx, projectIDOrIdentifier, pageName, version)
+	ret0, _ := ret[0].(*redmine.WikiPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWikiPageVersion indicates an expected call of GetWikiPageVersion.
+func (mr *MockWikiServiceMockRecorder) GetWikiPageVersion(ctx, projectIDOrIdentifier, pageName, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWikiPageVersion", reflect.TypeOf((*MockWikiService)(nil).GetWikiPageVersion), ctx, projectIDOrIdentifier, pageName, version)
+}
+
+// ListWikiPageVersions mocks base method.
+func (m *MockWikiService) ListWikiPageVersions(ctx context.Context, projectIDOrIdentifier, pageName string, opts *redmine.ListWikiPageVersionsOptions) ([]redmine.WikiPageVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWikiPageVersions", ctx, projectIDOrIdentifier, pageName, opts)
+	ret0, _ := ret[0].([]redmine.WikiPageVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWikiPageVersions indicates an expected call of ListWikiPageVersions.
+func (mr *MockWikiServiceMockRecorder) ListWikiPageVersions(ctx, projectIDOrIdentifier, pageName, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWikiPageVersions", reflect.TypeOf((*MockWikiService)(nil).ListWikiPageVersions), ctx, projectIDOrIdentifier, pageName, opts)
+}
+
+// ListWikiPages mocks base method.
+func (m *MockWikiService) ListWikiPages(ctx context.Context, projectIDOrIdentifier string) (*redmine.WikiPagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWikiPages", ctx, projectIDOrIdentifier)
+	ret0, _ := ret[0].(*redmine.WikiPagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWikiPages indicates an expected call of ListWikiPages.
+func (mr *MockWikiServiceMockRecorder) ListWikiPages(ctx, projectIDOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWikiPages", reflect.TypeOf((*MockWikiService)(nil).ListWikiPages), ctx, projectIDOrIdentifier)
+}
+
+// MockNewsService is a mock of NewsService interface.
+type MockNewsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockNewsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockNewsServiceMockRecorder is the mock recorder for MockNewsService.
+type MockNewsServiceMockRecorder struct {
+	mock *MockNewsService
+}
+
+// NewMockNewsService creates a new mock instance.
+func NewMockNewsService(ctrl *gomock.Controller) *MockNewsService {
+	mock := &MockNewsService{ctrl: ctrl}
+	mock.recorder = &MockNewsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNewsService) EXPECT() *MockNewsServiceMockRecorder {
+	return m.recorder
+}
+
+// AllNews mocks base method.
+func (m *MockNewsService) AllNews(ctx context.Context, opts *redmine.ListNewsOptions) iter.Seq2[redmine.News, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllNews", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[redmine.News, error])
+	return ret0
+}
+
+// AllNews indicates an expected call of AllNews.
+func (mr *MockNewsServiceMockRecorder) AllNews(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllNews", reflect.TypeOf((*MockNewsService)(nil).AllNews), ctx, opts)
+}
+
+// AllProjectNews mocks base method.
+func (m *MockNewsService) AllProjectNews(ctx context.Context, projectIDOrIdentifier string, opts *redmine.ListNewsOptions) iter.Seq2[redmine.News, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllProjectNews", ctx, projectIDOrIdentifier, opts)
+	ret0, _ := ret[0].(iter.Seq2[redmine.News, error])
+	return ret0
+}
+
+// AllProjectNews indicates an expected call of AllProjectNews.
+func (mr *MockNewsServiceMockRecorder) AllProjectNews(ctx, projectIDOrIdentifier, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllProjectNews", reflect.TypeOf((*MockNewsService)(nil).AllProjectNews), ctx, projectIDOrIdentifier, opts)
+}
+
+// ListAllNews mocks base method.
+func (m *MockNewsService) ListAllNews(ctx context.Context, opts *redmine.ListNewsOptions) ([]redmine.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllNews", ctx, opts)
+	ret0, _ := ret[0].([]redmine.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllNews indicates an expected call of ListAllNews.
+func (mr *MockNewsServiceMockRecorder) ListAllNews(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllNews", reflect.TypeOf((*MockNewsService)(nil).ListAllNews), ctx, opts)
+}
+
+// ListAllProjectNews mocks base method.
+func (m *MockNewsService) ListAllProjectNews(ctx context.Context, projectIDOrIdentifier string, opts *redmine.ListNewsOptions) ([]redmine.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllProjectNews", ctx, projectIDOrIdentifier, opts)
+	ret0, _ := ret[0].([]redmine.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllProjectNews indicates an expected call of ListAllProjectNews.
+func (mr *MockNewsServiceMockRecorder) ListAllProjectNews(ctx, projectIDOrIdentifier, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllProjectNews", reflect.TypeOf((*MockNewsService)(nil).ListAllProjectNews), ctx, projectIDOrIdentifier, opts)
+}
+
+// ListNews mocks base method.
+func (m *MockNewsService) ListNews(ctx context.Context, opts *redmine.ListNewsOptions) (*redmine.NewsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNews", ctx, opts)
+	ret0, _ := ret[0].(*redmine.NewsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNews indicates an expected call of ListNews.
+func (mr *MockNewsServiceMockRecorder) ListNews(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNews", reflect.TypeOf((*MockNewsService)(nil).ListNews), ctx, opts)
+}
+
+// ListProjectNews mocks base method.
+func (m *MockNewsService) ListProjectNews(ctx context.Context, projectIDOrIdentifier string, opts *redmine.ListNewsOptions) (*redmine.NewsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectNews", ctx, projectIDOrIdentifier, opts)
+	ret0, _ := ret[0].(*redmine.NewsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectNews indicates an expected call of ListProjectNews.
+func (mr *MockNewsServiceMockRecorder) ListProjectNews(ctx, projectIDOrIdentifier, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectNews", reflect.TypeOf((*MockNewsService)(nil).ListProjectNews), ctx, projectIDOrIdentifier, opts)
+}
+
+// MockQueriesService is a mock of QueriesService interface.
+type MockQueriesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockQueriesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockQueriesServiceMockRecorder is the mock recorder for MockQueriesService.
+type MockQueriesServiceMockRecorder struct {
+	mock *MockQueriesService
+}
+
+// NewMockQueriesService creates a new mock instance.
+func NewMockQueriesService(ctrl *gomock.Controller) *MockQueriesService {
+	mock := &MockQueriesService{ctrl: ctrl}
+	mock.recorder = &MockQueriesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueriesService) EXPECT() *MockQueriesServiceMockRecorder {
+	return m.recorder
+}
+
+// ListAllIssuesByQuery mocks base method.
+func (m *MockQueriesService) ListAllIssuesByQuery(ctx context.Context, query redmine.Query, opts *redmine.ListIssuesOptions) ([]redmine.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllIssuesByQuery", ctx, query, opts)
+	ret0, _ := ret[0].([]redmine.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllIssuesByQuery indicates an expected call of ListAllIssuesByQuery.
+func (mr *MockQueriesServiceMockRecorder) ListAllIssuesByQuery(ctx, query, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllIssuesByQuery", reflect.TypeOf((*MockQueriesService)(nil).ListAllIssuesByQuery), ctx, query, opts)
+}
+
+// ListIssuesByQuery mocks base method.
+func (m *MockQueriesService) ListIssuesByQuery(ctx context.Context, query redmine.Query, opts *redmine.ListIssuesOptions) (*redmine.IssuesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssuesByQuery", ctx, query, opts)
+	ret0, _ := ret[0].(*redmine.IssuesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssuesByQuery indicates an expected call of ListIssuesByQuery.
+func (mr *MockQueriesServiceMockRecorder) ListIssuesByQuery(ctx, query, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssuesByQuery", reflect.TypeOf((*MockQueriesService)(nil).ListIssuesByQuery), ctx, query, opts)
+}
+
+// ListQueries mocks base method.
+func (m *MockQueriesService) ListQueries(ctx context.Context) (*redmine.QueriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueries", ctx)
+	ret0, _ := ret[0].(*redmine.QueriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueries indicates an expected call of ListQueries.
+func (mr *MockQueriesServiceMockRecorder) ListQueries(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueries", reflect.TypeOf((*MockQueriesService)(nil).ListQueries), ctx)
+}
+
+// MockSearchService is a mock of SearchService interface.
+type MockSearchService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSearchServiceMockRecorder is the mock recorder for MockSearchService.
+type MockSearchServiceMockRecorder struct {
+	mock *MockSearchService
+}
+
+// NewMockSearchService creates a new mock instance.
+func NewMockSearchService(ctrl *gomock.Controller) *MockSearchService {
+	mock := &MockSearchService{ctrl: ctrl}
+	mock.recorder = &MockSearchServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchService) EXPECT() *MockSearchServiceMockRecorder {
+	return m.recorder
+}
+
+// AllSearchResults mocks base method.
+func (m *MockSearchService) AllSearchResults(ctx context.Context, opts *redmine.SearchOptions) iter.Seq2[redmine.SearchResult, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllSearchResults", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[redmine.SearchResult, error])
+	return ret0
+}
+
+// AllSearchResults indicates an expected call of AllSearchResults.
+func (mr *MockSearchServiceMockRecorder) AllSearchResults(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllSearchResults", reflect.TypeOf((*MockSearchService)(nil).AllSearchResults), ctx, opts)
+}
+
+// ListAllSearchResults mocks base method.
+func (m *MockSearchService) ListAllSearchResults(ctx context.Context, opts *redmine.SearchOptions) ([]redmine.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllSearchResults", ctx, opts)
+	ret0, _ := ret[0].([]redmine.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllSearchResults indicates an expected call of ListAllSearchResults.
+func (mr *MockSearchServiceMockRecorder) ListAllSearchResults(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllSearchResults", reflect.TypeOf((*MockSearchService)(nil).ListAllSearchResults), ctx, opts)
+}
+
+// Search mocks base method.
+func (m *MockSearchService) Search(ctx context.Context, opts *redmine.SearchOptions) (*redmine.SearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, opts)
+	ret0, _ := ret[0].(*redmine.SearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearchServiceMockRecorder) Search(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearchService)(nil).Search), ctx, opts)
+}
+
+// SearchSimple mocks base method.
+func (m *MockSearchService) SearchSimple(ctx context.Context, query string) (*redmine.SearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSimple", ctx, query)
+	ret0, _ := ret[0].(*redmine.SearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSimple indicates an expected call of SearchSimple.
+func (mr *MockSearchServiceMockRecorder) SearchSimple(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSimple", reflect.TypeOf((*MockSearchService)(nil).SearchSimple), ctx, query)
+}
+
+// MockActivityService is a mock of ActivityService interface.
+type MockActivityService struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityServiceMockRecorder is the mock recorder for MockActivityService.
+type MockActivityServiceMockRecorder struct {
+	mock *MockActivityService
+}
+
+// NewMockActivityService creates a new mock instance.
+func NewMockActivityService(ctrl *gomock.Controller) *MockActivityService {
+	mock := &MockActivityService{ctrl: ctrl}
+	mock.recorder = &MockActivityServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityService) EXPECT() *MockActivityServiceMockRecorder {
+	return m.recorder
+}
+
+// GetFeed mocks base method.
+func (m *MockActivityService) GetFeed(ctx context.Context, path string, params url.Values) (*redmine.ActivityFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, path, params)
+	ret0, _ := ret[0].(*redmine.ActivityFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockActivityServiceMockRecorder) GetFeed(ctx, path, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockActivityService)(nil).GetFeed), ctx, path, params)
+}
+
+// ListActivity mocks base method.
+func (m *MockActivityService) ListActivity(ctx context.Context, opts *redmine.ListActivityOptions) ([]redmine.ActivityEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivity", ctx, opts)
+	ret0, _ := ret[0].([]redmine.ActivityEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActivity indicates an expected call of ListActivity.
+func (mr *MockActivityServiceMockRecorder) ListActivity(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivity", reflect.TypeOf((*MockActivityService)(nil).ListActivity), ctx, opts)
+}
+
+// MockJournalsService is a mock of JournalsService interface.
+type MockJournalsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockJournalsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockJournalsServiceMockRecorder is the mock recorder for MockJournalsService.
+type MockJournalsServiceMockRecorder struct {
+	mock *MockJournalsService
+}
+
+// NewMockJournalsService creates a new mock instance.
+func NewMockJournalsService(ctrl *gomock.Controller) *MockJournalsService {
+	mock := &MockJournalsService{ctrl: ctrl}
+	mock.recorder = &MockJournalsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJournalsService) EXPECT() *MockJournalsServiceMockRecorder {
+	return m.recorder
+}
+
+// ShowJournal mocks base method.
+func (m *MockJournalsService) ShowJournal(ctx context.Context, id int) (*redmine.JournalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowJournal", ctx, id)
+	ret0, _ := ret[0].(*redmine.JournalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowJournal indicates an expected call of ShowJournal.
+func (mr *MockJournalsServiceMockRecorder) ShowJournal(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowJournal", reflect.TypeOf((*MockJournalsService)(nil).ShowJournal), ctx, id)
+}
+
+// MockRolesService is a mock of RolesService interface.
+type MockRolesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRolesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRolesServiceMockRecorder is the mock recorder for MockRolesService.
+type MockRolesServiceMockRecorder struct {
+	mock *MockRolesService
+}
+
+// NewMockRolesService creates a new mock instance.
+func NewMockRolesService(ctrl *gomock.Controller) *MockRolesService {
+	mock := &MockRolesService{ctrl: ctrl}
+	mock.recorder = &MockRolesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRolesService) EXPECT() *MockRolesServiceMockRecorder {
+	return m.recorder
+}
+
+// ListRoles mocks base method.
+func (m *MockRolesService) ListRoles(ctx context.Context) (*redmine.RolesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", ctx)
+	ret0, _ := ret[0].(*redmine.RolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockRolesServiceMockRecorder) ListRoles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockRolesService)(nil).ListRoles), ctx)
+}
+
+// ShowRole mocks base method.
+func (m *MockRolesService) ShowRole(ctx context.Context, id int) (*redmine.RoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowRole", ctx, id)
+	ret0, _ := ret[0].(*redmine.RoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowRole indicates an expected call of ShowRole.
+func (mr *MockRolesServiceMockRecorder) ShowRole(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowRole", reflect.TypeOf((*MockRolesService)(nil).ShowRole), ctx, id)
+}
+
+// MockTrackersService is a mock of TrackersService interface.
+type MockTrackersService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrackersServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTrackersServiceMockRecorder is the mock recorder for MockTrackersService.
+type MockTrackersServiceMockRecorder struct {
+	mock *MockTrackersService
+}
+
+// NewMockTrackersService creates a new mock instance.
+func NewMockTrackersService(ctrl *gomock.Controller) *MockTrackersService {
+	mock := &MockTrackersService{ctrl: ctrl}
+	mock.recorder = &MockTrackersServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrackersService) EXPECT() *MockTrackersServiceMockRecorder {
+	return m.recorder
+}
+
+// ListTrackers mocks base method.
+func (m *MockTrackersService) ListTrackers(ctx context.Context) (*redmine.TrackersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrackers", ctx)
+	ret0, _ := ret[0].(*redmine.TrackersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrackers indicates an expected call of ListTrackers.
+func (mr *MockTrackersServiceMockRecorder) ListTrackers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrackers", reflect.TypeOf((*MockTrackersService)(nil).ListTrackers), ctx)
+}
+
+// MockIssueStatusesService is a mock of IssueStatusesService interface.
+type MockIssueStatusesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIssueStatusesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockIssueStatusesServiceMockRecorder is the mock recorder for MockIssueStatusesService.
+type MockIssueStatusesServiceMockRecorder struct {
+	mock *MockIssueStatusesService
+}
+
+// NewMockIssueStatusesService creates a new mock instance.
+func NewMockIssueStatusesService(ctrl *gomock.Controller) *MockIssueStatusesService {
+	mock := &MockIssueStatusesService{ctrl: ctrl}
+	mock.recorder = &MockIssueStatusesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIssueStatusesService) EXPECT() *MockIssueStatusesServiceMockRecorder {
+	return m.recorder
+}
+
+// ListIssueStatuses mocks base method.
+func (m *MockIssueStatusesService) ListIssueStatuses(ctx context.Context) (*redmine.IssueStatusesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssueStatuses", ctx)
+	ret0, _ := ret[0].(*redmine.IssueStatusesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssueStatuses indicates an expected call of ListIssueStatuses.
+func (mr *MockIssueStatusesServiceMockRecorder) ListIssueStatuses(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueStatuses", reflect.TypeOf((*MockIssueStatusesService)(nil).ListIssueStatuses), ctx)
+}
+
+// MockEnumerationsService is a mock of EnumerationsService interface.
+type MockEnumerationsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnumerationsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockEnumerationsServiceMockRecorder is the mock recorder for MockEnumerationsService.
+type MockEnumerationsServiceMockRecorder struct {
+	mock *MockEnumerationsService
+}
+
+// NewMockEnumerationsService creates a new mock instance.
+func NewMockEnumerationsService(ctrl *gomock.Controller) *MockEnumerationsService {
+	mock := &MockEnumerationsService{ctrl: ctrl}
+	mock.recorder = &MockEnumerationsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnumerationsService) EXPECT() *MockEnumerationsServiceMockRecorder {
+	return m.recorder
+}
+
+// ListDocumentCategories mocks base method.
+func (m *MockEnumerationsService) ListDocumentCategories(ctx context.Context) (*redmine.EnumerationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentCategories", ctx)
+	ret0, _ := ret[0].(*redmine.EnumerationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentCategories indicates an expected call of ListDocumentCategories.
+func (mr *MockEnumerationsServiceMockRecorder) ListDocumentCategories(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentCategories", reflect.TypeOf((*MockEnumerationsService)(nil).ListDocumentCategories), ctx)
+}
+
+// ListIssuePriorities mocks base method.
+func (m *MockEnumerationsService) ListIssuePriorities(ctx context.Context) (*redmine.EnumerationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssuePriorities", ctx)
+	ret0, _ := ret[0].(*redmine.EnumerationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssuePriorities indicates an expected call of ListIssuePriorities.
+func (mr *MockEnumerationsServiceMockRecorder) ListIssuePriorities(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssuePriorities", reflect.TypeOf((*MockEnumerationsService)(nil).ListIssuePriorities), ctx)
+}
+
+// ListTimeEntryActivities mocks base method.
+func (m *MockEnumerationsService) ListTimeEntryActivities(ctx context.Context) (*redmine.EnumerationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTimeEntryActivities", ctx)
+	ret0, _ := ret[0].(*redmine.EnumerationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTimeEntryActivities indicates an expected call of ListTimeEntryActivities.
+func (mr *MockEnumerationsServiceMockRecorder) ListTimeEntryActivities(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTimeEntryActivities", reflect.TypeOf((*MockEnumerationsService)(nil).ListTimeEntryActivities), ctx)
+}
+
+// MockCustomFieldsService is a mock of CustomFieldsService interface.
+type MockCustomFieldsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomFieldsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomFieldsServiceMockRecorder is the mock recorder for MockCustomFieldsService.
+type MockCustomFieldsServiceMockRecorder struct {
+	mock *MockCustomFieldsService
+}
+
+// NewMockCustomFieldsService creates a new mock instance.
+func NewMockCustomFieldsService(ctrl *gomock.Controller) *MockCustomFieldsService {
+	mock := &MockCustomFieldsService{ctrl: ctrl}
+	mock.recorder = &MockCustomFieldsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomFieldsService) EXPECT() *MockCustomFieldsServiceMockRecorder {
+	return m.recorder
+}
+
+// ListCustomFields mocks base method.
+func (m *MockCustomFieldsService) ListCustomFields(ctx context.Context) (*redmine.CustomFieldsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomFields", ctx)
+	ret0, _ := ret[0].(*redmine.CustomFieldsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomFields indicates an expected call of ListCustomFields.
+func (mr *MockCustomFieldsServiceMockRecorder) ListCustomFields(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomFields", reflect.TypeOf((*MockCustomFieldsService)(nil).ListCustomFields), ctx)
+}
+
+// MockAPI is a mock of API interface.
+type MockAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIMockRecorder is the mock recorder for MockAPI.
+type MockAPIMockRecorder struct {
+	mock *MockAPI
+}
+
+// NewMockAPI creates a new mock instance.
+func NewMockAPI(ctrl *gomock.Controller) *MockAPI {
+	mock := &MockAPI{ctrl: ctrl}
+	mock.recorder = &MockAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
+	return m.recorder
+}
+
+// AddUserToGroup mocks base method.
+func (m *MockAPI) AddUserToGroup(ctx context.Context, groupID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserToGroup", ctx, groupID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUserToGroup indicates an expected call of AddUserToGroup.
+func (mr *MockAPIMockRecorder) AddUserToGroup(ctx, groupID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToGroup", reflect.TypeOf((*MockAPI)(nil).AddUserToGroup), ctx, groupID, userID)
+}
+
+// AddWatcher mocks base method.
+func (m *MockAPI) AddWatcher(ctx context.Context, issueID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWatcher", ctx, issueID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWatcher indicates an expected call of AddWatcher.
+func (mr *MockAPIMockRecorder) AddWatcher(ctx, issueID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWatcher", reflect.TypeOf((*MockAPI)(nil).AddWatcher), ctx, issueID, userID)
+}
+
+// AllIssues mocks base method.
+func (m *MockAPI) AllIssues(ctx context.Context, opts *redmine.ListIssuesOptions) iter.Seq2[redmine.Issue, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllIssues", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[redmine.Issue, error])
+	return ret0
+}
+
+// AllIssues indicates an expected call of AllIssues.
+func (mr *MockAPIMockRecorder) AllIssues(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllIssues", reflect.TypeOf((*MockAPI)(nil).AllIssues), ctx, opts)
+}
+
+// AllMemberships mocks base method.
+func (m *MockAPI) AllMemberships(ctx context.Context, projectIDOrIdentifier string) iter.Seq2[redmine.Membership, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllMemberships", ctx, projectIDOrIdentifier)
+	ret0, _ := ret[0].(iter.Seq2[redmine.Membership, error])
+	return ret0
+}
+
+// AllMemberships indicates an expected call of AllMemberships.
+func (mr *MockAPIMockRecorder) AllMemberships(ctx, projectIDOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllMemberships", reflect.TypeOf((*MockAPI)(nil).AllMemberships), ctx, projectIDOrIdentifier)
+}
+
+// AllNews mocks base method.
+func (m *MockAPI) AllNews(ctx context.Context, opts *redmine.ListNewsOptions) iter.Seq2[redmine.News, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllNews", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[redmine.News, error])
+	return ret0
+}
+
+// AllNews indicates an expected call of AllNews.
+func (mr *MockAPIMockRecorder) AllNews(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllNews", reflect.TypeOf((*MockAPI)(nil).AllNews), ctx, opts)
+}
+
+// AllProjectNews mocks base method.
+func (m *MockAPI) AllProjectNews(ctx context.Context, projectIDOrIdentifier string, opts *redmine.ListNewsOptions) iter.Seq2[redmine.News, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllProjectNews", ctx, projectIDOrIdentifier, opts)
+	ret0, _ := ret[0].(iter.Seq2[redmine.News, error])
+	return ret0
+}
+
+// AllProjectNews indicates an expected call of AllProjectNews.
+func (mr *MockAPIMockRecorder) AllProjectNews(ctx, projectIDOrIdentifier, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllProjectNews", reflect.TypeOf((*MockAPI)(nil).AllProjectNews), ctx, projectIDOrIdentifier, opts)
+}
+
+// AllProjects mocks base method.
+func (m *MockAPI) AllProjects(ctx context.Context, opts *redmine.ListProjectsOptions) iter.Seq2[redmine.Project, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllProjects", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[redmine.Project, error])
+	return ret0
+}
+
+// AllProjects indicates an expected call of AllProjects.
+func (mr *MockAPIMockRecorder) AllProjects(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllProjects", reflect.TypeOf((*MockAPI)(nil).AllProjects), ctx, opts)
+}
+
+// AllSearchResults mocks base method.
+func (m *MockAPI) AllSearchResults(ctx context.Context, opts *redmine.SearchOptions) iter.Seq2[redmine.SearchResult, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllSearchResults", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[redmine.SearchResult, error])
+	return ret0
+}
+
+// AllSearchResults indicates an expected call of AllSearchResults.
+func (mr *MockAPIMockRecorder) AllSearchResults(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllSearchResults", reflect.TypeOf((*MockAPI)(nil).AllSearchResults), ctx, opts)
+}
+
+// AllTimeEntries mocks base method.
+func (m *MockAPI) AllTimeEntries(ctx context.Context, opts *redmine.ListTimeEntriesOptions) iter.Seq2[redmine.TimeEntry, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllTimeEntries", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[redmine.TimeEntry, error])
+	return ret0
+}
+
+// AllTimeEntries indicates an expected call of AllTimeEntries.
+func (mr *MockAPIMockRecorder) AllTimeEntries(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllTimeEntries", reflect.TypeOf((*MockAPI)(nil).AllTimeEntries), ctx, opts)
+}
+
+// AllUsers mocks base method.
+func (m *MockAPI) AllUsers(ctx context.Context, opts *redmine.ListUsersOptions) iter.Seq2[redmine.User, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllUsers", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[redmine.User, error])
+	return ret0
+}
+
+// AllUsers indicates an expected call of AllUsers.
+func (mr *MockAPIMockRecorder) AllUsers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllUsers", reflect.TypeOf((*MockAPI)(nil).AllUsers), ctx, opts)
+}
+
+// ArchiveProject mocks base method.
+func (m *MockAPI) ArchiveProject(ctx context.Context, idOrIdentifier string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveProject", ctx, idOrIdentifier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveProject indicates an expected call of ArchiveProject.
+func (mr *MockAPIMockRecorder) ArchiveProject(ctx, idOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveProject", reflect.TypeOf((*MockAPI)(nil).ArchiveProject), ctx, idOrIdentifier)
+}
+
+// CreateGroup mocks base method.
+func (m *MockAPI) CreateGroup(ctx context.Context, group redmine.Group) (*redmine.GroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroup", ctx, group)
+	ret0, _ := ret[0].(*redmine.GroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroup indicates an expected call of CreateGroup.
+func (mr *MockAPIMockRecorder) CreateGroup(ctx, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockAPI)(nil).CreateGroup), ctx, group)
+}
+
+// CreateIssue mocks base method.
+func (m *MockAPI) CreateIssue(ctx context.Context, req redmine.IssueCreateRequest) (*redmine.IssueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssue", ctx, req)
+	ret0, _ := ret[0].(*redmine.IssueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIssue indicates an expected call of CreateIssue.
+func (mr *MockAPIMockRecorder) CreateIssue(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssue", reflect.TypeOf((*MockAPI)(nil).CreateIssue), ctx, req)
+}
+
+// CreateIssueCategory mocks base method.
+func (m *MockAPI) CreateIssueCategory(ctx context.Context, projectIDOrIdentifier string, req redmine.IssueCategoryCreateRequest) (*redmine.IssueCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssueCategory", ctx, projectIDOrIdentifier, req)
+	ret0, _ := ret[0].(*redmine.IssueCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIssueCategory indicates an expected call of CreateIssueCategory.
+func (mr *MockAPIMockRecorder) CreateIssueCategory(ctx, projectIDOrIdentifier, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssueCategory", reflect.TypeOf((*MockAPI)(nil).CreateIssueCategory), ctx, projectIDOrIdentifier, req)
+}
+
+// CreateIssueRelation mocks base method.
+func (m *MockAPI) CreateIssueRelation(ctx context.Context, issueID int, relation redmine.IssueRelation) (*redmine.IssueRelationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssueRelation", ctx, issueID, relation)
+	ret0, _ := ret[0].(*redmine.IssueRelationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIssueRelation indicates an expected call of CreateIssueRelation.
+func (mr *MockAPIMockRecorder) CreateIssueRelation(ctx, issueID, relation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssueRelation", reflect.TypeOf((*MockAPI)(nil).CreateIssueRelation), ctx, issueID, relation)
+}
+
+// CreateMembership mocks base method.
+func (m *MockAPI) CreateMembership(ctx context.Context, projectIDOrIdentifier string, membership redmine.MembershipCreateUpdate) (*redmine.MembershipResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMembership", ctx, projectIDOrIdentifier, membership)
+	ret0, _ := ret[0].(*redmine.MembershipResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMembership indicates an expected call of CreateMembership.
+func (mr *MockAPIMockRecorder) CreateMembership(ctx, projectIDOrIdentifier, membership any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMembership", reflect.TypeOf((*MockAPI)(nil).CreateMembership), ctx, projectIDOrIdentifier, membership)
+}
+
+// CreateOrUpdateWikiPage mocks base method.
+func (m *MockAPI) CreateOrUpdateWikiPage(ctx context.Context, projectIDOrIdentifier, pageName string, page redmine.WikiPageUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateWikiPage", ctx, projectIDOrIdentifier, pageName, page)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateWikiPage indicates an expected call of CreateOrUpdateWikiPage.
+func (mr *MockAPIMockRecorder) CreateOrUpdateWikiPage(ctx, projectIDOrIdentifier, pageName, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateWikiPage", reflect.TypeOf((*MockAPI)(nil).CreateOrUpdateWikiPage), ctx, projectIDOrIdentifier, pageName, page)
+}
+
+// CreateProject mocks base method.
+func (m *MockAPI) CreateProject(ctx context.Context, req redmine.ProjectCreateRequest) (*redmine.ProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", ctx, req)
+	ret0, _ := ret[0].(*redmine.ProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockAPIMockRecorder) CreateProject(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockAPI)(nil).CreateProject), ctx, req)
+}
+
+// CreateTimeEntry mocks base method.
+func (m *MockAPI) CreateTimeEntry(ctx context.Context, req redmine.TimeEntryCreateRequest) (*redmine.TimeEntryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTimeEntry", ctx, req)
+	ret0, _ := ret[0].(*redmine.TimeEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTimeEntry indicates an expected call of CreateTimeEntry.
+func (mr *MockAPIMockRecorder) CreateTimeEntry(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTimeEntry", reflect.TypeOf((*MockAPI)(nil).CreateTimeEntry), ctx, req)
+}
+
+// CreateUser mocks base method.
+func (m *MockAPI) CreateUser(ctx context.Context, user redmine.User) (*redmine.UserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
+	ret0, _ := ret[0].(*redmine.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockAPIMockRecorder) CreateUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockAPI)(nil).CreateUser), ctx, user)
+}
+
+// CreateVersion mocks base method.
+func (m *MockAPI) CreateVersion(ctx context.Context, projectIDOrIdentifier string, version redmine.Version) (*redmine.VersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVersion", ctx, projectIDOrIdentifier, version)
+	ret0, _ := ret[0].(*redmine.VersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVersion indicates an expected call of CreateVersion.
+func (mr *MockAPIMockRecorder) CreateVersion(ctx, projectIDOrIdentifier, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVersion", reflect.TypeOf((*MockAPI)(nil).CreateVersion), ctx, projectIDOrIdentifier, version)
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockAPI) DeleteAttachment(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockAPIMockRecorder) DeleteAttachment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockAPI)(nil).DeleteAttachment), ctx, id)
+}
+
+// DeleteGroup mocks base method.
+func (m *MockAPI) DeleteGroup(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroup", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroup indicates an expected call of DeleteGroup.
+func (mr *MockAPIMockRecorder) DeleteGroup(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockAPI)(nil).DeleteGroup), ctx, id)
+}
+
+// DeleteIssue mocks base method.
+func (m *MockAPI) DeleteIssue(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIssue", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIssue indicates an expected call of DeleteIssue.
+func (mr *MockAPIMockRecorder) DeleteIssue(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIssue", reflect.TypeOf((*MockAPI)(nil).DeleteIssue), ctx, id)
+}
+
+// DeleteIssueCategory mocks base method.
+func (m *MockAPI) DeleteIssueCategory(ctx context.Context, id int, opts *redmine.DeleteIssueCategoryOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIssueCategory", ctx, id, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIssueCategory indicates an expected call of DeleteIssueCategory.
+func (mr *MockAPIMockRecorder) DeleteIssueCategory(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIssueCategory", reflect.TypeOf((*MockAPI)(nil).DeleteIssueCategory), ctx, id, opts)
+}
+
+// DeleteIssueRelation mocks base method.
+func (m *MockAPI) DeleteIssueRelation(ctx context.Context, relationID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIssueRelation", ctx, relationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIssueRelation indicates an expected call of DeleteIssueRelation.
+func (mr *MockAPIMockRecorder) DeleteIssueRelation(ctx, relationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIssueRelation", reflect.TypeOf((*MockAPI)(nil).DeleteIssueRelation), ctx, relationID)
+}
+
+// DeleteMembership mocks base method.
+func (m *MockAPI) DeleteMembership(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMembership", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMembership indicates an expected call of DeleteMembership.
+func (mr *MockAPIMockRecorder) DeleteMembership(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMembership", reflect.TypeOf((*MockAPI)(nil).DeleteMembership), ctx, id)
+}
+
+// DeleteProject mocks base method.
+func (m *MockAPI) DeleteProject(ctx context.Context, idOrIdentifier string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", ctx, idOrIdentifier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockAPIMockRecorder) DeleteProject(ctx, idOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockAPI)(nil).DeleteProject), ctx, idOrIdentifier)
+}
+
+// DeleteTimeEntry mocks base method.
+func (m *MockAPI) DeleteTimeEntry(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTimeEntry", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTimeEntry indicates an expected call of DeleteTimeEntry.
+func (mr *MockAPIMockRecorder) DeleteTimeEntry(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTimeEntry", reflect.TypeOf((*MockAPI)(nil).DeleteTimeEntry), ctx, id)
+}
+
+// DeleteUser mocks base method.
+func (m *MockAPI) DeleteUser(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockAPIMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockAPI)(nil).DeleteUser), ctx, id)
+}
+
+// DeleteVersion mocks base method.
+func (m *MockAPI) DeleteVersion(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVersion", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVersion indicates an expected call of DeleteVersion.
+func (mr *MockAPIMockRecorder) DeleteVersion(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVersion", reflect.TypeOf((*MockAPI)(nil).DeleteVersion), ctx, id)
+}
+
+// DeleteWikiPage mocks base method.
+func (m *MockAPI) DeleteWikiPage(ctx context.Context, projectIDOrIdentifier, pageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWikiPage", ctx, projectIDOrIdentifier, pageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWikiPage indicates an expected call of DeleteWikiPage.
+func (mr *MockAPIMockRecorder) DeleteWikiPage(ctx, projectIDOrIdentifier, pageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWikiPage", reflect.TypeOf((*MockAPI)(nil).DeleteWikiPage), ctx, projectIDOrIdentifier, pageName)
+}
+
+// DiffWikiPageVersions mocks base method.
+func (m *MockAPI) DiffWikiPageVersions(ctx context.Context, projectIDOrIdentifier, pageName string, from, to int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffWikiPageVersions", ctx, projectIDOrIdentifier, pageName, from, to)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffWikiPageVersions indicates an expected call of DiffWikiPageVersions.
+func (mr *MockAPIMockRecorder) DiffWikiPageVersions(ctx, projectIDOrIdentifier, pageName, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffWikiPageVersions", reflect.TypeOf((*MockAPI)(nil).DiffWikiPageVersions), ctx, projectIDOrIdentifier, pageName, from, to)
+}
+
+// DownloadAttachment mocks base method.
+func (m *MockAPI) DownloadAttachment(ctx context.Context, id int, w io.Writer) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadAttachment", ctx, id, w)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadAttachment indicates an expected call of DownloadAttachment.
+func (mr *MockAPIMockRecorder) DownloadAttachment(ctx, id, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadAttachment", reflect.TypeOf((*MockAPI)(nil).DownloadAttachment), ctx, id, w)
+}
+
+// DownloadAttachmentWithOptions mocks base method.
+func (m *MockAPI) DownloadAttachmentWithOptions(ctx context.Context, id int, w io.Writer, opts *redmine.DownloadOptions) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadAttachmentWithOptions", ctx, id, w, opts)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadAttachmentWithOptions indicates an expected call of DownloadAttachmentWithOptions.
+func (mr *MockAPIMockRecorder) DownloadAttachmentWithOptions(ctx, id, w, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadAttachmentWithOptions", reflect.TypeOf((*MockAPI)(nil).DownloadAttachmentWithOptions), ctx, id, w, opts)
+}
+
+// DownloadFile mocks base method.
+func (m *MockAPI) DownloadFile(ctx context.Context, file redmine.File, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadFile", ctx, file, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadFile indicates an expected call of DownloadFile.
+func (mr *MockAPIMockRecorder) DownloadFile(ctx, file, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadFile", reflect.TypeOf((*MockAPI)(nil).DownloadFile), ctx, file, w)
+}
+
+// DownloadFileToPath mocks base method.
+func (m *MockAPI) DownloadFileToPath(ctx context.Context, file redmine.File, path string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadFileToPath", ctx, file, path)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadFileToPath indicates an expected call of DownloadFileToPath.
+func (mr *MockAPIMockRecorder) DownloadFileToPath(ctx, file, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadFileToPath", reflect.TypeOf((*MockAPI)(nil).DownloadFileToPath), ctx, file, path)
+}
+
+// DownloadThumbnail mocks base method.
+func (m *MockAPI) DownloadThumbnail(ctx context.Context, id, size int, w io.Writer) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadThumbnail", ctx, id, size, w)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadThumbnail indicates an expected call of DownloadThumbnail.
+func (mr *MockAPIMockRecorder) DownloadThumbnail(ctx, id, size, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadThumbnail", reflect.TypeOf((*MockAPI)(nil).DownloadThumbnail), ctx, id, size, w)
+}
+
+// GetCurrentUser mocks base method.
+func (m *MockAPI) GetCurrentUser(ctx context.Context, opts *redmine.ShowUserOptions) (*redmine.UserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentUser", ctx, opts)
+	ret0, _ := ret[0].(*redmine.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentUser indicates an expected call of GetCurrentUser.
+func (mr *MockAPIMockRecorder) GetCurrentUser(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUser", reflect.TypeOf((*MockAPI)(nil).GetCurrentUser), ctx, opts)
+}
+
+// GetFeed mocks base method.
+func (m *MockAPI) GetFeed(ctx context.Context, path string, params url.Values) (*redmine.ActivityFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, path, params)
+	ret0, _ := ret[0].(*redmine.ActivityFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockAPIMockRecorder) GetFeed(ctx, path, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockAPI)(nil).GetFeed), ctx, path, params)
+}
+
+// GetIssueHistory mocks base method.
+func (m *MockAPI) GetIssueHistory(ctx context.Context, id int) (*redmine.IssueHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIssueHistory", ctx, id)
+	ret0, _ := ret[0].(*redmine.IssueHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssueHistory indicates an expected call of GetIssueHistory.
+func (mr *MockAPIMockRecorder) GetIssueHistory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssueHistory", reflect.TypeOf((*MockAPI)(nil).GetIssueHistory), ctx, id)
+}
+
+// GetMyAccount mocks base method.
+func (m *MockAPI) GetMyAccount(ctx context.Context) (*redmine.MyAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMyAccount", ctx)
+	ret0, _ := ret[0].(*redmine.MyAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMyAccount indicates an expected call of GetMyAccount.
+func (mr *MockAPIMockRecorder) GetMyAccount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyAccount", reflect.TypeOf((*MockAPI)(nil).GetMyAccount), ctx)
+}
+
+// GetWikiPage mocks base method.
+func (m *MockAPI) GetWikiPage(ctx context.Context, projectIDOrIdentifier, pageName string, opts *redmine.GetWikiPageOptions) (*redmine.WikiPageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWikiPage", ctx, projectIDOrIdentifier, pageName, opts)
+	ret0, _ := ret[0].(*redmine.WikiPageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWikiPage indicates an expected call of GetWikiPage.
+func (mr *MockAPIMockRecorder) GetWikiPage(ctx, projectIDOrIdentifier, pageName, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWikiPage", reflect.TypeOf((*MockAPI)(nil).GetWikiPage), ctx, projectIDOrIdentifier, pageName, opts)
+}
+
+// GetWikiPageVersion mocks base method.
+func (m *MockAPI) GetWikiPageVersion(ctx context.Context, projectIDOrIdentifier, pageName string, version int) (*redmine.WikiPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWikiPageVersion", ctx, projectIDOrIdentifier, pageName, version)
+	ret0, _ := ret[0].(*redmine.WikiPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWikiPageVersion indicates an expected call of GetWikiPageVersion.
+func (mr *MockAPIMockRecorder) GetWikiPageVersion(ctx, projectIDOrIdentifier, pageName, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWikiPageVersion", reflect.TypeOf((*MockAPI)(nil).GetWikiPageVersion), ctx, projectIDOrIdentifier, pageName, version)
+}
+
+// ListActivity mocks base method.
+func (m *MockAPI) ListActivity(ctx context.Context, opts *redmine.ListActivityOptions) ([]redmine.ActivityEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivity", ctx, opts)
+	ret0, _ := ret[0].([]redmine.ActivityEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActivity indicates an expected call of ListActivity.
+func (mr *MockAPIMockRecorder) ListActivity(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivity", reflect.TypeOf((*MockAPI)(nil).ListActivity), ctx, opts)
+}
+
+// ListAllIssues mocks base method.
+func (m *MockAPI) ListAllIssues(ctx context.Context, opts *redmine.ListIssuesOptions) ([]redmine.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllIssues", ctx, opts)
+	ret0, _ := ret[0].([]redmine.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllIssues indicates an expected call of ListAllIssues.
+func (mr *MockAPIMockRecorder) ListAllIssues(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllIssues", reflect.TypeOf((*MockAPI)(nil).ListAllIssues), ctx, opts)
+}
+
+// ListAllIssuesByQuery mocks base method.
+func (m *MockAPI) ListAllIssuesByQuery(ctx context.Context, query redmine.Query, opts *redmine.ListIssuesOptions) ([]redmine.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllIssuesByQuery", ctx, query, opts)
+	ret0, _ := ret[0].([]redmine.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllIssuesByQuery indicates an expected call of ListAllIssuesByQuery.
+func (mr *MockAPIMockRecorder) ListAllIssuesByQuery(ctx, query, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllIssuesByQuery", reflect.TypeOf((*MockAPI)(nil).ListAllIssuesByQuery), ctx, query, opts)
+}
+
+// ListAllMemberships mocks base method.
+func (m *MockAPI) ListAllMemberships(ctx context.Context, projectIDOrIdentifier string) ([]redmine.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllMemberships", ctx, projectIDOrIdentifier)
+	ret0, _ := ret[0].([]redmine.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllMemberships indicates an expected call of ListAllMemberships.
+func (mr *MockAPIMockRecorder) ListAllMemberships(ctx, projectIDOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllMemberships", reflect.TypeOf((*MockAPI)(nil).ListAllMemberships), ctx, projectIDOrIdentifier)
+}
+
+// ListAllNews mocks base method.
+func (m *MockAPI) ListAllNews(ctx context.Context, opts *redmine.ListNewsOptions) ([]redmine.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllNews", ctx, opts)
+	ret0, _ := ret[0].([]redmine.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllNews indicates an expected call of ListAllNews.
+func (mr *MockAPIMockRecorder) ListAllNews(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllNews", reflect.TypeOf((*MockAPI)(nil).ListAllNews), ctx, opts)
+}
+
+// ListAllProjectNews mocks base method.
+func (m *MockAPI) ListAllProjectNews(ctx context.Context, projectIDOrIdentifier string, opts *redmine.ListNewsOptions) ([]redmine.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllProjectNews", ctx, projectIDOrIdentifier, opts)
+	ret0, _ := ret[0].([]redmine.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllProjectNews indicates an expected call of ListAllProjectNews.
+func (mr *MockAPIMockRecorder) ListAllProjectNews(ctx, projectIDOrIdentifier, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllProjectNews", reflect.TypeOf((*MockAPI)(nil).ListAllProjectNews), ctx, projectIDOrIdentifier, opts)
+}
+
+// ListAllProjects mocks base method.
+func (m *MockAPI) ListAllProjects(ctx context.Context, opts *redmine.ListProjectsOptions) ([]redmine.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllProjects", ctx, opts)
+	ret0, _ := ret[0].([]redmine.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllProjects indicates an expected call of ListAllProjects.
+func (mr *MockAPIMockRecorder) ListAllProjects(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllProjects", reflect.TypeOf((*MockAPI)(nil).ListAllProjects), ctx, opts)
+}
+
+// ListAllSearchResults mocks base method.
+func (m *MockAPI) ListAllSearchResults(ctx context.Context, opts *redmine.SearchOptions) ([]redmine.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllSearchResults", ctx, opts)
+	ret0, _ := ret[0].([]redmine.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllSearchResults indicates an expected call of ListAllSearchResults.
+func (mr *MockAPIMockRecorder) ListAllSearchResults(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllSearchResults", reflect.TypeOf((*MockAPI)(nil).ListAllSearchResults), ctx, opts)
+}
+
+// ListAllTimeEntries mocks base method.
+func (m *MockAPI) ListAllTimeEntries(ctx context.Context, opts *redmine.ListTimeEntriesOptions) ([]redmine.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllTimeEntries", ctx, opts)
+	ret0, _ := ret[0].([]redmine.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllTimeEntries indicates an expected call of ListAllTimeEntries.
+func (mr *MockAPIMockRecorder) ListAllTimeEntries(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllTimeEntries", reflect.TypeOf((*MockAPI)(nil).ListAllTimeEntries), ctx, opts)
+}
+
+// ListAllUsers mocks base method.
+func (m *MockAPI) ListAllUsers(ctx context.Context, opts *redmine.ListUsersOptions) ([]redmine.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllUsers", ctx, opts)
+	ret0, _ := ret[0].([]redmine.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllUsers indicates an expected call of ListAllUsers.
+func (mr *MockAPIMockRecorder) ListAllUsers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllUsers", reflect.TypeOf((*MockAPI)(nil).ListAllUsers), ctx, opts)
+}
+
+// ListCustomFields mocks base method.
+func (m *MockAPI) ListCustomFields(ctx context.Context) (*redmine.CustomFieldsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomFields", ctx)
+	ret0, _ := ret[0].(*redmine.CustomFieldsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomFields indicates an expected call of ListCustomFields.
+func (mr *MockAPIMockRecorder) ListCustomFields(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomFields", reflect.TypeOf((*MockAPI)(nil).ListCustomFields), ctx)
+}
+
+// ListDocumentCategories mocks base method.
+func (m *MockAPI) ListDocumentCategories(ctx context.Context) (*redmine.EnumerationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentCategories", ctx)
+	ret0, _ := ret[0].(*redmine.EnumerationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentCategories indicates an expected call of ListDocumentCategories.
+func (mr *MockAPIMockRecorder) ListDocumentCategories(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentCategories", reflect.TypeOf((*MockAPI)(nil).ListDocumentCategories), ctx)
+}
+
+// ListFiles mocks base method.
+func (m *MockAPI) ListFiles(ctx context.Context, projectIDOrIdentifier string) (*redmine.FilesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFiles", ctx, projectIDOrIdentifier)
+	ret0, _ := ret[0].(*redmine.FilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFiles indicates an expected call of ListFiles.
+func (mr *MockAPIMockRecorder) ListFiles(ctx, projectIDOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockAPI)(nil).ListFiles), ctx, projectIDOrIdentifier)
+}
+
+// ListGroups mocks base method.
+func (m *MockAPI) ListGroups(ctx context.Context, opts *redmine.ListGroupsOptions) (*redmine.GroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroups", ctx, opts)
+	ret0, _ := ret[0].(*redmine.GroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroups indicates an expected call of ListGroups.
+func (mr *MockAPIMockRecorder) ListGroups(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroups", reflect.TypeOf((*MockAPI)(nil).ListGroups), ctx, opts)
+}
+
+// ListIssueCategories mocks base method.
+func (m *MockAPI) ListIssueCategories(ctx context.Context, projectIDOrIdentifier string) (*redmine.IssueCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssueCategories", ctx, projectIDOrIdentifier)
+	ret0, _ := ret[0].(*redmine.IssueCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssueCategories indicates an expected call of ListIssueCategories.
+func (mr *MockAPIMockRecorder) ListIssueCategories(ctx, projectIDOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueCategories", reflect.TypeOf((*MockAPI)(nil).ListIssueCategories), ctx, projectIDOrIdentifier)
+}
+
+// ListIssuePriorities mocks base method.
+func (m *MockAPI) ListIssuePriorities(ctx context.Context) (*redmine.EnumerationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssuePriorities", ctx)
+	ret0, _ := ret[0].(*redmine.EnumerationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssuePriorities indicates an expected call of ListIssuePriorities.
+func (mr *MockAPIMockRecorder) ListIssuePriorities(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssuePriorities", reflect.TypeOf((*MockAPI)(nil).ListIssuePriorities), ctx)
+}
+
+// ListIssueRelations mocks base method.
+func (m *MockAPI) ListIssueRelations(ctx context.Context, issueID int) (*redmine.IssueRelationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssueRelations", ctx, issueID)
+	ret0, _ := ret[0].(*redmine.IssueRelationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssueRelations indicates an expected call of ListIssueRelations.
+func (mr *MockAPIMockRecorder) ListIssueRelations(ctx, issueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueRelations", reflect.TypeOf((*MockAPI)(nil).ListIssueRelations), ctx, issueID)
+}
+
+// ListIssueStatuses mocks base method.
+func (m *MockAPI) ListIssueStatuses(ctx context.Context) (*redmine.IssueStatusesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssueStatuses", ctx)
+	ret0, _ := ret[0].(*redmine.IssueStatusesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssueStatuses indicates an expected call of ListIssueStatuses.
+func (mr *MockAPIMockRecorder) ListIssueStatuses(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueStatuses", reflect.TypeOf((*MockAPI)(nil).ListIssueStatuses), ctx)
+}
+
+// ListIssues mocks base method.
+func (m *MockAPI) ListIssues(ctx context.Context, opts *redmine.ListIssuesOptions) (*redmine.IssuesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssues", ctx, opts)
+	ret0, _ := ret[0].(*redmine.IssuesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssues indicates an expected call of ListIssues.
+func (mr *MockAPIMockRecorder) ListIssues(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssues", reflect.TypeOf((*MockAPI)(nil).ListIssues), ctx, opts)
+}
+
+// ListIssuesByQuery mocks base method.
+func (m *MockAPI) ListIssuesByQuery(ctx context.Context, query redmine.Query, opts *redmine.ListIssuesOptions) (*redmine.IssuesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssuesByQuery", ctx, query, opts)
+	ret0, _ := ret[0].(*redmine.IssuesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssuesByQuery indicates an expected call of ListIssuesByQuery.
+func (mr *MockAPIMockRecorder) ListIssuesByQuery(ctx, query, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssuesByQuery", reflect.TypeOf((*MockAPI)(nil).ListIssuesByQuery), ctx, query, opts)
+}
+
+// ListMemberships mocks base method.
+func (m *MockAPI) ListMemberships(ctx context.Context, projectIDOrIdentifier string) (*redmine.MembershipsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberships", ctx, projectIDOrIdentifier)
+	ret0, _ := ret[0].(*redmine.MembershipsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberships indicates an expected call of ListMemberships.
+func (mr *MockAPIMockRecorder) ListMemberships(ctx, projectIDOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberships", reflect.TypeOf((*MockAPI)(nil).ListMemberships), ctx, projectIDOrIdentifier)
+}
+
+// ListNews mocks base method.
+func (m *MockAPI) ListNews(ctx context.Context, opts *redmine.ListNewsOptions) (*redmine.NewsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNews", ctx, opts)
+	ret0, _ := ret[0].(*redmine.NewsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNews indicates an expected call of ListNews.
+func (mr *MockAPIMockRecorder) ListNews(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNews", reflect.TypeOf((*MockAPI)(nil).ListNews), ctx, opts)
+}
+
+// ListProjectNews mocks base method.
+func (m *MockAPI) ListProjectNews(ctx context.Context, projectIDOrIdentifier string, opts *redmine.ListNewsOptions) (*redmine.NewsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectNews", ctx, projectIDOrIdentifier, opts)
+	ret0, _ := ret[0].(*redmine.NewsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectNews indicates an expected call of ListProjectNews.
+func (mr *MockAPIMockRecorder) ListProjectNews(ctx, projectIDOrIdentifier, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectNews", reflect.TypeOf((*MockAPI)(nil).ListProjectNews), ctx, projectIDOrIdentifier, opts)
+}
+
+// ListProjects mocks base method.
+func (m *MockAPI) ListProjects(ctx context.Context, opts *redmine.ListProjectsOptions) (*redmine.ProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", ctx, opts)
+	ret0, _ := ret[0].(*redmine.ProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockAPIMockRecorder) ListProjects(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockAPI)(nil).ListProjects), ctx, opts)
+}
+
+// ListQueries mocks base method.
+func (m *MockAPI) ListQueries(ctx context.Context) (*redmine.QueriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueries", ctx)
+	ret0, _ := ret[0].(*redmine.QueriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueries indicates an expected call of ListQueries.
+func (mr *MockAPIMockRecorder) ListQueries(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueries", reflect.TypeOf((*MockAPI)(nil).ListQueries), ctx)
+}
+
+// ListRoles mocks base method.
+func (m *MockAPI) ListRoles(ctx context.Context) (*redmine.RolesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", ctx)
+	ret0, _ := ret[0].(*redmine.RolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockAPIMockRecorder) ListRoles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockAPI)(nil).ListRoles), ctx)
+}
+
+// ListTimeEntries mocks base method.
+func (m *MockAPI) ListTimeEntries(ctx context.Context, opts *redmine.ListTimeEntriesOptions) (*redmine.TimeEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTimeEntries", ctx, opts)
+	ret0, _ := ret[0].(*redmine.TimeEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTimeEntries indicates an expected call of ListTimeEntries.
+func (mr *MockAPIMockRecorder) ListTimeEntries(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTimeEntries", reflect.TypeOf((*MockAPI)(nil).ListTimeEntries), ctx, opts)
+}
+
+// ListTimeEntryActivities mocks base method.
+func (m *MockAPI) ListTimeEntryActivities(ctx context.Context) (*redmine.EnumerationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTimeEntryActivities", ctx)
+	ret0, _ := ret[0].(*redmine.EnumerationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTimeEntryActivities indicates an expected call of ListTimeEntryActivities.
+func (mr *MockAPIMockRecorder) ListTimeEntryActivities(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTimeEntryActivities", reflect.TypeOf((*MockAPI)(nil).ListTimeEntryActivities), ctx)
+}
+
+// ListTrackers mocks base method.
+func (m *MockAPI) ListTrackers(ctx context.Context) (*redmine.TrackersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrackers", ctx)
+	ret0, _ := ret[0].(*redmine.TrackersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrackers indicates an expected call of ListTrackers.
+func (mr *MockAPIMockRecorder) ListTrackers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrackers", reflect.TypeOf((*MockAPI)(nil).ListTrackers), ctx)
+}
+
+// ListUsers mocks base method.
+func (m *MockAPI) ListUsers(ctx context.Context, opts *redmine.ListUsersOptions) (*redmine.UsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, opts)
+	ret0, _ := ret[0].(*redmine.UsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockAPIMockRecorder) ListUsers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockAPI)(nil).ListUsers), ctx, opts)
+}
+
+// ListVersions mocks base method.
+func (m *MockAPI) ListVersions(ctx context.Context, projectIDOrIdentifier string) (*redmine.VersionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", ctx, projectIDOrIdentifier)
+	ret0, _ := ret[0].(*redmine.VersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions.
+func (mr *MockAPIMockRecorder) ListVersions(ctx, projectIDOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockAPI)(nil).ListVersions), ctx, projectIDOrIdentifier)
+}
+
+// ListWikiPageVersions mocks base method.
+func (m *MockAPI) ListWikiPageVersions(ctx context.Context, projectIDOrIdentifier, pageName string, opts *redmine.ListWikiPageVersionsOptions) ([]redmine.WikiPageVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWikiPageVersions", ctx, projectIDOrIdentifier, pageName, opts)
+	ret0, _ := ret[0].([]redmine.WikiPageVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWikiPageVersions indicates an expected call of ListWikiPageVersions.
+func (mr *MockAPIMockRecorder) ListWikiPageVersions(ctx, projectIDOrIdentifier, pageName, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWikiPageVersions", reflect.TypeOf((*MockAPI)(nil).ListWikiPageVersions), ctx, projectIDOrIdentifier, pageName, opts)
+}
+
+// ListWikiPages mocks base method.
+func (m *MockAPI) ListWikiPages(ctx context.Context, projectIDOrIdentifier string) (*redmine.WikiPagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWikiPages", ctx, projectIDOrIdentifier)
+	ret0, _ := ret[0].(*redmine.WikiPagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWikiPages indicates an expected call of ListWikiPages.
+func (mr *MockAPIMockRecorder) ListWikiPages(ctx, projectIDOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWikiPages", reflect.TypeOf((*MockAPI)(nil).ListWikiPages), ctx, projectIDOrIdentifier)
+}
+
+// RemoveUserFromGroup mocks base method.
+func (m *MockAPI) RemoveUserFromGroup(ctx context.Context, groupID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserFromGroup", ctx, groupID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUserFromGroup indicates an expected call of RemoveUserFromGroup.
+func (mr *MockAPIMockRecorder) RemoveUserFromGroup(ctx, groupID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserFromGroup", reflect.TypeOf((*MockAPI)(nil).RemoveUserFromGroup), ctx, groupID, userID)
+}
+
+// RemoveWatcher mocks base method.
+func (m *MockAPI) RemoveWatcher(ctx context.Context, issueID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWatcher", ctx, issueID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveWatcher indicates an expected call of RemoveWatcher.
+func (mr *MockAPIMockRecorder) RemoveWatcher(ctx, issueID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWatcher", reflect.TypeOf((*MockAPI)(nil).RemoveWatcher), ctx, issueID, userID)
+}
+
+// Search mocks base method.
+func (m *MockAPI) Search(ctx context.Context, opts *redmine.SearchOptions) (*redmine.SearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, opts)
+	ret0, _ := ret[0].(*redmine.SearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockAPIMockRecorder) Search(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockAPI)(nil).Search), ctx, opts)
+}
+
+// SearchSimple mocks base method.
+func (m *MockAPI) SearchSimple(ctx context.Context, query string) (*redmine.SearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSimple", ctx, query)
+	ret0, _ := ret[0].(*redmine.SearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSimple indicates an expected call of SearchSimple.
+func (mr *MockAPIMockRecorder) SearchSimple(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSimple", reflect.TypeOf((*MockAPI)(nil).SearchSimple), ctx, query)
+}
+
+// ShowAttachment mocks base method.
+func (m *MockAPI) ShowAttachment(ctx context.Context, id int) (*redmine.AttachmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowAttachment", ctx, id)
+	ret0, _ := ret[0].(*redmine.AttachmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowAttachment indicates an expected call of ShowAttachment.
+func (mr *MockAPIMockRecorder) ShowAttachment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowAttachment", reflect.TypeOf((*MockAPI)(nil).ShowAttachment), ctx, id)
+}
+
+// ShowGroup mocks base method.
+func (m *MockAPI) ShowGroup(ctx context.Context, id int, opts *redmine.ShowGroupOptions) (*redmine.GroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowGroup", ctx, id, opts)
+	ret0, _ := ret[0].(*redmine.GroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowGroup indicates an expected call of ShowGroup.
+func (mr *MockAPIMockRecorder) ShowGroup(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowGroup", reflect.TypeOf((*MockAPI)(nil).ShowGroup), ctx, id, opts)
+}
+
+// ShowIssue mocks base method.
+func (m *MockAPI) ShowIssue(ctx context.Context, id int, opts *redmine.ShowIssueOptions) (*redmine.IssueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowIssue", ctx, id, opts)
+	ret0, _ := ret[0].(*redmine.IssueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowIssue indicates an expected call of ShowIssue.
+func (mr *MockAPIMockRecorder) ShowIssue(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowIssue", reflect.TypeOf((*MockAPI)(nil).ShowIssue), ctx, id, opts)
+}
+
+// ShowIssueCategory mocks base method.
+func (m *MockAPI) ShowIssueCategory(ctx context.Context, id int) (*redmine.IssueCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowIssueCategory", ctx, id)
+	ret0, _ := ret[0].(*redmine.IssueCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowIssueCategory indicates an expected call of ShowIssueCategory.
+func (mr *MockAPIMockRecorder) ShowIssueCategory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowIssueCategory", reflect.TypeOf((*MockAPI)(nil).ShowIssueCategory), ctx, id)
+}
+
+// ShowIssueRelation mocks base method.
+func (m *MockAPI) ShowIssueRelation(ctx context.Context, relationID int) (*redmine.IssueRelationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowIssueRelation", ctx, relationID)
+	ret0, _ := ret[0].(*redmine.IssueRelationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowIssueRelation indicates an expected call of ShowIssueRelation.
+func (mr *MockAPIMockRecorder) ShowIssueRelation(ctx, relationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowIssueRelation", reflect.TypeOf((*MockAPI)(nil).ShowIssueRelation), ctx, relationID)
+}
+
+// ShowJournal mocks base method.
+func (m *MockAPI) ShowJournal(ctx context.Context, id int) (*redmine.JournalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowJournal", ctx, id)
+	ret0, _ := ret[0].(*redmine.JournalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowJournal indicates an expected call of ShowJournal.
+func (mr *MockAPIMockRecorder) ShowJournal(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowJournal", reflect.TypeOf((*MockAPI)(nil).ShowJournal), ctx, id)
+}
+
+// ShowMembership mocks base method.
+func (m *MockAPI) ShowMembership(ctx context.Context, id int) (*redmine.MembershipResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowMembership", ctx, id)
+	ret0, _ := ret[0].(*redmine.MembershipResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowMembership indicates an expected call of ShowMembership.
+func (mr *MockAPIMockRecorder) ShowMembership(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowMembership", reflect.TypeOf((*MockAPI)(nil).ShowMembership), ctx, id)
+}
+
+// ShowProject mocks base method.
+func (m *MockAPI) ShowProject(ctx context.Context, idOrIdentifier string, opts *redmine.ShowProjectOptions) (*redmine.ProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowProject", ctx, idOrIdentifier, opts)
+	ret0, _ := ret[0].(*redmine.ProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowProject indicates an expected call of ShowProject.
+func (mr *MockAPIMockRecorder) ShowProject(ctx, idOrIdentifier, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowProject", reflect.TypeOf((*MockAPI)(nil).ShowProject), ctx, idOrIdentifier, opts)
+}
+
+// ShowRole mocks base method.
+func (m *MockAPI) ShowRole(ctx context.Context, id int) (*redmine.RoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowRole", ctx, id)
+	ret0, _ := ret[0].(*redmine.RoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowRole indicates an expected call of ShowRole.
+func (mr *MockAPIMockRecorder) ShowRole(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowRole", reflect.TypeOf((*MockAPI)(nil).ShowRole), ctx, id)
+}
+
+// ShowTimeEntry mocks base method.
+func (m *MockAPI) ShowTimeEntry(ctx context.Context, id int) (*redmine.TimeEntryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowTimeEntry", ctx, id)
+	ret0, _ := ret[0].(*redmine.TimeEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowTimeEntry indicates an expected call of ShowTimeEntry.
+func (mr *MockAPIMockRecorder) ShowTimeEntry(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowTimeEntry", reflect.TypeOf((*MockAPI)(nil).ShowTimeEntry), ctx, id)
+}
+
+// ShowUser mocks base method.
+func (m *MockAPI) ShowUser(ctx context.Context, id int, opts *redmine.ShowUserOptions) (*redmine.UserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowUser", ctx, id, opts)
+	ret0, _ := ret[0].(*redmine.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowUser indicates an expected call of ShowUser.
+func (mr *MockAPIMockRecorder) ShowUser(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowUser", reflect.TypeOf((*MockAPI)(nil).ShowUser), ctx, id, opts)
+}
+
+// ShowVersion mocks base method.
+func (m *MockAPI) ShowVersion(ctx context.Context, id int) (*redmine.VersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowVersion", ctx, id)
+	ret0, _ := ret[0].(*redmine.VersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowVersion indicates an expected call of ShowVersion.
+func (mr *MockAPIMockRecorder) ShowVersion(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowVersion", reflect.TypeOf((*MockAPI)(nil).ShowVersion), ctx, id)
+}
+
+// UnarchiveProject mocks base method.
+func (m *MockAPI) UnarchiveProject(ctx context.Context, idOrIdentifier string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveProject", ctx, idOrIdentifier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnarchiveProject indicates an expected call of UnarchiveProject.
+func (mr *MockAPIMockRecorder) UnarchiveProject(ctx, idOrIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveProject", reflect.TypeOf((*MockAPI)(nil).UnarchiveProject), ctx, idOrIdentifier)
+}
+
+// UpdateAttachment mocks base method.
+func (m *MockAPI) UpdateAttachment(ctx context.Context, id int, attachment redmine.Attachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttachment", ctx, id, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttachment indicates an expected call of UpdateAttachment.
+func (mr *MockAPIMockRecorder) UpdateAttachment(ctx, id, attachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttachment", reflect.TypeOf((*MockAPI)(nil).UpdateAttachment), ctx, id, attachment)
+}
+
+// UpdateGroup mocks base method.
+func (m *MockAPI) UpdateGroup(ctx context.Context, id int, group redmine.Group) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroup", ctx, id, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGroup indicates an expected call of UpdateGroup.
+func (mr *MockAPIMockRecorder) UpdateGroup(ctx, id, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroup", reflect.TypeOf((*MockAPI)(nil).UpdateGroup), ctx, id, group)
+}
+
+// UpdateIssue mocks base method.
+func (m *MockAPI) UpdateIssue(ctx context.Context, id int, req redmine.IssueUpdateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIssue", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIssue indicates an expected call of UpdateIssue.
+func (mr *MockAPIMockRecorder) UpdateIssue(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIssue", reflect.TypeOf((*MockAPI)(nil).UpdateIssue), ctx, id, req)
+}
+
+// UpdateIssueCategory mocks base method.
+func (m *MockAPI) UpdateIssueCategory(ctx context.Context, id int, req redmine.IssueCategoryUpdateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIssueCategory", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIssueCategory indicates an expected call of UpdateIssueCategory.
+func (mr *MockAPIMockRecorder) UpdateIssueCategory(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIssueCategory", reflect.TypeOf((*MockAPI)(nil).UpdateIssueCategory), ctx, id, req)
+}
+
+// UpdateIssueIfUnmodified mocks base method.
+func (m *MockAPI) UpdateIssueIfUnmodified(ctx context.Context, id int, lastUpdatedOn redmine.Timestamp, req redmine.IssueUpdateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIssueIfUnmodified", ctx, id, lastUpdatedOn, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIssueIfUnmodified indicates an expected call of UpdateIssueIfUnmodified.
+func (mr *MockAPIMockRecorder) UpdateIssueIfUnmodified(ctx, id, lastUpdatedOn, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIssueIfUnmodified", reflect.TypeOf((*MockAPI)(nil).UpdateIssueIfUnmodified), ctx, id, lastUpdatedOn, req)
+}
+
+// UpdateMembership mocks base method.
+func (m *MockAPI) UpdateMembership(ctx context.Context, id int, roleIDs []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMembership", ctx, id, roleIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMembership indicates an expected call of UpdateMembership.
+func (mr *MockAPIMockRecorder) UpdateMembership(ctx, id, roleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMembership", reflect.TypeOf((*MockAPI)(nil).UpdateMembership), ctx, id, roleIDs)
+}
+
+// UpdateMyAccount mocks base method.
+func (m *MockAPI) UpdateMyAccount(ctx context.Context, user redmine.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMyAccount", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMyAccount indicates an expected call of UpdateMyAccount.
+func (mr *MockAPIMockRecorder) UpdateMyAccount(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMyAccount", reflect.TypeOf((*MockAPI)(nil).UpdateMyAccount), ctx, user)
+}
+
+// UpdateProject mocks base method.
+func (m *MockAPI) UpdateProject(ctx context.Context, idOrIdentifier string, req redmine.ProjectUpdateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", ctx, idOrIdentifier, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProject indicates an expected call of UpdateProject.
+func (mr *MockAPIMockRecorder) UpdateProject(ctx, idOrIdentifier, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockAPI)(nil).UpdateProject), ctx, idOrIdentifier, req)
+}
+
+// UpdateTimeEntry mocks base method.
+func (m *MockAPI) UpdateTimeEntry(ctx context.Context, id int, req redmine.TimeEntryUpdateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimeEntry", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTimeEntry indicates an expected call of UpdateTimeEntry.
+func (mr *MockAPIMockRecorder) UpdateTimeEntry(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimeEntry", reflect.TypeOf((*MockAPI)(nil).UpdateTimeEntry), ctx, id, req)
+}
+
+// UpdateUser mocks base method.
+func (m *MockAPI) UpdateUser(ctx context.Context, id int, user redmine.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, id, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockAPIMockRecorder) UpdateUser(ctx, id, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockAPI)(nil).UpdateUser), ctx, id, user)
+}
+
+// UpdateVersion mocks base method.
+func (m *MockAPI) UpdateVersion(ctx context.Context, id int, version redmine.Version) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVersion", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVersion indicates an expected call of UpdateVersion.
+func (mr *MockAPIMockRecorder) UpdateVersion(ctx, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVersion", reflect.TypeOf((*MockAPI)(nil).UpdateVersion), ctx, id, version)
+}
+
+// Upload mocks base method.
+func (m *MockAPI) Upload(ctx context.Context, filename string, r io.Reader, size int64) (*redmine.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, filename, r, size)
+	ret0, _ := ret[0].(*redmine.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockAPIMockRecorder) Upload(ctx, filename, r, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockAPI)(nil).Upload), ctx, filename, r, size)
+}
+
+// UploadFile mocks base method.
+func (m *MockAPI) UploadFile(ctx context.Context, projectIDOrIdentifier string, fileUpload redmine.FileUpload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadFile", ctx, projectIDOrIdentifier, fileUpload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadFile indicates an expected call of UploadFile.
+func (mr *MockAPIMockRecorder) UploadFile(ctx, projectIDOrIdentifier, fileUpload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*MockAPI)(nil).UploadFile), ctx, projectIDOrIdentifier, fileUpload)
+}
+
+// UploadWithOptions mocks base method.
+func (m *MockAPI) UploadWithOptions(ctx context.Context, filename string, r io.Reader, size int64, opts *redmine.UploadOptions) (*redmine.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadWithOptions", ctx, filename, r, size, opts)
+	ret0, _ := ret[0].(*redmine.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadWithOptions indicates an expected call of UploadWithOptions.
+func (mr *MockAPIMockRecorder) UploadWithOptions(ctx, filename, r, size, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadWithOptions", reflect.TypeOf((*MockAPI)(nil).UploadWithOptions), ctx, filename, r, size, opts)
+}
@@ -0,0 +1,38 @@
+package redminemock
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+var (
+	_ redmine.API                = (*MockAPI)(nil)
+	_ redmine.IssuesService      = (*MockIssuesService)(nil)
+	_ redmine.TimeEntriesService = (*MockTimeEntriesService)(nil)
+)
+
+func TestMockAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := NewMockAPI(ctrl)
+	ctx := context.Background()
+
+	// The Resolver lists trackers once and caches them
+	api.EXPECT().ListTrackers(gomock.Any()).Return(&redmine.TrackersResponse{
+		Trackers: []redmine.Tracker{{ID: 1, Name: "Bug"}, {ID: 2, Name: "Feature"}},
+	}, nil)
+
+	resolver := redmine.NewResolver(api)
+	for range 2 {
+		id, err := resolver.TrackerID(ctx, "feature")
+		if err != nil {
+			t.Fatalf("TrackerID failed: %v", err)
+		}
+		if id != 2 {
+			t.Errorf("Expected tracker 2, got %d", id)
+		}
+	}
+}
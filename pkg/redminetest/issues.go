@@ -0,0 +1,738 @@
+package redminetest
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// issueAttribute is an attribute of an issue that requests can set. key is
+// the name in request bodies and journal the name in journal details.
+type issueAttribute struct {
+	key     string
+	journal string
+	label   string
+	get     func(i *redmine.Issue) string
+	set     func(i *redmine.Issue, f fields, key string) bool
+}
+
+func setRef(ref func(i *redmine.Issue) *redmine.Resource) func(i *redmine.Issue, f fields, key string) bool {
+	return func(i *redmine.Issue, f fields, key string) bool {
+		id, ok := f.int(key)
+		*ref(i) = redmine.Resource{ID: id}
+		return ok
+	}
+}
+
+func getRef(ref func(i *redmine.Issue) *redmine.Resource) func(i *redmine.Issue) string {
+	return func(i *redmine.Issue) string {
+		if id := ref(i).ID; id > 0 {
+			return strconv.Itoa(id)
+		}
+		return ""
+	}
+}
+
+func refAttribute(key, journal, label string, ref func(i *redmine.Issue) *redmine.Resource) issueAttribute {
+	return issueAttribute{key: key, journal: journal, label: label, get: getRef(ref), set: setRef(ref)}
+}
+
+func dateAttribute(key, label string, date func(i *redmine.Issue) *redmine.Date) issueAttribute {
+	return issueAttribute{
+		key: key, journal: key, label: label,
+		get: func(i *redmine.Issue) string { return date(i).String() },
+		set: func(i *redmine.Issue, f fields, key string) bool {
+			d, ok := f.date(key)
+			*date(i) = d
+			return ok
+		},
+	}
+}
+
+// issueAttributes lists the attributes in the order Redmine records them.
+var issueAttributes = []issueAttribute{
+	refAttribute("project_id", "project_id", "Project", func(i *redmine.Issue) *redmine.Resource { return &i.Project }),
+	refAttribute("tracker_id", "tracker_id", "Tracker", func(i *redmine.Issue) *redmine.Resource { return &i.Tracker }),
+	{
+		key: "subject", journal: "subject", label: "Subject",
+		get: func(i *redmine.Issue) string { return i.Subject },
+		set: func(i *redmine.Issue, f fields, key string) bool { i.Subject = f.string(key); return true },
+	},
+	{
+		key: "description", journal: "description", label: "Description",
+		get: func(i *redmine.Issue) string { return i.Description },
+		set: func(i *redmine.Issue, f fields, key string) bool { i.Description = f.string(key); return true },
+	},
+	dateAttribute("due_date", "Due date", func(i *redmine.Issue) *redmine.Date { return &i.DueDate }),
+	refAttribute("category_id", "category_id", "Category", func(i *redmine.Issue) *redmine.Resource { return &i.Category }),
+	refAttribute("status_id", "status_id", "Status", func(i *redmine.Issue) *redmine.Resource { return &i.Status }),
+	refAttribute("assigned_to_id", "assigned_to_id", "Assignee", func(i *redmine.Issue) *redmine.Resource { return &i.AssignedTo }),
+	refAttribute("priority_id", "priority_id", "Priority", func(i *redmine.Issue) *redmine.Resource { return &i.Priority }),
+	refAttribute("fixed_version_id", "fixed_version_id", "Target version", func(i *redmine.Issue) *redmine.Resource { return &i.FixedVersion }),
+	refAttribute("parent_issue_id", "parent_id", "Parent task", func(i *redmine.Issue) *redmine.Resource { return &i.Parent }),
+	dateAttribute("start_date", "Start date", func(i *redmine.Issue) *redmine.Date { return &i.StartDate }),
+	{
+		key: "done_ratio", journal: "done_ratio", label: "% Done",
+		get: func(i *redmine.Issue) string { return strconv.Itoa(i.DoneRatio) },
+		set: func(i *redmine.Issue, f fields, key string) bool {
+			var ok bool
+			i.DoneRatio, ok = f.int(key)
+			return ok
+		},
+	},
+	{
+		key: "estimated_hours", journal: "estimated_hours", label: "Estimated time",
+		get: func(i *redmine.Issue) string { return formatFloat(i.EstimatedHours) },
+		set: func(i *redmine.Issue, f fields, key string) bool {
+			var ok bool
+			i.EstimatedHours, ok = f.float(key)
+			return ok
+		},
+	},
+	{
+		key: "is_private", journal: "is_private", label: "Private",
+		get: func(i *redmine.Issue) string { return strconv.Itoa(btoi(i.IsPrivate)) },
+		set: func(i *redmine.Issue, f fields, key string) bool { i.IsPrivate = f.bool(key); return true },
+	},
+}
+
+func btoi(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// reverseRelations maps each relation type to the type seen from the
+// related issue.
+var reverseRelations = map[string]string{
+	redmine.RelationRelates:    redmine.RelationRelates,
+	redmine.RelationDuplicates: redmine.RelationDuplicated,
+	redmine.RelationDuplicated: redmine.RelationDuplicates,
+	redmine.RelationBlocks:     redmine.RelationBlocked,
+	redmine.RelationBlocked:    redmine.RelationBlocks,
+	redmine.RelationPrecedes:   redmine.RelationFollows,
+	redmine.RelationFollows:    redmine.RelationPrecedes,
+	redmine.RelationCopiedTo:   redmine.RelationCopiedFrom,
+	redmine.RelationCopiedFrom: redmine.RelationCopiedTo,
+}
+
+func (s *Server) findIssue(id int) *redmine.Issue {
+	i := slices.IndexFunc(s.issues, func(i *redmine.Issue) bool { return i.ID == id })
+	if i < 0 {
+		return nil
+	}
+	return s.issues[i]
+}
+
+// canView reports whether user can see the issue. Private issues are only
+// visible to administrators, their author and their assignee.
+func (s *Server) canView(user *redmine.User, i *redmine.Issue) bool {
+	p := s.findProject(strconv.Itoa(i.Project.ID))
+	if !s.visible(user, p) || !s.allowed(user, p, "view_issues") {
+		return false
+	}
+	return !i.IsPrivate || (user != nil && (user.Admin || user.ID == i.Author.ID || user.ID == i.AssignedTo.ID))
+}
+
+// issueByID returns the issue named by the path value "id" if user can see
+// it, answering 404, 401 or 403 otherwise.
+func (s *Server) issueByID(w http.ResponseWriter, r *http.Request, user *redmine.User) *redmine.Issue {
+	i := s.findIssue(pathID(r, "id"))
+	if i == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return nil
+	}
+	if !s.canView(user, i) {
+		deny(w, user)
+		return nil
+	}
+	return i
+}
+
+func (s *Server) issueProject(i *redmine.Issue) *redmine.Project {
+	return s.findProject(strconv.Itoa(i.Project.ID))
+}
+
+func (s *Server) trackerRef(id int) redmine.Resource {
+	if t := s.findTracker(id); t != nil {
+		return redmine.Resource{ID: id, Name: t.Name}
+	}
+	return redmine.Resource{ID: id}
+}
+
+func (s *Server) statusRef(id int) redmine.Resource {
+	if st := s.findStatus(id); st != nil {
+		return redmine.Resource{ID: id, Name: st.Name}
+	}
+	return redmine.Resource{ID: id}
+}
+
+func enumerationRef(values []redmine.Enumeration, id int) redmine.Resource {
+	if e := findEnumeration(values, id); e != nil {
+		return redmine.Resource{ID: id, Name: e.Name}
+	}
+	return redmine.Resource{ID: id}
+}
+
+// spentHours returns the hours logged on an issue.
+func (s *Server) spentHours(issueID int) float64 {
+	var hours float64
+	for _, e := range s.timeEntries {
+		if e.Issue.ID == issueID {
+			hours += e.Hours
+		}
+	}
+	return hours
+}
+
+// issueJSON returns i as shown to user, with the associations listed in the
+// include parameter of r.
+func (s *Server) issueJSON(i *redmine.Issue, r *http.Request, user *redmine.User) redmine.Issue {
+	issue := *i
+	issue.Project = s.projectRef(i.Project.ID)
+	issue.Tracker = s.trackerRef(i.Tracker.ID)
+	issue.Status = s.statusRef(i.Status.ID)
+	issue.Priority = enumerationRef(s.priorities, i.Priority.ID)
+	issue.Author = s.userRef(i.Author.ID)
+	if i.AssignedTo.ID > 0 {
+		issue.AssignedTo = s.userRef(i.AssignedTo.ID)
+	}
+	if i.FixedVersion.ID > 0 {
+		issue.FixedVersion = s.versionRef(i.FixedVersion.ID)
+	}
+	if st := s.findStatus(i.Status.ID); st != nil {
+		issue.IsClosed = st.IsClosed
+	}
+	issue.SpentHours = s.spentHours(i.ID)
+	issue.TotalSpentHours, issue.TotalEstimatedHours = s.totalHours(i)
+
+	issue.Journals, issue.Watchers, issue.Relations, issue.Attachments, issue.Children = nil, nil, nil, nil, nil
+	if includes(r, "journals") {
+		for _, j := range i.Journals {
+			j.User = s.userRef(j.User.ID)
+			issue.Journals = append(issue.Journals, j)
+		}
+	}
+	if includes(r, "watchers") && s.allowed(user, s.issueProject(i), "view_issue_watchers") {
+		for _, w := range i.Watchers {
+			issue.Watchers = append(issue.Watchers, redmine.Watcher(s.userRef(w.ID)))
+		}
+	}
+	if includes(r, "relations") {
+		for _, rel := range s.relations {
+			if rel.IssueID == i.ID || rel.IssueToID == i.ID {
+				issue.Relations = append(issue.Relations, *rel)
+			}
+		}
+	}
+	if includes(r, "attachments") {
+		for _, a := range s.attachments {
+			if a.issueID == i.ID {
+				issue.Attachments = append(issue.Attachments, s.attachmentJSON(a))
+			}
+		}
+	}
+	if includes(r, "children") {
+		issue.Children = s.childrenJSON(i.ID, user)
+	}
+	if includes(r, "allowed_statuses") && s.allowed(user, s.issueProject(i), "edit_issues") {
+		issue.AllowedStatuses = s.statuses
+	}
+	return issue
+}
+
+// totalHours returns the hours spent on and estimated for i and its subtasks.
+func (s *Server) totalHours(i *redmine.Issue) (float64, float64) {
+	spent, estimated := s.spentHours(i.ID), i.EstimatedHours
+	for _, child := range s.issues {
+		if child.Parent.ID == i.ID {
+			childSpent, childEstimated := s.totalHours(child)
+			spent += childSpent
+			estimated += childEstimated
+		}
+	}
+	return spent, estimated
+}
+
+func (s *Server) childrenJSON(id int, user *redmine.User) []redmine.Issue {
+	var children []redmine.Issue
+	for _, child := range s.issues {
+		if child.Parent.ID == id && s.canView(user, child) {
+			children = append(children, redmine.Issue{
+				ID:       child.ID,
+				Tracker:  s.trackerRef(child.Tracker.ID),
+				Subject:  child.Subject,
+				Children: s.childrenJSON(child.ID, user),
+			})
+		}
+	}
+	return children
+}
+
+func (s *Server) listIssues(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	q := r.URL.Query()
+	var projects []int
+	if id := q.Get("project_id"); id != "" {
+		p := s.findProject(id)
+		if p == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if !s.visible(user, p) || !s.allowed(user, p, "view_issues") {
+			deny(w, user)
+			return
+		}
+		projects = []int{p.ID}
+		if q.Get("subproject_id") != redmine.OpNone {
+			projects = s.descendants(p)
+		}
+	}
+
+	match, errs := s.issueMatcher(issueConditions(q), user)
+	if len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+
+	var matched []*redmine.Issue
+	for _, i := range s.issues {
+		if (projects == nil || slices.Contains(projects, i.Project.ID)) && s.canView(user, i) && match(i) {
+			matched = append(matched, i)
+		}
+	}
+	s.sortIssues(matched, q.Get("sort"))
+
+	page, offset, limit := paginate(r, matched)
+	issues := make([]redmine.Issue, 0, len(page))
+	for _, i := range page {
+		issues = append(issues, s.issueJSON(i, r, user))
+	}
+	writeJSON(w, http.StatusOK, redmine.IssuesResponse{Issues: issues, TotalCount: len(matched), Offset: offset, Limit: limit})
+}
+
+func (s *Server) showIssue(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	i := s.issueByID(w, r, user)
+	if i == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, redmine.IssueResponse{Issue: s.issueJSON(i, r, user)})
+}
+
+func (s *Server) createIssue(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	f, ok := decodeFields(w, r, "issue")
+	if !ok {
+		return
+	}
+
+	projectID, _ := f.int("project_id")
+	if projectID == 0 {
+		// Redmine also accepts the identifier
+		if p := s.findProject(f.string("project_id")); p != nil {
+			projectID = p.ID
+			f["project_id"] = mustMarshal(p.ID)
+		}
+	}
+	p := s.findProject(strconv.Itoa(projectID))
+	if p == nil {
+		writeErrors(w, "Project cannot be blank")
+		return
+	}
+	if !s.visible(user, p) {
+		deny(w, user)
+		return
+	}
+	if !s.authorize(w, user, p, "add_issues") {
+		return
+	}
+
+	i := &redmine.Issue{
+		Tracker:  redmine.Resource{ID: s.trackers[0].ID},
+		Priority: redmine.Resource{ID: defaultEnumeration(s.priorities)},
+		Author:   redmine.Resource{ID: user.ID},
+	}
+	if _, errs := s.applyIssue(i, f); len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+	// Like Redmine, fall back to the first tracker when none is given
+	if i.Tracker.ID == 0 {
+		i.Tracker = redmine.Resource{ID: s.trackers[0].ID}
+	}
+	if !f.has("status_id") || i.Status.ID == 0 {
+		if t := s.findTracker(i.Tracker.ID); t != nil {
+			i.Status = redmine.Resource{ID: t.DefaultStatus.ID}
+		}
+	}
+	errs := s.validateIssue(i, nil)
+	watchers, ok := f.ints("watcher_user_ids")
+	if f.has("watcher_user_ids") && !ok {
+		errs = append(errs, "Watchers is invalid")
+	}
+	for _, id := range watchers {
+		if s.findUser(func(u *redmine.User) bool { return u.ID == id }) == nil {
+			errs = append(errs, "Watchers is invalid")
+			break
+		}
+		i.Watchers = append(i.Watchers, redmine.Watcher{ID: id})
+	}
+	uploads, uploadErrs := s.pendingUploads(f, user)
+	errs = append(errs, uploadErrs...)
+	if len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+
+	now := s.timestamp()
+	i.ID = s.nextID("issues")
+	i.CreatedOn, i.UpdatedOn = now, now
+	if st := s.findStatus(i.Status.ID); st != nil && st.IsClosed {
+		i.ClosedOn = now
+	}
+	for _, a := range uploads {
+		a.issueID = i.ID
+	}
+	s.issues = append(s.issues, i)
+	writeJSON(w, http.StatusCreated, redmine.IssueResponse{Issue: s.issueJSON(i, r, user)})
+}
+
+func (s *Server) updateIssue(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	i := s.issueByID(w, r, user)
+	if i == nil {
+		return
+	}
+	f, ok := decodeFields(w, r, "issue")
+	if !ok {
+		return
+	}
+
+	// Notes and files may be added with add_issue_notes alone
+	p := s.issueProject(i)
+	perm := "edit_issues"
+	if !slices.ContainsFunc(issueAttributes, func(a issueAttribute) bool { return f.has(a.key) }) && s.allowed(user, p, "add_issue_notes") {
+		perm = "add_issue_notes"
+	}
+	if !s.authorize(w, user, p, perm) {
+		return
+	}
+
+	updated := *i
+	details, errs := s.applyIssue(&updated, f)
+	if len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+	if updated.Project.ID != i.Project.ID {
+		target := s.findProject(strconv.Itoa(updated.Project.ID))
+		if target != nil && !s.allowed(user, target, "add_issues") {
+			errs = append(errs, "Project is invalid")
+		}
+	}
+	errs = append(errs, s.validateIssue(&updated, i)...)
+	uploads, uploadErrs := s.pendingUploads(f, user)
+	errs = append(errs, uploadErrs...)
+	if len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+
+	for _, a := range uploads {
+		a.issueID = i.ID
+		details = append(details, redmine.JournalDetail{Property: redmine.JournalPropertyAttachment, Name: strconv.Itoa(a.ID), NewValue: a.Filename})
+	}
+	notes := f.string("notes")
+	if len(details) == 0 && notes == "" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	now := s.timestamp()
+	oldStatus, newStatus := s.findStatus(i.Status.ID), s.findStatus(updated.Status.ID)
+	if newStatus != nil && newStatus.IsClosed && (oldStatus == nil || !oldStatus.IsClosed) {
+		updated.ClosedOn = now
+	}
+	updated.UpdatedOn = now
+	*i = updated
+	s.addJournal(i, user.ID, notes, details, now)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// applyIssue applies the attributes in f to i and returns the journal
+// details of the changes, or the errors of attributes that could not be
+// read.
+func (s *Server) applyIssue(i *redmine.Issue, f fields) ([]redmine.JournalDetail, []string) {
+	var details []redmine.JournalDetail
+	var errs []string
+	for _, a := range issueAttributes {
+		if !f.has(a.key) {
+			continue
+		}
+		old := a.get(i)
+		if !a.set(i, f, a.key) {
+			errs = append(errs, a.label+" is invalid")
+			continue
+		}
+		if v := a.get(i); v != old {
+			details = append(details, redmine.JournalDetail{Property: redmine.JournalPropertyAttr, Name: a.journal, OldValue: old, NewValue: v})
+		}
+	}
+	return details, errs
+}
+
+// validateIssue checks i, which was old before the change, if any.
+func (s *Server) validateIssue(i, old *redmine.Issue) []string {
+	var errs []string
+	p := s.findProject(strconv.Itoa(i.Project.ID))
+	if p == nil {
+		errs = append(errs, "Project cannot be blank")
+	}
+	switch {
+	case i.Tracker.ID == 0:
+		errs = append(errs, "Tracker cannot be blank")
+	case s.findTracker(i.Tracker.ID) == nil:
+		errs = append(errs, "Tracker is not included in the list")
+	}
+	switch {
+	case i.Subject == "":
+		errs = append(errs, "Subject cannot be blank")
+	case len([]rune(i.Subject)) > 255:
+		errs = append(errs, "Subject is too long (maximum is 255 characters)")
+	}
+	if s.findStatus(i.Status.ID) == nil {
+		errs = append(errs, "Status is not included in the list")
+	}
+	if findEnumeration(s.priorities, i.Priority.ID) == nil {
+		errs = append(errs, "Priority is not included in the list")
+	}
+	if i.Category.ID > 0 {
+		errs = append(errs, "Category is not included in the list")
+	}
+	if id := i.AssignedTo.ID; id > 0 && (old == nil || id != old.AssignedTo.ID || i.Project.ID != old.Project.ID) {
+		if m := s.findMembership(i.Project.ID, id); m == nil || !slices.ContainsFunc(m.Roles, func(r redmine.Resource) bool {
+			role := s.findRole(r.ID)
+			return role != nil && role.Assignable
+		}) {
+			errs = append(errs, "Assignee is invalid")
+		}
+	}
+	if id := i.FixedVersion.ID; id > 0 {
+		v := s.findVersion(id)
+		changed := old == nil || id != old.FixedVersion.ID
+		if v == nil || v.Project.ID != i.Project.ID || (changed && v.Status != "open") {
+			errs = append(errs, "Target version is not included in the list")
+		}
+	}
+	if id := i.Parent.ID; id > 0 {
+		parent := s.findIssue(id)
+		if parent == nil || id == i.ID || s.isDescendant(id, i.ID) {
+			errs = append(errs, "Parent task is invalid")
+		}
+	}
+	if !i.StartDate.IsZero() && !i.DueDate.IsZero() && i.DueDate.Before(i.StartDate.Time) {
+		errs = append(errs, "Due date must be greater than start date")
+	}
+	if i.DoneRatio < 0 || i.DoneRatio > 100 {
+		errs = append(errs, "% Done is not included in the list")
+	}
+	if i.EstimatedHours < 0 {
+		errs = append(errs, "Estimated time is invalid")
+	}
+	return errs
+}
+
+// isDescendant reports whether the issue id is a subtask of ancestor, at any
+// depth.
+func (s *Server) isDescendant(id, ancestor int) bool {
+	for i := s.findIssue(id); i != nil && i.Parent.ID > 0; i = s.findIssue(i.Parent.ID) {
+		if i.Parent.ID == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) addJournal(i *redmine.Issue, userID int, notes string, details []redmine.JournalDetail, now redmine.Timestamp) {
+	i.Journals = append(i.Journals, redmine.Journal{
+		ID:        s.nextID("journals"),
+		User:      redmine.Resource{ID: userID},
+		Notes:     notes,
+		CreatedOn: now,
+		Details:   details,
+	})
+}
+
+func (s *Server) deleteIssue(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	i := s.issueByID(w, r, user)
+	if i == nil || !s.authorize(w, user, s.issueProject(i), "delete_issues") {
+		return
+	}
+	s.removeIssue(i.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// removeIssue deletes an issue with its subtasks, relations, time entries
+// and attachments.
+func (s *Server) removeIssue(id int) {
+	for _, child := range slices.Clone(s.issues) {
+		if child.Parent.ID == id {
+			s.removeIssue(child.ID)
+		}
+	}
+	s.issues = slices.DeleteFunc(s.issues, func(i *redmine.Issue) bool { return i.ID == id })
+	s.relations = slices.DeleteFunc(s.relations, func(r *redmine.IssueRelation) bool { return r.IssueID == id || r.IssueToID == id })
+	s.timeEntries = slices.DeleteFunc(s.timeEntries, func(e *redmine.TimeEntry) bool { return e.Issue.ID == id })
+	s.attachments = slices.DeleteFunc(s.attachments, func(a *attachment) bool { return a.issueID == id })
+}
+
+func (s *Server) addWatcher(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	i := s.issueByID(w, r, user)
+	if i == nil || !s.authorize(w, user, s.issueProject(i), "add_issue_watchers") {
+		return
+	}
+	f, ok := decodeFields(w, r, "")
+	if !ok {
+		return
+	}
+
+	id, _ := f.int("user_id")
+	if s.findUser(func(u *redmine.User) bool { return u.ID == id }) == nil {
+		writeErrors(w, "User is invalid")
+		return
+	}
+	if !slices.ContainsFunc(i.Watchers, func(w redmine.Watcher) bool { return w.ID == id }) {
+		i.Watchers = append(i.Watchers, redmine.Watcher{ID: id})
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) removeWatcher(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	i := s.issueByID(w, r, user)
+	if i == nil {
+		return
+	}
+	id := pathID(r, "user")
+	if !(user != nil && user.ID == id) && !s.authorize(w, user, s.issueProject(i), "delete_issue_watchers") {
+		return
+	}
+	if !slices.ContainsFunc(i.Watchers, func(w redmine.Watcher) bool { return w.ID == id }) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	i.Watchers = slices.DeleteFunc(i.Watchers, func(w redmine.Watcher) bool { return w.ID == id })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listRelations(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	i := s.issueByID(w, r, user)
+	if i == nil {
+		return
+	}
+
+	relations := []redmine.IssueRelation{}
+	for _, rel := range s.relations {
+		if rel.IssueID == i.ID || rel.IssueToID == i.ID {
+			relations = append(relations, *rel)
+		}
+	}
+	writeJSON(w, http.StatusOK, redmine.IssueRelationsResponse{Relations: relations})
+}
+
+func (s *Server) createRelation(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	i := s.issueByID(w, r, user)
+	if i == nil || !s.authorize(w, user, s.issueProject(i), "manage_issue_relations") {
+		return
+	}
+	f, ok := decodeFields(w, r, "relation")
+	if !ok {
+		return
+	}
+
+	rel := &redmine.IssueRelation{IssueID: i.ID, RelationType: f.string("relation_type")}
+	if rel.RelationType == "" {
+		rel.RelationType = redmine.RelationRelates
+	}
+	rel.IssueToID, _ = f.int("issue_to_id")
+	rel.Delay, _ = f.int("delay")
+
+	var errs []string
+	if _, ok := reverseRelations[rel.RelationType]; !ok {
+		errs = append(errs, "Type is not included in the list")
+	}
+	to := s.findIssue(rel.IssueToID)
+	switch {
+	case to == nil || !s.canView(user, to):
+		errs = append(errs, "Related issue cannot be blank")
+	case to.ID == i.ID:
+		errs = append(errs, "Related issue is invalid")
+	case slices.ContainsFunc(s.relations, func(other *redmine.IssueRelation) bool {
+		return (other.IssueID == i.ID && other.IssueToID == to.ID) || (other.IssueID == to.ID && other.IssueToID == i.ID)
+	}):
+		errs = append(errs, "Related issue has already been taken")
+	}
+	if len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+
+	// Redmine stores follows, blocked, duplicated and copied_from relations
+	// from the other side
+	switch rel.RelationType {
+	case redmine.RelationFollows, redmine.RelationBlocked, redmine.RelationDuplicated, redmine.RelationCopiedFrom:
+		rel.IssueID, rel.IssueToID = rel.IssueToID, rel.IssueID
+		rel.RelationType = reverseRelations[rel.RelationType]
+	}
+	if rel.RelationType != redmine.RelationPrecedes {
+		rel.Delay = 0
+	}
+	rel.ID = s.nextID("relations")
+	s.relations = append(s.relations, rel)
+	s.journalRelation(rel, user, false)
+	writeJSON(w, http.StatusCreated, redmine.IssueRelationResponse{Relation: *rel})
+}
+
+// relationByID returns the relation named by the path value "id" if user
+// can see both issues, answering 404 otherwise.
+func (s *Server) relationByID(w http.ResponseWriter, r *http.Request, user *redmine.User) *redmine.IssueRelation {
+	id := pathID(r, "id")
+	i := slices.IndexFunc(s.relations, func(r *redmine.IssueRelation) bool { return r.ID == id })
+	if i < 0 || !s.canView(user, s.findIssue(s.relations[i].IssueID)) || !s.canView(user, s.findIssue(s.relations[i].IssueToID)) {
+		w.WriteHeader(http.StatusNotFound)
+		return nil
+	}
+	return s.relations[i]
+}
+
+func (s *Server) showRelation(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	rel := s.relationByID(w, r, user)
+	if rel == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, redmine.IssueRelationResponse{Relation: *rel})
+}
+
+func (s *Server) deleteRelation(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	rel := s.relationByID(w, r, user)
+	if rel == nil || !s.authorize(w, user, s.issueProject(s.findIssue(rel.IssueID)), "manage_issue_relations") {
+		return
+	}
+	s.relations = slices.DeleteFunc(s.relations, func(other *redmine.IssueRelation) bool { return other == rel })
+	s.journalRelation(rel, user, true)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// journalRelation records a relation that was added or removed on both
+// issues, as Redmine does.
+func (s *Server) journalRelation(rel *redmine.IssueRelation, user *redmine.User, removed bool) {
+	now := s.timestamp()
+	record := func(issueID int, relationType string, other int) {
+		detail := redmine.JournalDetail{Property: redmine.JournalPropertyRelation, Name: relationType, NewValue: strconv.Itoa(other)}
+		if removed {
+			detail.OldValue, detail.NewValue = detail.NewValue, ""
+		}
+		s.addJournal(s.findIssue(issueID), user.ID, "", []redmine.JournalDetail{detail}, now)
+	}
+	record(rel.IssueID, rel.RelationType, rel.IssueToID)
+	record(rel.IssueToID, reverseRelations[rel.RelationType], rel.IssueID)
+}
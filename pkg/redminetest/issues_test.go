@@ -0,0 +1,330 @@
+package redminetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// clock is a settable time source for WithClock.
+type clock struct {
+	t time.Time
+}
+
+func (c *clock) now() time.Time {
+	return c.t
+}
+
+func (c *clock) advance(d time.Duration) {
+	c.t = c.t.Add(d)
+}
+
+func TestServerCreateIssue(t *testing.T) {
+	srv, public, _, jsmith, _ := newTestServer(t)
+	client := srv.Client(jsmith.APIKey)
+	ctx := context.Background()
+
+	created, err := client.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "Cannot print recipes", AssignedToID: jsmith.ID})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	i := created.Issue
+	if i.Tracker.Name != "Bug" || i.Status.Name != "New" || i.Priority.Name != "Normal" {
+		t.Errorf("Expected Bug, New and Normal defaults, got %+v", i)
+	}
+	if i.Author.ID != jsmith.ID || i.AssignedTo.Name != "John Smith" || i.Project.Name != "eCookbook" {
+		t.Errorf("Unexpected references: %+v", i)
+	}
+	if i.CreatedOn.IsZero() || !i.UpdatedOn.Equal(i.CreatedOn.Time) {
+		t.Errorf("Expected created_on and updated_on to be set, got %v and %v", i.CreatedOn, i.UpdatedOn)
+	}
+
+	shown, err := client.ShowIssue(ctx, i.ID, nil)
+	if err != nil {
+		t.Fatalf("ShowIssue failed: %v", err)
+	}
+	if shown.Issue.Subject != "Cannot print recipes" {
+		t.Errorf("Expected the issue to be stored, got %+v", shown.Issue)
+	}
+}
+
+func TestServerCreateIssueValidation(t *testing.T) {
+	srv, public, private, jsmith, dlopper := newTestServer(t)
+	ctx := context.Background()
+
+	closed, err := srv.Client(srv.Admin.APIKey).CreateVersion(ctx, public.Identifier, redmine.Version{Name: "0.9", Status: "closed"})
+	if err != nil {
+		t.Fatalf("CreateVersion failed: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  redmine.IssueCreateRequest
+		want []string
+	}{
+		{name: "blank subject", req: redmine.IssueCreateRequest{ProjectID: public.ID}, want: []string{"Subject cannot be blank"}},
+		{name: "unknown tracker", req: redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "S", TrackerID: 99, StatusID: 1}, want: []string{"Tracker is not included in the list"}},
+		{name: "unknown priority", req: redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "S", PriorityID: 99}, want: []string{"Priority is not included in the list"}},
+		{name: "reporters are not assignable", req: redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "S", AssignedToID: dlopper.ID}, want: []string{"Assignee is invalid"}},
+		{name: "closed version", req: redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "S", FixedVersionID: closed.Version.ID}, want: []string{"Target version is not included in the list"}},
+		{name: "version of another project", req: redmine.IssueCreateRequest{ProjectID: private.ID, Subject: "S", FixedVersionID: closed.Version.ID}, want: []string{"Target version is not included in the list"}},
+		{name: "unknown parent", req: redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "S", ParentIssueID: 999}, want: []string{"Parent task is invalid"}},
+		{name: "dates", req: redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "S", StartDate: redmine.NewDate(2025, 5, 2), DueDate: redmine.NewDate(2025, 5, 1)}, want: []string{"Due date must be greater than start date"}},
+		{name: "done ratio", req: redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "S", DoneRatio: 110}, want: []string{"% Done is not included in the list"}},
+		{name: "unknown project", req: redmine.IssueCreateRequest{ProjectID: 999, Subject: "S"}, want: []string{"Project cannot be blank"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := srv.Client(jsmith.APIKey).CreateIssue(ctx, tt.req)
+			expectErrors(t, err, tt.want...)
+		})
+	}
+}
+
+func TestServerUpdateIssue(t *testing.T) {
+	c := &clock{t: time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)}
+	srv, public, _, jsmith, dlopper := newTestServer(t, WithClock(c.now))
+	client := srv.Client(jsmith.APIKey)
+	ctx := context.Background()
+
+	created, err := client.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "Slow search"})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	id := created.Issue.ID
+
+	c.advance(time.Hour)
+	if err := client.UpdateIssue(ctx, id, redmine.IssueUpdateRequest{StatusID: 5, AssignedToID: jsmith.ID, Notes: "Fixed by caching"}); err != nil {
+		t.Fatalf("UpdateIssue failed: %v", err)
+	}
+	// A request that changes nothing does not touch the issue
+	c.advance(time.Hour)
+	if err := client.UpdateIssue(ctx, id, redmine.IssueUpdateRequest{StatusID: 5}); err != nil {
+		t.Fatalf("UpdateIssue failed: %v", err)
+	}
+
+	shown, err := client.ShowIssue(ctx, id, &redmine.ShowIssueOptions{Include: "journals"})
+	if err != nil {
+		t.Fatalf("ShowIssue failed: %v", err)
+	}
+	i := shown.Issue
+	closedAt := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	if !i.UpdatedOn.Equal(closedAt) || !i.ClosedOn.Equal(closedAt) {
+		t.Errorf("Expected updated_on and closed_on %v, got %v and %v", closedAt, i.UpdatedOn, i.ClosedOn)
+	}
+	if len(i.Journals) != 1 {
+		t.Fatalf("Expected 1 journal, got %+v", i.Journals)
+	}
+	j := i.Journals[0]
+	if j.Notes != "Fixed by caching" || j.User.Name != "John Smith" || len(j.Details) != 2 {
+		t.Errorf("Unexpected journal: %+v", j)
+	}
+	if d := j.Details[0]; d.Name != "status_id" || d.OldValue != "1" || d.NewValue != "5" {
+		t.Errorf("Unexpected status change: %+v", d)
+	}
+
+	history, err := client.GetIssueHistory(ctx, id)
+	if err != nil {
+		t.Fatalf("GetIssueHistory failed: %v", err)
+	}
+	if history.Initial().StatusID() != 1 || history.Current().StatusID() != 5 {
+		t.Errorf("Expected status to go from 1 to 5, got %d to %d", history.Initial().StatusID(), history.Current().StatusID())
+	}
+
+	// Reporters may comment but not edit
+	if err := srv.Client(dlopper.APIKey).UpdateIssue(ctx, id, redmine.IssueUpdateRequest{Notes: "Confirmed"}); err != nil {
+		t.Errorf("Expected reporters to add notes, got %v", err)
+	}
+	err = client.UpdateIssue(ctx, id, redmine.IssueUpdateRequest{ParentIssueID: id})
+	expectErrors(t, err, "Parent task is invalid")
+}
+
+func TestServerUpdateIssueIfUnmodified(t *testing.T) {
+	c := &clock{t: time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)}
+	srv, public, _, jsmith, _ := newTestServer(t, WithClock(c.now))
+	client := srv.Client(jsmith.APIKey)
+	ctx := context.Background()
+
+	created, err := client.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "Stale"})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	read := created.Issue
+
+	c.advance(time.Minute)
+	if err := srv.Client(srv.Admin.APIKey).UpdateIssue(ctx, read.ID, redmine.IssueUpdateRequest{PriorityID: 3}); err != nil {
+		t.Fatalf("UpdateIssue failed: %v", err)
+	}
+
+	err = client.UpdateIssueIfUnmodified(ctx, read.ID, read.UpdatedOn, redmine.IssueUpdateRequest{Subject: "Mine"})
+	var conflict *redmine.ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("Expected a conflict, got %v", err)
+	}
+	if conflict.Issue.Priority.ID != 3 {
+		t.Errorf("Expected the current issue in the conflict, got %+v", conflict.Issue)
+	}
+
+	if err := client.UpdateIssueIfUnmodified(ctx, read.ID, conflict.Issue.UpdatedOn, redmine.IssueUpdateRequest{Subject: "Mine"}); err != nil {
+		t.Errorf("Expected the rebased update to succeed, got %v", err)
+	}
+}
+
+func TestServerIssueRelations(t *testing.T) {
+	srv, public, _, jsmith, _ := newTestServer(t)
+	client := srv.Client(jsmith.APIKey)
+	ctx := context.Background()
+
+	var ids []int
+	for _, subject := range []string{"Design", "Build", "Ship"} {
+		created, err := client.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: public.ID, Subject: subject})
+		if err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+		ids = append(ids, created.Issue.ID)
+	}
+
+	// "Build follows Design" is stored as "Design precedes Build"
+	created, err := client.CreateIssueRelation(ctx, ids[1], redmine.IssueRelation{IssueToID: ids[0], RelationType: redmine.RelationFollows, Delay: 2})
+	if err != nil {
+		t.Fatalf("CreateIssueRelation failed: %v", err)
+	}
+	rel := created.Relation
+	if rel.IssueID != ids[0] || rel.IssueToID != ids[1] || rel.RelationType != redmine.RelationPrecedes || rel.Delay != 2 {
+		t.Errorf("Unexpected relation: %+v", rel)
+	}
+
+	_, err = client.CreateIssueRelation(ctx, ids[0], redmine.IssueRelation{IssueToID: ids[1], RelationType: redmine.RelationRelates})
+	expectErrors(t, err, "Related issue has already been taken")
+	_, err = client.CreateIssueRelation(ctx, ids[0], redmine.IssueRelation{IssueToID: ids[0], RelationType: redmine.RelationBlocks})
+	expectErrors(t, err, "Related issue is invalid")
+	_, err = client.CreateIssueRelation(ctx, ids[0], redmine.IssueRelation{IssueToID: ids[2], RelationType: "causes"})
+	expectErrors(t, err, "Type is not included in the list")
+
+	list, err := client.ListIssueRelations(ctx, ids[1])
+	if err != nil {
+		t.Fatalf("ListIssueRelations failed: %v", err)
+	}
+	if len(list.Relations) != 1 {
+		t.Errorf("Expected 1 relation, got %+v", list.Relations)
+	}
+
+	// Both issues record the relation
+	shown, err := client.ShowIssue(ctx, ids[1], &redmine.ShowIssueOptions{Include: "journals,relations"})
+	if err != nil {
+		t.Fatalf("ShowIssue failed: %v", err)
+	}
+	if len(shown.Issue.Relations) != 1 || len(shown.Issue.Journals) != 1 {
+		t.Fatalf("Expected 1 relation and 1 journal, got %+v", shown.Issue)
+	}
+	if d := shown.Issue.Journals[0].Details[0]; d.Property != redmine.JournalPropertyRelation || d.Name != redmine.RelationFollows || d.NewValue != "1" {
+		t.Errorf("Unexpected journal detail: %+v", d)
+	}
+
+	if err := client.DeleteIssueRelation(ctx, rel.ID); err != nil {
+		t.Fatalf("DeleteIssueRelation failed: %v", err)
+	}
+	if _, err := client.ShowIssueRelation(ctx, rel.ID); !errors.Is(err, redmine.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestServerWatchers(t *testing.T) {
+	srv, public, private, jsmith, dlopper := newTestServer(t)
+	client := srv.Client(jsmith.APIKey)
+	ctx := context.Background()
+
+	created, err := client.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "Watched", WatcherUserIDs: []int{jsmith.ID}})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	id := created.Issue.ID
+
+	if err := client.AddWatcher(ctx, id, dlopper.ID); err != nil {
+		t.Fatalf("AddWatcher failed: %v", err)
+	}
+	shown, err := client.ShowIssue(ctx, id, &redmine.ShowIssueOptions{Include: "watchers"})
+	if err != nil {
+		t.Fatalf("ShowIssue failed: %v", err)
+	}
+	if len(shown.Issue.Watchers) != 2 || shown.Issue.Watchers[1].Name != "Dave Lopper" {
+		t.Errorf("Unexpected watchers: %+v", shown.Issue.Watchers)
+	}
+
+	// Watched issues can be found with the watcher filter
+	watched, err := srv.Client(dlopper.APIKey).ListIssues(ctx, &redmine.ListIssuesOptions{WatcherID: "me"})
+	if err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+	if len(watched.Issues) != 1 || watched.Issues[0].ID != id {
+		t.Errorf("Expected issue %d to be watched, got %+v", id, watched.Issues)
+	}
+
+	// Developers cannot remove other watchers, but anyone can stop watching
+	if err := client.RemoveWatcher(ctx, id, dlopper.ID); !errors.Is(err, redmine.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+	if err := srv.Client(dlopper.APIKey).RemoveWatcher(ctx, id, dlopper.ID); err != nil {
+		t.Errorf("RemoveWatcher failed: %v", err)
+	}
+	if err := srv.Client(dlopper.APIKey).RemoveWatcher(ctx, id, dlopper.ID); !errors.Is(err, redmine.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound when not watching, got %v", err)
+	}
+
+	// Watchers of a private project issue are hidden from outsiders
+	hidden, err := client.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: private.ID, Subject: "Hidden"})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	outsider := srv.AddUser(redmine.User{Login: "outsider", Firstname: "Out", Lastname: "Sider", Mail: "outsider@example.net"})
+	if _, err := srv.Client(outsider.APIKey).ShowIssue(ctx, hidden.Issue.ID, nil); !errors.Is(err, redmine.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestServerDeleteIssue(t *testing.T) {
+	srv, public, _, jsmith, _ := newTestServer(t)
+	admin := srv.Client(srv.Admin.APIKey)
+	ctx := context.Background()
+
+	parent, err := admin.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "Parent"})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	child, err := admin.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "Child", ParentIssueID: parent.Issue.ID})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	if _, err := admin.CreateTimeEntry(ctx, redmine.TimeEntryCreateRequest{IssueID: child.Issue.ID, Hours: 1.5}); err != nil {
+		t.Fatalf("CreateTimeEntry failed: %v", err)
+	}
+
+	shown, err := admin.ShowIssue(ctx, parent.Issue.ID, &redmine.ShowIssueOptions{Include: "children"})
+	if err != nil {
+		t.Fatalf("ShowIssue failed: %v", err)
+	}
+	if len(shown.Issue.Children) != 1 || shown.Issue.TotalSpentHours != 1.5 {
+		t.Errorf("Expected the child and its time, got %+v", shown.Issue)
+	}
+
+	if err := srv.Client(jsmith.APIKey).DeleteIssue(ctx, parent.Issue.ID); !errors.Is(err, redmine.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden for developers, got %v", err)
+	}
+	if err := admin.DeleteIssue(ctx, parent.Issue.ID); err != nil {
+		t.Fatalf("DeleteIssue failed: %v", err)
+	}
+	if _, err := admin.ShowIssue(ctx, child.Issue.ID, nil); !errors.Is(err, redmine.ErrNotFound) {
+		t.Errorf("Expected the child to be deleted, got %v", err)
+	}
+	entries, err := admin.ListTimeEntries(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListTimeEntries failed: %v", err)
+	}
+	if len(entries.TimeEntries) != 0 {
+		t.Errorf("Expected time entries to be deleted, got %+v", entries.TimeEntries)
+	}
+}
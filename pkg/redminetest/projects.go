@@ -0,0 +1,564 @@
+package redminetest
+
+import (
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// identifierPattern matches valid project identifiers.
+var identifierPattern = regexp.MustCompile(`^[a-z][a-z0-9_\-]{0,99}$`)
+
+// findProject returns the project with the given ID or identifier.
+func (s *Server) findProject(idOrIdentifier string) *redmine.Project {
+	id, _ := strconv.Atoi(idOrIdentifier)
+	i := slices.IndexFunc(s.projects, func(p *redmine.Project) bool {
+		return p.ID == id || p.Identifier == idOrIdentifier
+	})
+	if i < 0 {
+		return nil
+	}
+	return s.projects[i]
+}
+
+// visibleProject returns the project named by the path value name. It
+// answers 404 if there is no such project and 401 or 403 if user cannot see
+// it.
+func (s *Server) visibleProject(w http.ResponseWriter, r *http.Request, user *redmine.User, name string) *redmine.Project {
+	p := s.findProject(pathValue(r, name))
+	if p == nil || (p.Status == projectArchived && (user == nil || !user.Admin)) {
+		w.WriteHeader(http.StatusNotFound)
+		return nil
+	}
+	if !s.visible(user, p) {
+		deny(w, user)
+		return nil
+	}
+	return p
+}
+
+// visible reports whether user can see project.
+func (s *Server) visible(user *redmine.User, project *redmine.Project) bool {
+	if user != nil && (user.Admin || s.findMembership(project.ID, user.ID) != nil) {
+		return true
+	}
+	return project.IsPublic && project.Status != projectArchived
+}
+
+// descendants returns the IDs of project and its subprojects.
+func (s *Server) descendants(project *redmine.Project) []int {
+	ids := []int{project.ID}
+	for _, p := range s.projects {
+		if p.Parent.ID == project.ID {
+			ids = append(ids, s.descendants(p)...)
+		}
+	}
+	return ids
+}
+
+func (s *Server) projectRef(id int) redmine.Resource {
+	i := slices.IndexFunc(s.projects, func(p *redmine.Project) bool { return p.ID == id })
+	if i < 0 {
+		return redmine.Resource{ID: id}
+	}
+	return redmine.Resource{ID: id, Name: s.projects[i].Name}
+}
+
+func (s *Server) projectJSON(p *redmine.Project, r *http.Request) redmine.Project {
+	project := *p
+	if p.Parent.ID > 0 {
+		project.Parent = s.projectRef(p.Parent.ID)
+	}
+	if p.DefaultAssignedTo.ID > 0 {
+		project.DefaultAssignedTo = s.userRef(p.DefaultAssignedTo.ID)
+	}
+	if p.DefaultVersion.ID > 0 {
+		project.DefaultVersion = s.versionRef(p.DefaultVersion.ID)
+	}
+	project.Trackers, project.TimeEntryActivities = nil, nil
+	if includes(r, "trackers") {
+		for _, t := range s.trackers {
+			project.Trackers = append(project.Trackers, redmine.Resource{ID: t.ID, Name: t.Name})
+		}
+	}
+	if includes(r, "time_entry_activities") {
+		for _, a := range s.activities {
+			project.TimeEntryActivities = append(project.TimeEntryActivities, redmine.Resource{ID: a.ID, Name: a.Name})
+		}
+	}
+	return project
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	var projects []redmine.Project
+	for _, p := range s.projects {
+		if p.Status != projectArchived && s.visible(user, p) {
+			projects = append(projects, s.projectJSON(p, r))
+		}
+	}
+
+	page, offset, limit := paginate(r, projects)
+	writeJSON(w, http.StatusOK, redmine.ProjectsResponse{Projects: page, TotalCount: len(projects), Offset: offset, Limit: limit})
+}
+
+func (s *Server) showProject(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	p := s.visibleProject(w, r, user, "project")
+	if p == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, redmine.ProjectResponse{Project: s.projectJSON(p, r)})
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	if !requireAdmin(w, user) {
+		return
+	}
+	f, ok := decodeFields(w, r, "project")
+	if !ok {
+		return
+	}
+
+	p := redmine.Project{
+		Name:           f.string("name"),
+		Identifier:     f.string("identifier"),
+		Description:    f.string("description"),
+		Homepage:       f.string("homepage"),
+		IsPublic:       !f.has("is_public") || f.bool("is_public"),
+		InheritMembers: f.bool("inherit_members"),
+	}
+	var errs []string
+	if p.Name == "" {
+		errs = append(errs, "Name cannot be blank")
+	}
+	switch {
+	case p.Identifier == "":
+		errs = append(errs, "Identifier cannot be blank")
+	case !identifierPattern.MatchString(p.Identifier):
+		errs = append(errs, "Identifier is invalid")
+	case s.findProject(p.Identifier) != nil:
+		errs = append(errs, "Identifier has already been taken")
+	}
+	errs = append(errs, s.setProjectParent(&p, f)...)
+	if len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+
+	now := s.timestamp()
+	p.ID = s.nextID("projects")
+	p.Status = projectActive
+	p.CreatedOn, p.UpdatedOn = now, now
+	s.projects = append(s.projects, &p)
+	writeJSON(w, http.StatusCreated, redmine.ProjectResponse{Project: s.projectJSON(&p, r)})
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	p := s.visibleProject(w, r, user, "project")
+	if p == nil || !s.authorize(w, user, p, "edit_project") {
+		return
+	}
+	f, ok := decodeFields(w, r, "project")
+	if !ok {
+		return
+	}
+
+	updated := *p
+	var errs []string
+	if f.has("name") {
+		if updated.Name = f.string("name"); updated.Name == "" {
+			errs = append(errs, "Name cannot be blank")
+		}
+	}
+	if f.has("description") {
+		updated.Description = f.string("description")
+	}
+	if f.has("homepage") {
+		updated.Homepage = f.string("homepage")
+	}
+	if f.has("is_public") {
+		updated.IsPublic = f.bool("is_public")
+	}
+	if f.has("inherit_members") {
+		updated.InheritMembers = f.bool("inherit_members")
+	}
+	errs = append(errs, s.setProjectParent(&updated, f)...)
+	if updated.Parent.ID > 0 && slices.Contains(s.descendants(p), updated.Parent.ID) {
+		errs = append(errs, "Subproject of is invalid")
+	}
+	if f.has("default_version_id") {
+		id, _ := f.int("default_version_id")
+		if v := s.findVersion(id); id > 0 && (v == nil || v.Project.ID != p.ID) {
+			errs = append(errs, "Default version is invalid")
+		}
+		updated.DefaultVersion = redmine.Resource{ID: id}
+	}
+	if f.has("default_assigned_to_id") {
+		id, _ := f.int("default_assigned_to_id")
+		if id > 0 && s.findMembership(p.ID, id) == nil {
+			errs = append(errs, "Default assignee is invalid")
+		}
+		updated.DefaultAssignedTo = redmine.Resource{ID: id}
+	}
+	if len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+
+	updated.UpdatedOn = s.timestamp()
+	*p = updated
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// setProjectParent applies parent_id from f to p and returns the validation
+// errors.
+func (s *Server) setProjectParent(p *redmine.Project, f fields) []string {
+	if !f.has("parent_id") {
+		return nil
+	}
+	id, ok := f.int("parent_id")
+	if !ok || (id > 0 && s.findProject(strconv.Itoa(id)) == nil) {
+		return []string{"Subproject of is invalid"}
+	}
+	p.Parent = redmine.Resource{ID: id}
+	return nil
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	p := s.visibleProject(w, r, user, "project")
+	if p == nil || !requireAdmin(w, user) {
+		return
+	}
+
+	ids := s.descendants(p)
+	in := func(r redmine.Resource) bool { return slices.Contains(ids, r.ID) }
+	s.projects = slices.DeleteFunc(s.projects, func(p *redmine.Project) bool { return slices.Contains(ids, p.ID) })
+	s.memberships = slices.DeleteFunc(s.memberships, func(m *redmine.Membership) bool { return in(m.Project) })
+	s.versions = slices.DeleteFunc(s.versions, func(v *redmine.Version) bool { return in(v.Project) })
+	s.timeEntries = slices.DeleteFunc(s.timeEntries, func(e *redmine.TimeEntry) bool { return in(e.Project) })
+	s.wikiPages = slices.DeleteFunc(s.wikiPages, func(p *wikiPage) bool { return slices.Contains(ids, p.project) })
+	for _, i := range slices.Clone(s.issues) {
+		if in(i.Project) {
+			s.removeIssue(i.ID)
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) findMembership(projectID, userID int) *redmine.Membership {
+	i := slices.IndexFunc(s.memberships, func(m *redmine.Membership) bool {
+		return m.Project.ID == projectID && m.User.ID == userID
+	})
+	if i < 0 {
+		return nil
+	}
+	return s.memberships[i]
+}
+
+func (s *Server) membershipJSON(m *redmine.Membership) redmine.Membership {
+	membership := redmine.Membership{ID: m.ID, Project: s.projectRef(m.Project.ID), User: s.userRef(m.User.ID)}
+	for _, r := range m.Roles {
+		role := redmine.Resource{ID: r.ID}
+		if found := s.findRole(r.ID); found != nil {
+			role.Name = found.Name
+		}
+		membership.Roles = append(membership.Roles, role)
+	}
+	return membership
+}
+
+// membershipByID returns the membership named by the path value "id" for a
+// user who can see its project and has perm, if set, on it. It answers 404,
+// 401 or 403 otherwise.
+func (s *Server) membershipByID(w http.ResponseWriter, r *http.Request, user *redmine.User, perm string) *redmine.Membership {
+	id := pathID(r, "id")
+	i := slices.IndexFunc(s.memberships, func(m *redmine.Membership) bool { return m.ID == id })
+	if i < 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return nil
+	}
+	m := s.memberships[i]
+	p := s.findProject(strconv.Itoa(m.Project.ID))
+	if !s.visible(user, p) {
+		deny(w, user)
+		return nil
+	}
+	if perm != "" && !s.authorize(w, user, p, perm) {
+		return nil
+	}
+	return m
+}
+
+// validRoles checks the role IDs of a membership.
+func (s *Server) validRoles(f fields) ([]redmine.Resource, []string) {
+	ids, ok := f.ints("role_ids")
+	if !ok || len(ids) == 0 {
+		return nil, []string{"Role cannot be empty"}
+	}
+	var roles []redmine.Resource
+	for _, id := range ids {
+		if s.findRole(id) == nil {
+			return nil, []string{"Role is invalid"}
+		}
+		roles = append(roles, redmine.Resource{ID: id})
+	}
+	return roles, nil
+}
+
+func (s *Server) listMemberships(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	p := s.visibleProject(w, r, user, "project")
+	if p == nil {
+		return
+	}
+
+	var memberships []redmine.Membership
+	for _, m := range s.memberships {
+		if m.Project.ID == p.ID {
+			memberships = append(memberships, s.membershipJSON(m))
+		}
+	}
+	page, offset, limit := paginate(r, memberships)
+	writeJSON(w, http.StatusOK, redmine.MembershipsResponse{Memberships: page, TotalCount: len(memberships), Offset: offset, Limit: limit})
+}
+
+func (s *Server) createMembership(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	p := s.visibleProject(w, r, user, "project")
+	if p == nil || !s.authorize(w, user, p, "manage_members") {
+		return
+	}
+	f, ok := decodeFields(w, r, "membership")
+	if !ok {
+		return
+	}
+
+	userID, _ := f.int("user_id")
+	var errs []string
+	switch {
+	case userID == 0 || s.findUser(func(u *redmine.User) bool { return u.ID == userID }) == nil:
+		errs = append(errs, "Principal cannot be blank")
+	case s.findMembership(p.ID, userID) != nil:
+		errs = append(errs, "Principal has already been taken")
+	}
+	roles, roleErrs := s.validRoles(f)
+	errs = append(errs, roleErrs...)
+	if len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+
+	m := &redmine.Membership{ID: s.nextID("memberships"), Project: redmine.Resource{ID: p.ID}, User: redmine.Resource{ID: userID}, Roles: roles}
+	s.memberships = append(s.memberships, m)
+	writeJSON(w, http.StatusCreated, redmine.MembershipResponse{Membership: s.membershipJSON(m)})
+}
+
+func (s *Server) showMembership(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	m := s.membershipByID(w, r, user, "")
+	if m == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, redmine.MembershipResponse{Membership: s.membershipJSON(m)})
+}
+
+func (s *Server) updateMembership(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	m := s.membershipByID(w, r, user, "manage_members")
+	if m == nil {
+		return
+	}
+	f, ok := decodeFields(w, r, "membership")
+	if !ok {
+		return
+	}
+
+	roles, errs := s.validRoles(f)
+	if len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+	m.Roles = roles
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteMembership(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	m := s.membershipByID(w, r, user, "manage_members")
+	if m == nil {
+		return
+	}
+	s.memberships = slices.DeleteFunc(s.memberships, func(other *redmine.Membership) bool { return other == m })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Values accepted for the status and sharing of a version.
+var (
+	versionStatuses = []string{"open", "locked", "closed"}
+	versionSharings = []string{"none", "descendants", "hierarchy", "tree", "system"}
+)
+
+func (s *Server) findVersion(id int) *redmine.Version {
+	i := slices.IndexFunc(s.versions, func(v *redmine.Version) bool { return v.ID == id })
+	if i < 0 {
+		return nil
+	}
+	return s.versions[i]
+}
+
+func (s *Server) versionRef(id int) redmine.Resource {
+	if v := s.findVersion(id); v != nil {
+		return redmine.Resource{ID: id, Name: v.Name}
+	}
+	return redmine.Resource{ID: id}
+}
+
+func (s *Server) versionJSON(v *redmine.Version) redmine.Version {
+	version := *v
+	version.Project = s.projectRef(v.Project.ID)
+	for _, i := range s.issues {
+		if i.FixedVersion.ID == v.ID {
+			version.EstimatedHours += i.EstimatedHours
+			version.SpentHours += s.spentHours(i.ID)
+		}
+	}
+	return version
+}
+
+// versionByID returns the version named by the path value "id" for a user
+// who can see its project and has perm, if set, on it. It answers 404, 401
+// or 403 otherwise.
+func (s *Server) versionByID(w http.ResponseWriter, r *http.Request, user *redmine.User, perm string) *redmine.Version {
+	v := s.findVersion(pathID(r, "id"))
+	if v == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return nil
+	}
+	p := s.findProject(strconv.Itoa(v.Project.ID))
+	if !s.visible(user, p) {
+		deny(w, user)
+		return nil
+	}
+	if perm != "" && !s.authorize(w, user, p, perm) {
+		return nil
+	}
+	return v
+}
+
+// applyVersion applies the attributes in f to v and returns the validation
+// errors.
+func (s *Server) applyVersion(v *redmine.Version, f fields) []string {
+	var errs []string
+	if f.has("name") {
+		v.Name = f.string("name")
+	}
+	if v.Name == "" {
+		errs = append(errs, "Name cannot be blank")
+	} else if slices.ContainsFunc(s.versions, func(other *redmine.Version) bool {
+		return other.ID != v.ID && other.Project.ID == v.Project.ID && other.Name == v.Name
+	}) {
+		errs = append(errs, "Name has already been taken")
+	}
+	if f.has("description") {
+		v.Description = f.string("description")
+	}
+	if f.has("status") {
+		v.Status = f.string("status")
+	}
+	if !slices.Contains(versionStatuses, v.Status) {
+		errs = append(errs, "Status is not included in the list")
+	}
+	if f.has("sharing") {
+		v.Sharing = f.string("sharing")
+	}
+	if !slices.Contains(versionSharings, v.Sharing) {
+		errs = append(errs, "Sharing is not included in the list")
+	}
+	if f.has("due_date") {
+		d, ok := f.date("due_date")
+		if !ok {
+			errs = append(errs, "Due date is not a valid date")
+		}
+		v.DueDate = d
+	}
+	if f.has("wiki_page_title") {
+		v.WikiPageTitle = f.string("wiki_page_title")
+	}
+	return errs
+}
+
+func (s *Server) listVersions(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	p := s.visibleProject(w, r, user, "project")
+	if p == nil {
+		return
+	}
+
+	var versions []redmine.Version
+	for _, v := range s.versions {
+		if v.Project.ID == p.ID {
+			versions = append(versions, s.versionJSON(v))
+		}
+	}
+	writeJSON(w, http.StatusOK, redmine.VersionsResponse{Versions: versions, TotalCount: len(versions)})
+}
+
+func (s *Server) createVersion(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	p := s.visibleProject(w, r, user, "project")
+	if p == nil || !s.authorize(w, user, p, "manage_versions") {
+		return
+	}
+	f, ok := decodeFields(w, r, "version")
+	if !ok {
+		return
+	}
+
+	v := &redmine.Version{Project: redmine.Resource{ID: p.ID}, Status: "open", Sharing: "none"}
+	if errs := s.applyVersion(v, f); len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+
+	now := s.timestamp()
+	v.ID = s.nextID("versions")
+	v.CreatedOn, v.UpdatedOn = now, now
+	s.versions = append(s.versions, v)
+	writeJSON(w, http.StatusCreated, redmine.VersionResponse{Version: s.versionJSON(v)})
+}
+
+func (s *Server) showVersion(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	v := s.versionByID(w, r, user, "")
+	if v == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, redmine.VersionResponse{Version: s.versionJSON(v)})
+}
+
+func (s *Server) updateVersion(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	v := s.versionByID(w, r, user, "manage_versions")
+	if v == nil {
+		return
+	}
+	f, ok := decodeFields(w, r, "version")
+	if !ok {
+		return
+	}
+
+	updated := *v
+	if errs := s.applyVersion(&updated, f); len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+	updated.UpdatedOn = s.timestamp()
+	*v = updated
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteVersion(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	v := s.versionByID(w, r, user, "manage_versions")
+	if v == nil {
+		return
+	}
+	if slices.ContainsFunc(s.issues, func(i *redmine.Issue) bool { return i.FixedVersion.ID == v.ID }) {
+		writeErrors(w, "Unable to delete version.")
+		return
+	}
+	s.versions = slices.DeleteFunc(s.versions, func(other *redmine.Version) bool { return other == v })
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,220 @@
+package redminetest
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// expectErrors fails the test unless err is a 422 carrying the given messages.
+func expectErrors(t *testing.T, err error, want ...string) {
+	t.Helper()
+
+	var apiErr *redmine.APIError
+	if !errors.As(err, &apiErr) || !errors.Is(err, redmine.ErrUnprocessable) {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+	if !slices.Equal(apiErr.Errors, want) {
+		t.Errorf("Expected errors %q, got %q", want, apiErr.Errors)
+	}
+}
+
+func TestServerProjects(t *testing.T) {
+	srv, public, private, jsmith, _ := newTestServer(t)
+	admin := srv.Client(srv.Admin.APIKey)
+	ctx := context.Background()
+
+	created, err := admin.CreateProject(ctx, redmine.ProjectCreateRequest{Name: "Sub", Identifier: "sub", ParentID: public.ID})
+	if err != nil {
+		t.Fatalf("CreateProject failed: %v", err)
+	}
+	if created.Project.Parent.ID != public.ID || created.Project.Parent.Name != "eCookbook" {
+		t.Errorf("Expected parent eCookbook, got %+v", created.Project.Parent)
+	}
+	if !created.Project.IsPublic {
+		t.Errorf("Expected a public project, got %+v", created.Project)
+	}
+
+	// Private projects are listed for their members only
+	for _, tt := range []struct {
+		client *redmine.Client
+		want   int
+	}{
+		{admin, 3},
+		{srv.Client(jsmith.APIKey), 3},
+		{srv.Client(""), 2},
+	} {
+		projects, err := tt.client.ListAllProjects(ctx, nil)
+		if err != nil {
+			t.Fatalf("ListAllProjects failed: %v", err)
+		}
+		if len(projects) != tt.want {
+			t.Errorf("Expected %d projects, got %d", tt.want, len(projects))
+		}
+	}
+
+	shown, err := admin.ShowProject(ctx, private.Identifier, nil)
+	if err != nil {
+		t.Fatalf("ShowProject failed: %v", err)
+	}
+	if shown.Project.ID != private.ID {
+		t.Errorf("Expected project %d, got %d", private.ID, shown.Project.ID)
+	}
+
+	if err := admin.UpdateProject(ctx, "sub", redmine.ProjectUpdateRequest{Description: "A subproject"}); err != nil {
+		t.Fatalf("UpdateProject failed: %v", err)
+	}
+	shown, err = admin.ShowProject(ctx, "sub", nil)
+	if err != nil {
+		t.Fatalf("ShowProject failed: %v", err)
+	}
+	if shown.Project.Description != "A subproject" {
+		t.Errorf("Expected description to be updated, got %q", shown.Project.Description)
+	}
+
+	// Moving a project under its own subproject is refused
+	err = admin.UpdateProject(ctx, public.Identifier, redmine.ProjectUpdateRequest{ParentID: created.Project.ID})
+	expectErrors(t, err, "Subproject of is invalid")
+
+	if err := admin.DeleteProject(ctx, public.Identifier); err != nil {
+		t.Fatalf("DeleteProject failed: %v", err)
+	}
+	if _, err := admin.ShowProject(ctx, "sub", nil); !errors.Is(err, redmine.ErrNotFound) {
+		t.Errorf("Expected subproject to be deleted, got %v", err)
+	}
+}
+
+func TestServerCreateProjectValidation(t *testing.T) {
+	srv, public, _, jsmith, _ := newTestServer(t)
+	ctx := context.Background()
+
+	if _, err := srv.Client(jsmith.APIKey).CreateProject(ctx, redmine.ProjectCreateRequest{Name: "Mine", Identifier: "mine"}); !errors.Is(err, redmine.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden for non-admins, got %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  redmine.ProjectCreateRequest
+		want []string
+	}{
+		{name: "blank", req: redmine.ProjectCreateRequest{}, want: []string{"Name cannot be blank", "Identifier cannot be blank"}},
+		{name: "invalid identifier", req: redmine.ProjectCreateRequest{Name: "Bad", Identifier: "Bad Identifier"}, want: []string{"Identifier is invalid"}},
+		{name: "taken identifier", req: redmine.ProjectCreateRequest{Name: "Again", Identifier: public.Identifier}, want: []string{"Identifier has already been taken"}},
+		{name: "unknown parent", req: redmine.ProjectCreateRequest{Name: "Orphan", Identifier: "orphan", ParentID: 999}, want: []string{"Subproject of is invalid"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := srv.Client(srv.Admin.APIKey).CreateProject(ctx, tt.req)
+			expectErrors(t, err, tt.want...)
+		})
+	}
+}
+
+func TestServerMemberships(t *testing.T) {
+	srv, public, _, jsmith, _ := newTestServer(t)
+	admin := srv.Client(srv.Admin.APIKey)
+	ctx := context.Background()
+
+	newcomer := srv.AddUser(redmine.User{Login: "newcomer", Firstname: "New", Lastname: "Comer", Mail: "newcomer@example.net"})
+	created, err := admin.CreateMembership(ctx, public.Identifier, redmine.MembershipCreateUpdate{UserID: newcomer.ID, RoleIDs: []int{RoleReporter}})
+	if err != nil {
+		t.Fatalf("CreateMembership failed: %v", err)
+	}
+	m := created.Membership
+	if m.User.Name != "New Comer" || len(m.Roles) != 1 || m.Roles[0].Name != "Reporter" {
+		t.Errorf("Unexpected membership: %+v", m)
+	}
+
+	_, err = admin.CreateMembership(ctx, public.Identifier, redmine.MembershipCreateUpdate{UserID: jsmith.ID, RoleIDs: []int{RoleManager}})
+	expectErrors(t, err, "Principal has already been taken")
+	_, err = admin.CreateMembership(ctx, public.Identifier, redmine.MembershipCreateUpdate{RoleIDs: []int{RoleManager}})
+	expectErrors(t, err, "Principal cannot be blank")
+	err = admin.UpdateMembership(ctx, m.ID, nil)
+	expectErrors(t, err, "Role cannot be empty")
+
+	if err := admin.UpdateMembership(ctx, m.ID, []int{RoleManager}); err != nil {
+		t.Fatalf("UpdateMembership failed: %v", err)
+	}
+	// The new manager can now manage members
+	if err := srv.Client(newcomer.APIKey).UpdateMembership(ctx, m.ID, []int{RoleManager, RoleDeveloper}); err != nil {
+		t.Fatalf("UpdateMembership as manager failed: %v", err)
+	}
+	shown, err := admin.ShowMembership(ctx, m.ID)
+	if err != nil {
+		t.Fatalf("ShowMembership failed: %v", err)
+	}
+	if len(shown.Membership.Roles) != 2 {
+		t.Errorf("Expected 2 roles, got %+v", shown.Membership.Roles)
+	}
+
+	if err := admin.DeleteMembership(ctx, m.ID); err != nil {
+		t.Fatalf("DeleteMembership failed: %v", err)
+	}
+	list, err := admin.ListMemberships(ctx, public.Identifier)
+	if err != nil {
+		t.Fatalf("ListMemberships failed: %v", err)
+	}
+	if len(list.Memberships) != 2 {
+		t.Errorf("Expected 2 memberships, got %d", len(list.Memberships))
+	}
+}
+
+func TestServerVersions(t *testing.T) {
+	srv, public, _, jsmith, _ := newTestServer(t)
+	admin := srv.Client(srv.Admin.APIKey)
+	ctx := context.Background()
+
+	created, err := admin.CreateVersion(ctx, public.Identifier, redmine.Version{Name: "1.0", DueDate: redmine.NewDate(2025, 6, 30)})
+	if err != nil {
+		t.Fatalf("CreateVersion failed: %v", err)
+	}
+	v := created.Version
+	if v.Status != "open" || v.Sharing != "none" || v.Project.ID != public.ID {
+		t.Errorf("Unexpected version: %+v", v)
+	}
+
+	_, err = admin.CreateVersion(ctx, public.Identifier, redmine.Version{Name: "1.0", Status: "locked"})
+	expectErrors(t, err, "Name has already been taken")
+	_, err = admin.CreateVersion(ctx, public.Identifier, redmine.Version{Name: "2.0", Status: "done"})
+	expectErrors(t, err, "Status is not included in the list")
+	if _, err := srv.Client(jsmith.APIKey).CreateVersion(ctx, public.Identifier, redmine.Version{Name: "2.0"}); !errors.Is(err, redmine.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden for developers, got %v", err)
+	}
+
+	// A version in use by an issue cannot be deleted
+	issue, err := admin.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "Release", FixedVersionID: v.ID, EstimatedHours: 3})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	err = admin.DeleteVersion(ctx, v.ID)
+	expectErrors(t, err, "Unable to delete version.")
+
+	if err := admin.UpdateVersion(ctx, v.ID, redmine.Version{Status: "closed"}); err != nil {
+		t.Fatalf("UpdateVersion failed: %v", err)
+	}
+	shown, err := admin.ShowVersion(ctx, v.ID)
+	if err != nil {
+		t.Fatalf("ShowVersion failed: %v", err)
+	}
+	if shown.Version.Status != "closed" || shown.Version.EstimatedHours != 3 {
+		t.Errorf("Unexpected version: %+v", shown.Version)
+	}
+
+	if err := admin.DeleteIssue(ctx, issue.Issue.ID); err != nil {
+		t.Fatalf("DeleteIssue failed: %v", err)
+	}
+	if err := admin.DeleteVersion(ctx, v.ID); err != nil {
+		t.Fatalf("DeleteVersion failed: %v", err)
+	}
+	list, err := admin.ListVersions(ctx, public.Identifier)
+	if err != nil {
+		t.Fatalf("ListVersions failed: %v", err)
+	}
+	if len(list.Versions) != 0 {
+		t.Errorf("Expected no versions, got %+v", list.Versions)
+	}
+}
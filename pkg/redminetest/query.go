@@ -0,0 +1,385 @@
+package redminetest
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// paginate returns the page of items selected by the offset, limit and page
+// parameters of r, along with the offset and limit applied. As in Redmine,
+// the limit defaults to 25 and is capped at 100.
+func paginate[T any](r *http.Request, items []T) ([]T, int, int) {
+	q := r.URL.Query()
+	limit, err := strconv.Atoi(q.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultLimit
+	}
+	limit = min(limit, maxLimit)
+
+	offset, err := strconv.Atoi(q.Get("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+		if page, err := strconv.Atoi(q.Get("page")); err == nil && page > 0 {
+			offset = (page - 1) * limit
+		}
+	}
+
+	start, end := min(offset, len(items)), min(offset+limit, len(items))
+	return append([]T{}, items[start:end]...), offset, limit
+}
+
+// condition is a filter condition on an issue list.
+type condition struct {
+	field    string
+	operator string
+	values   []string
+}
+
+// Kinds of issue filters, which decide the operators they accept and how
+// values compare.
+const (
+	kindID     = "id"
+	kindStatus = "status"
+	kindText   = "text"
+	kindDate   = "date"
+	kindTime   = "time"
+	kindNumber = "number"
+)
+
+// issueFilter reads the values of a filter field from an issue. An unset
+// field has no values.
+type issueFilter struct {
+	kind  string
+	value func(s *Server, i *redmine.Issue) []string
+}
+
+func idValue(id int) []string {
+	if id == 0 {
+		return nil
+	}
+	return []string{strconv.Itoa(id)}
+}
+
+func textValue(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return []string{s}
+}
+
+var issueFilters = map[string]issueFilter{
+	"issue_id":         {kindID, func(s *Server, i *redmine.Issue) []string { return idValue(i.ID) }},
+	"project_id":       {kindID, func(s *Server, i *redmine.Issue) []string { return idValue(i.Project.ID) }},
+	"tracker_id":       {kindID, func(s *Server, i *redmine.Issue) []string { return idValue(i.Tracker.ID) }},
+	"status_id":        {kindStatus, func(s *Server, i *redmine.Issue) []string { return idValue(i.Status.ID) }},
+	"priority_id":      {kindID, func(s *Server, i *redmine.Issue) []string { return idValue(i.Priority.ID) }},
+	"author_id":        {kindID, func(s *Server, i *redmine.Issue) []string { return idValue(i.Author.ID) }},
+	"assigned_to_id":   {kindID, func(s *Server, i *redmine.Issue) []string { return idValue(i.AssignedTo.ID) }},
+	"fixed_version_id": {kindID, func(s *Server, i *redmine.Issue) []string { return idValue(i.FixedVersion.ID) }},
+	"parent_id":        {kindID, func(s *Server, i *redmine.Issue) []string { return idValue(i.Parent.ID) }},
+	"watcher_id": {kindID, func(s *Server, i *redmine.Issue) []string {
+		var ids []string
+		for _, w := range i.Watchers {
+			ids = append(ids, strconv.Itoa(w.ID))
+		}
+		return ids
+	}},
+	"subject":         {kindText, func(s *Server, i *redmine.Issue) []string { return textValue(i.Subject) }},
+	"description":     {kindText, func(s *Server, i *redmine.Issue) []string { return textValue(i.Description) }},
+	"created_on":      {kindTime, func(s *Server, i *redmine.Issue) []string { return textValue(i.CreatedOn.String()) }},
+	"updated_on":      {kindTime, func(s *Server, i *redmine.Issue) []string { return textValue(i.UpdatedOn.String()) }},
+	"closed_on":       {kindTime, func(s *Server, i *redmine.Issue) []string { return textValue(i.ClosedOn.String()) }},
+	"start_date":      {kindDate, func(s *Server, i *redmine.Issue) []string { return textValue(i.StartDate.String()) }},
+	"due_date":        {kindDate, func(s *Server, i *redmine.Issue) []string { return textValue(i.DueDate.String()) }},
+	"estimated_hours": {kindNumber, func(s *Server, i *redmine.Issue) []string { return textValue(formatFloat(i.EstimatedHours)) }},
+	"done_ratio":      {kindNumber, func(s *Server, i *redmine.Issue) []string { return []string{strconv.Itoa(i.DoneRatio)} }},
+}
+
+// operators lists the operators accepted by each kind of filter.
+var operators = map[string][]string{
+	kindID:     {redmine.OpEquals, redmine.OpNotEquals, redmine.OpAny, redmine.OpNone},
+	kindStatus: {redmine.OpEquals, redmine.OpNotEquals, redmine.OpOpen, redmine.OpClosed, redmine.OpAny},
+	kindText:   {redmine.OpEquals, redmine.OpNotEquals, redmine.OpContains, redmine.OpNotContains, redmine.OpStartsWith, redmine.OpEndsWith, redmine.OpAny, redmine.OpNone},
+	kindDate:   {redmine.OpEquals, redmine.OpGreaterOrEqual, redmine.OpLessOrEqual, redmine.OpBetween, redmine.OpAny, redmine.OpNone},
+	kindTime:   {redmine.OpEquals, redmine.OpGreaterOrEqual, redmine.OpLessOrEqual, redmine.OpBetween, redmine.OpAny, redmine.OpNone},
+	kindNumber: {redmine.OpEquals, redmine.OpGreaterOrEqual, redmine.OpLessOrEqual, redmine.OpBetween, redmine.OpAny, redmine.OpNone},
+}
+
+// issueConditions reads the filter of an issue list: the generic f[], op[]
+// and v[] parameters when set_filter is set, and the short parameters such as
+// status_id=open or created_on=>=2024-01-01 otherwise. Without set_filter,
+// only open issues are listed unless status_id says otherwise.
+func issueConditions(q url.Values) []condition {
+	var conds []condition
+	if q.Get("set_filter") == "1" {
+		for _, field := range q["f[]"] {
+			if field != "" {
+				conds = append(conds, condition{field: field, operator: q.Get("op[" + field + "]"), values: q["v["+field+"][]"]})
+			}
+		}
+		return conds
+	}
+
+	for _, field := range slices.Sorted(maps.Keys(issueFilters)) {
+		if expr := q.Get(field); expr != "" && field != "project_id" {
+			conds = append(conds, shortCondition(field, expr))
+		}
+	}
+	if q.Get("status_id") == "" {
+		conds = append(conds, condition{field: "status_id", operator: redmine.OpOpen})
+	}
+	return conds
+}
+
+// shortCondition parses a short filter expression.
+func shortCondition(field, expr string) condition {
+	if field == "status_id" {
+		switch expr {
+		case "open", redmine.OpOpen:
+			return condition{field: field, operator: redmine.OpOpen}
+		case "closed", redmine.OpClosed:
+			return condition{field: field, operator: redmine.OpClosed}
+		}
+	}
+	if expr == redmine.OpAny || expr == redmine.OpNone {
+		return condition{field: field, operator: expr}
+	}
+	for _, op := range []string{redmine.OpBetween, redmine.OpGreaterOrEqual, redmine.OpLessOrEqual, redmine.OpNotContains, redmine.OpContains, redmine.OpNotEquals} {
+		if rest, ok := strings.CutPrefix(expr, op); ok {
+			return condition{field: field, operator: op, values: strings.Split(rest, "|")}
+		}
+	}
+	return condition{field: field, operator: redmine.OpEquals, values: strings.Split(expr, "|")}
+}
+
+// issueMatcher compiles conds into a predicate for user, or returns the
+// validation errors of the filter.
+func (s *Server) issueMatcher(conds []condition, user *redmine.User) (func(*redmine.Issue) bool, []string) {
+	var matchers []func(*redmine.Issue) bool
+	var errs []string
+	for _, c := range conds {
+		filter, ok := issueFilters[c.field]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%s is not a valid filter", c.field))
+			continue
+		}
+		if !slices.Contains(operators[filter.kind], c.operator) {
+			errs = append(errs, fmt.Sprintf("%s operator %q is not supported", c.field, c.operator))
+			continue
+		}
+
+		values := c.values
+		if filter.kind == kindID {
+			values = nil
+			for _, v := range c.values {
+				for id := range strings.SplitSeq(v, ",") {
+					if id == "me" {
+						id = "0"
+						if user != nil {
+							id = strconv.Itoa(user.ID)
+						}
+					}
+					values = append(values, strings.TrimSpace(id))
+				}
+			}
+		}
+		match, ok := s.valueMatcher(filter.kind, c.operator, values)
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%s is invalid", c.field))
+			continue
+		}
+		matchers = append(matchers, func(i *redmine.Issue) bool { return match(filter.value(s, i)) })
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return func(i *redmine.Issue) bool {
+		for _, match := range matchers {
+			if !match(i) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// valueMatcher returns a predicate on the values of a field of the given
+// kind, or false if values do not suit the operator.
+func (s *Server) valueMatcher(kind, op string, values []string) (func([]string) bool, bool) {
+	switch op {
+	case redmine.OpAny:
+		return func(got []string) bool { return len(got) > 0 }, true
+	case redmine.OpNone:
+		return func(got []string) bool { return len(got) == 0 }, true
+	case redmine.OpOpen, redmine.OpClosed:
+		closed := op == redmine.OpClosed
+		return func(got []string) bool {
+			id, _ := strconv.Atoi(strings.Join(got, ""))
+			st := s.findStatus(id)
+			return st != nil && st.IsClosed == closed
+		}, true
+	}
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	switch kind {
+	case kindText:
+		return textMatcher(op, values[0]), true
+	case kindID, kindStatus:
+		matches := func(got []string) bool {
+			return slices.ContainsFunc(got, func(v string) bool { return slices.Contains(values, v) })
+		}
+		if op == redmine.OpNotEquals {
+			return func(got []string) bool { return !matches(got) }, true
+		}
+		return matches, true
+	}
+
+	// Dates, times and numbers compare as ranges: a date stands for the
+	// whole day.
+	lo, hi, ok := bounds(kind, values[0])
+	if !ok {
+		return nil, false
+	}
+	switch op {
+	case redmine.OpGreaterOrEqual:
+		hi = maxBound
+	case redmine.OpLessOrEqual:
+		lo = -maxBound
+	case redmine.OpBetween:
+		if len(values) < 2 {
+			return nil, false
+		}
+		if _, hi, ok = bounds(kind, values[1]); !ok {
+			return nil, false
+		}
+	}
+	return func(got []string) bool {
+		if len(got) == 0 {
+			return false
+		}
+		v, _, ok := bounds(kind, got[0])
+		return ok && v >= lo && v <= hi
+	}, true
+}
+
+func textMatcher(op, value string) func([]string) bool {
+	value = strings.ToLower(value)
+	return func(got []string) bool {
+		s := strings.ToLower(strings.Join(got, ""))
+		switch op {
+		case redmine.OpContains:
+			return strings.Contains(s, value)
+		case redmine.OpNotContains:
+			return !strings.Contains(s, value)
+		case redmine.OpStartsWith:
+			return strings.HasPrefix(s, value)
+		case redmine.OpEndsWith:
+			return strings.HasSuffix(s, value)
+		case redmine.OpNotEquals:
+			return s != value
+		default:
+			return s == value
+		}
+	}
+}
+
+const maxBound = 1 << 62
+
+// bounds returns the range of numbers v stands for: a date covers the
+// seconds of its day, a timestamp or number a single point.
+func bounds(kind, v string) (float64, float64, bool) {
+	if kind == kindNumber {
+		n, err := strconv.ParseFloat(v, 64)
+		return n, n, err == nil
+	}
+	if d, err := redmine.ParseDate(v); err == nil && v != "" {
+		start := float64(d.Unix())
+		return start, start + float64(24*time.Hour/time.Second) - 1, true
+	}
+	if kind == kindTime {
+		if t, err := redmine.ParseTimestamp(v); err == nil && v != "" {
+			return float64(t.Unix()), float64(t.Unix()), true
+		}
+	}
+	return 0, 0, false
+}
+
+// issueSorts maps the columns accepted by the sort parameter to the value
+// issues are sorted by.
+var issueSorts = map[string]func(s *Server, i *redmine.Issue) any{
+	"id":              func(s *Server, i *redmine.Issue) any { return i.ID },
+	"project":         func(s *Server, i *redmine.Issue) any { return s.projectRef(i.Project.ID).Name },
+	"tracker":         func(s *Server, i *redmine.Issue) any { return i.Tracker.ID },
+	"status":          func(s *Server, i *redmine.Issue) any { return i.Status.ID },
+	"priority":        func(s *Server, i *redmine.Issue) any { return i.Priority.ID },
+	"subject":         func(s *Server, i *redmine.Issue) any { return i.Subject },
+	"author":          func(s *Server, i *redmine.Issue) any { return s.userRef(i.Author.ID).Name },
+	"assigned_to":     func(s *Server, i *redmine.Issue) any { return s.userRef(i.AssignedTo.ID).Name },
+	"fixed_version":   func(s *Server, i *redmine.Issue) any { return s.versionRef(i.FixedVersion.ID).Name },
+	"parent":          func(s *Server, i *redmine.Issue) any { return i.Parent.ID },
+	"start_date":      func(s *Server, i *redmine.Issue) any { return i.StartDate.String() },
+	"due_date":        func(s *Server, i *redmine.Issue) any { return i.DueDate.String() },
+	"created_on":      func(s *Server, i *redmine.Issue) any { return i.CreatedOn.String() },
+	"updated_on":      func(s *Server, i *redmine.Issue) any { return i.UpdatedOn.String() },
+	"closed_on":       func(s *Server, i *redmine.Issue) any { return i.ClosedOn.String() },
+	"done_ratio":      func(s *Server, i *redmine.Issue) any { return float64(i.DoneRatio) },
+	"estimated_hours": func(s *Server, i *redmine.Issue) any { return i.EstimatedHours },
+}
+
+// sortIssues sorts issues by the sort parameter, such as
+// "priority:desc,id". Unknown columns are ignored, and ties are broken by
+// descending ID, Redmine's default order.
+func (s *Server) sortIssues(issues []*redmine.Issue, sort string) {
+	type column struct {
+		value func(s *Server, i *redmine.Issue) any
+		desc  bool
+	}
+	var columns []column
+	for spec := range strings.SplitSeq(sort, ",") {
+		name, dir, _ := strings.Cut(strings.TrimSpace(spec), ":")
+		if value, ok := issueSorts[name]; ok {
+			columns = append(columns, column{value: value, desc: dir == "desc"})
+		}
+	}
+	columns = append(columns, column{value: issueSorts["id"], desc: true})
+
+	slices.SortStableFunc(issues, func(a, b *redmine.Issue) int {
+		for _, c := range columns {
+			var n int
+			switch va := c.value(s, a).(type) {
+			case int:
+				n = cmp.Compare(va, c.value(s, b).(int))
+			case float64:
+				n = cmp.Compare(va, c.value(s, b).(float64))
+			case string:
+				n = cmp.Compare(strings.ToLower(va), strings.ToLower(c.value(s, b).(string)))
+			}
+			if c.desc {
+				n = -n
+			}
+			if n != 0 {
+				return n
+			}
+		}
+		return 0
+	})
+}
+
+func formatFloat(f float64) string {
+	if f == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
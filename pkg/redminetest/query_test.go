@@ -0,0 +1,134 @@
+package redminetest
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+func TestServerListIssues(t *testing.T) {
+	c := &clock{t: time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)}
+	srv, public, _, jsmith, _ := newTestServer(t, WithClock(c.now))
+	admin := srv.Client(srv.Admin.APIKey)
+	ctx := context.Background()
+
+	sub, err := admin.CreateProject(ctx, redmine.ProjectCreateRequest{Name: "Plugins", Identifier: "plugins", ParentID: public.ID})
+	if err != nil {
+		t.Fatalf("CreateProject failed: %v", err)
+	}
+
+	// One issue a day, from May 1st
+	issues := []redmine.IssueCreateRequest{
+		{ProjectID: public.ID, Subject: "Print recipes", PriorityID: 3, AssignedToID: jsmith.ID, DueDate: redmine.NewDate(2025, 5, 10)},
+		{ProjectID: public.ID, Subject: "Add ratings", TrackerID: 2, PriorityID: 2, DueDate: redmine.NewDate(2025, 5, 20)},
+		{ProjectID: public.ID, Subject: "Broken print preview", StatusID: 5, PriorityID: 4, AssignedToID: jsmith.ID},
+		{ProjectID: sub.Project.ID, Subject: "Add plugin API", TrackerID: 2, PriorityID: 3, EstimatedHours: 8},
+	}
+	for _, req := range issues {
+		if _, err := admin.CreateIssue(ctx, req); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+		c.advance(24 * time.Hour)
+	}
+
+	tests := []struct {
+		name   string
+		client *redmine.Client
+		opts   *redmine.ListIssuesOptions
+		want   []int
+	}{
+		{name: "open by default", opts: nil, want: []int{4, 2, 1}},
+		{name: "closed", opts: &redmine.ListIssuesOptions{StatusID: "closed"}, want: []int{3}},
+		{name: "any status", opts: &redmine.ListIssuesOptions{StatusID: "*"}, want: []int{4, 3, 2, 1}},
+		{name: "assigned to me", client: srv.Client(jsmith.APIKey), opts: &redmine.ListIssuesOptions{AssignedToID: "me", StatusID: "*"}, want: []int{3, 1}},
+		{name: "unassigned", opts: &redmine.ListIssuesOptions{AssignedToID: "!*"}, want: []int{4, 2}},
+		{name: "tracker", opts: &redmine.ListIssuesOptions{TrackerID: 2}, want: []int{4, 2}},
+		{name: "subject contains", opts: &redmine.ListIssuesOptions{Subject: "~PRINT", StatusID: "*"}, want: []int{3, 1}},
+		{name: "due date range", opts: &redmine.ListIssuesOptions{DueDate: redmine.DateRange(redmine.NewDate(2025, 5, 1), redmine.NewDate(2025, 5, 15))}, want: []int{1}},
+		{name: "created since", opts: &redmine.ListIssuesOptions{CreatedOn: ">=2025-05-02", StatusID: "*"}, want: []int{4, 3, 2}},
+		{name: "created within a time range", opts: &redmine.ListIssuesOptions{CreatedOn: redmine.TimeRange(time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC), time.Date(2025, 5, 3, 8, 0, 0, 0, time.UTC))}, want: []int{2}},
+		{name: "estimated", opts: &redmine.ListIssuesOptions{EstimatedHours: ">=4"}, want: []int{4}},
+		{name: "project with subprojects", opts: &redmine.ListIssuesOptions{ProjectID: public.ID}, want: []int{4, 2, 1}},
+		{name: "project without subprojects", opts: &redmine.ListIssuesOptions{ProjectID: public.ID, SubprojectID: "!*"}, want: []int{2, 1}},
+		{name: "generic filter", opts: &redmine.ListIssuesOptions{Filter: redmine.NewFilter().Where("subject", redmine.OpStartsWith, "add").Where("priority_id", redmine.OpNotEquals, "2")}, want: []int{4}},
+		{name: "generic filter without status", opts: &redmine.ListIssuesOptions{Filter: redmine.NewFilter().Is("priority_id", "4")}, want: []int{3}},
+		{name: "sort", opts: &redmine.ListIssuesOptions{Sort: "priority:desc,subject", StatusID: "*"}, want: []int{3, 4, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := tt.client
+			if client == nil {
+				client = admin
+			}
+			resp, err := client.ListIssues(ctx, tt.opts)
+			if err != nil {
+				t.Fatalf("ListIssues failed: %v", err)
+			}
+			var ids []int
+			for _, i := range resp.Issues {
+				ids = append(ids, i.ID)
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("Expected issues %v, got %v", tt.want, ids)
+			}
+			if resp.TotalCount != len(tt.want) {
+				t.Errorf("Expected total_count %d, got %d", len(tt.want), resp.TotalCount)
+			}
+		})
+	}
+}
+
+func TestServerListIssuesPagination(t *testing.T) {
+	srv, public, _, _, _ := newTestServer(t)
+	admin := srv.Client(srv.Admin.APIKey)
+	ctx := context.Background()
+
+	for range 7 {
+		if _, err := admin.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "Issue"}); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+	}
+
+	page, err := admin.ListIssues(ctx, &redmine.ListIssuesOptions{Limit: 3, Offset: 5})
+	if err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+	if len(page.Issues) != 2 || page.TotalCount != 7 || page.Offset != 5 || page.Limit != 3 {
+		t.Errorf("Unexpected page: %d issues, total %d, offset %d, limit %d", len(page.Issues), page.TotalCount, page.Offset, page.Limit)
+	}
+
+	all, err := admin.ListAllIssues(ctx, &redmine.ListIssuesOptions{Limit: 3})
+	if err != nil {
+		t.Fatalf("ListAllIssues failed: %v", err)
+	}
+	if len(all) != 7 {
+		t.Errorf("Expected 7 issues, got %d", len(all))
+	}
+}
+
+func TestServerListIssuesInvalidFilter(t *testing.T) {
+	srv, _, _, _, _ := newTestServer(t)
+	admin := srv.Client(srv.Admin.APIKey)
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		filter *redmine.Filter
+		want   string
+	}{
+		{name: "unknown field", filter: redmine.NewFilter().Is("category_id", "1"), want: "category_id is not a valid filter"},
+		{name: "unsupported operator", filter: redmine.NewFilter().Contains("tracker_id", "1"), want: `tracker_id operator "~" is not supported`},
+		{name: "invalid value", filter: redmine.NewFilter().Where("due_date", redmine.OpGreaterOrEqual, "soon"), want: "due_date is invalid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := admin.ListIssues(ctx, &redmine.ListIssuesOptions{Filter: tt.filter})
+			expectErrors(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,440 @@
+package redminetest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode selects whether a Recorder replays or records interactions.
+type Mode int
+
+const (
+	// ModeReplay answers requests from the cassette without using the
+	// network. A request matching no recorded interaction fails with an
+	// *UnmatchedRequestError.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to Redmine and records the interactions. The
+	// cassette is replaced when the Recorder is stopped.
+	ModeRecord
+)
+
+// Redacted replaces secrets in cassettes.
+const Redacted = "REDACTED"
+
+// Headers and JSON body fields that are always redacted.
+var (
+	redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "X-Redmine-Api-Key"}
+	redactedFields  = []string{"api_key", "password"}
+)
+
+// Format encodes and decodes cassettes. Only JSON is provided. Marshal and
+// Unmarshal have the signatures of the encoding/json functions, which YAML
+// packages such as gopkg.in/yaml.v3 share, so a caller depending on one writes
+// YAML cassettes with Format{Marshal: yaml.Marshal, Unmarshal: yaml.Unmarshal}.
+type Format struct {
+	Marshal   func(v any) ([]byte, error)
+	Unmarshal func(data []byte, v any) error
+}
+
+// JSON writes cassettes as indented JSON. It is the default Format.
+var JSON = Format{
+	Marshal:   func(v any) ([]byte, error) { return json.MarshalIndent(v, "", "  ") },
+	Unmarshal: json.Unmarshal,
+}
+
+// Cassette holds recorded interactions in the order they happened.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions" yaml:"interactions"`
+}
+
+// Interaction is a request and the response Redmine gave to it.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request" yaml:"request"`
+	Response RecordedResponse `json:"response" yaml:"response"`
+}
+
+// RecordedRequest is a request as stored in a cassette, with secrets redacted.
+type RecordedRequest struct {
+	Method string      `json:"method" yaml:"method"`
+	URL    string      `json:"url" yaml:"url"`
+	Header http.Header `json:"header,omitempty" yaml:"header,omitempty"`
+	Body   string      `json:"body,omitempty" yaml:"body,omitempty"`
+	// BodyEncoding is "base64" for bodies that are not UTF-8 text.
+	BodyEncoding string `json:"body_encoding,omitempty" yaml:"body_encoding,omitempty"`
+}
+
+// RecordedResponse is a response as stored in a cassette, with secrets redacted.
+type RecordedResponse struct {
+	StatusCode   int         `json:"status_code" yaml:"status_code"`
+	Header       http.Header `json:"header,omitempty" yaml:"header,omitempty"`
+	Body         string      `json:"body,omitempty" yaml:"body,omitempty"`
+	BodyEncoding string      `json:"body_encoding,omitempty" yaml:"body_encoding,omitempty"`
+}
+
+// RecorderOptions configures a Recorder.
+type RecorderOptions struct {
+	Mode Mode
+	// Transport sends the requests being recorded. It defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+	// Format encodes the cassette. It defaults to JSON.
+	Format Format
+	// RedactHeaders lists headers to redact besides Authorization, Cookie,
+	// Set-Cookie and X-Redmine-Api-Key.
+	RedactHeaders []string
+	// RedactFields lists JSON body fields to redact, at any depth, besides
+	// api_key and password.
+	RedactFields []string
+}
+
+// UnmatchedRequestError is returned by a replaying Recorder for a request
+// that matches none of the interactions left in the cassette.
+type UnmatchedRequestError struct {
+	Cassette string
+	Request  RecordedRequest
+	// Similar lists the interactions left for the same method and path.
+	Similar []RecordedRequest
+}
+
+func (e *UnmatchedRequestError) Error() string {
+	msg := fmt.Sprintf("redminetest: no interaction left in %s matches %s", e.Cassette, describeRequest(e.Request))
+	for _, s := range e.Similar {
+		msg += "\n\tsimilar: " + describeRequest(s)
+	}
+	return msg
+}
+
+func describeRequest(r RecordedRequest) string {
+	s := r.Method + " " + r.URL
+	if r.Body != "" {
+		s += " " + r.Body
+	}
+	return s
+}
+
+// Recorder is an http.RoundTripper that records the interactions of a
+// client with Redmine to a cassette file and replays them in later runs:
+//
+//	rec, err := redminetest.NewRecorder("testdata/list_issues.json", &redminetest.RecorderOptions{Mode: mode})
+//	client := redmine.New(url, apiKey, redmine.WithTransport(rec))
+//	...
+//	err = rec.Stop()
+//
+// Requests match a recorded interaction with the same method, path, query
+// and body; JSON bodies match regardless of key order and formatting. Each
+// interaction is replayed once, in order, so a resource read before and
+// after a change replays both versions. API keys, passwords, cookies and
+// the "key" query parameter are redacted from the cassette; requests are
+// redacted the same way before they are matched, so replay with the
+// RedactFields used to record.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	format    Format
+	headers   []string
+	fields    []string
+
+	mu           sync.Mutex
+	interactions []Interaction
+	played       []bool
+}
+
+// NewRecorder returns a Recorder for the cassette at path. When replaying,
+// the cassette must exist.
+func NewRecorder(path string, opts *RecorderOptions) (*Recorder, error) {
+	if opts == nil {
+		opts = &RecorderOptions{}
+	}
+	r := &Recorder{
+		path:      path,
+		mode:      opts.Mode,
+		transport: opts.Transport,
+		format:    opts.Format,
+		headers:   append(append([]string{}, redactedHeaders...), opts.RedactHeaders...),
+		fields:    append(append([]string{}, redactedFields...), opts.RedactFields...),
+	}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+	if r.format.Marshal == nil || r.format.Unmarshal == nil {
+		r.format = JSON
+	}
+
+	if r.mode == ModeReplay {
+		//nolint:gosec // The cassette path is chosen by the test
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette, record it first: %w", err)
+		}
+		var cassette Cassette
+		if err := r.format.Unmarshal(data, &cassette); err != nil {
+			return nil, fmt.Errorf("failed to decode cassette %s: %w", path, err)
+		}
+		r.interactions = cassette.Interactions
+		r.played = make([]bool, len(cassette.Interactions))
+	}
+	return r, nil
+}
+
+// RoundTrip records or replays req.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		//nolint:errcheck
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+	recorded := RecordedRequest{Method: req.Method, URL: redactURL(req.URL), Header: r.redactHeader(req.Header)}
+	recorded.Body, recorded.BodyEncoding = r.redactBody(body)
+
+	if r.mode == ModeRecord {
+		return r.record(req, body, recorded)
+	}
+	return r.replay(req, recorded)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte, recorded RecordedRequest) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	if req.Body != nil {
+		out.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	resp, err := r.transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	//nolint:errcheck
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	interaction := Interaction{
+		Request:  recorded,
+		Response: RecordedResponse{StatusCode: resp.StatusCode, Header: r.redactHeader(resp.Header)},
+	}
+	interaction.Response.Body, interaction.Response.BodyEncoding = r.redactBody(respBody)
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+
+	// The caller gets the response as sent, secrets included
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.played[i] || !matchRequest(interaction.Request, recorded) {
+			continue
+		}
+		r.played[i] = true
+
+		body, err := decodeBody(interaction.Response.Body, interaction.Response.BodyEncoding)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode recorded response of %s: %w", describeRequest(interaction.Request), err)
+		}
+		header := interaction.Response.Header.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		code := interaction.Response.StatusCode
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", code, http.StatusText(code)),
+			StatusCode:    code,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	unmatched := &UnmatchedRequestError{Cassette: r.path, Request: recorded}
+	for i, interaction := range r.interactions {
+		if !r.played[i] && interaction.Request.Method == recorded.Method && requestPath(interaction.Request) == requestPath(recorded) {
+			unmatched.Similar = append(unmatched.Similar, interaction.Request)
+		}
+	}
+	return nil, unmatched
+}
+
+// Stop ends the recording or replay. When recording, it writes the
+// cassette. When replaying, it reports the interactions that were never
+// requested, so a test notices when the code under test stops making a
+// request.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode == ModeRecord {
+		data, err := r.format.Marshal(Cassette{Interactions: r.interactions})
+		if err != nil {
+			return fmt.Errorf("failed to encode cassette: %w", err)
+		}
+		//nolint:gosec // 0755 is appropriate for a testdata directory
+		if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+			return fmt.Errorf("failed to create cassette directory: %w", err)
+		}
+		if err := os.WriteFile(r.path, data, 0o600); err != nil {
+			return fmt.Errorf("failed to write cassette: %w", err)
+		}
+		return nil
+	}
+
+	var unplayed []string
+	for i, interaction := range r.interactions {
+		if !r.played[i] {
+			unplayed = append(unplayed, describeRequest(interaction.Request))
+		}
+	}
+	if len(unplayed) > 0 {
+		return fmt.Errorf("redminetest: %d of %d interactions in %s were not replayed:\n\t%s", len(unplayed), len(r.interactions), r.path, strings.Join(unplayed, "\n\t"))
+	}
+	return nil
+}
+
+// matchRequest reports whether req, redacted, matches the recorded request.
+func matchRequest(recorded, req RecordedRequest) bool {
+	if recorded.Method != req.Method || recorded.BodyEncoding != req.BodyEncoding {
+		return false
+	}
+	a, errA := url.Parse(recorded.URL)
+	b, errB := url.Parse(req.URL)
+	if errA != nil || errB != nil || a.Path != b.Path || a.Query().Encode() != b.Query().Encode() {
+		return false
+	}
+	if recorded.Body == req.Body {
+		return true
+	}
+	var va, vb any
+	if json.Unmarshal([]byte(recorded.Body), &va) != nil || json.Unmarshal([]byte(req.Body), &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+func requestPath(r RecordedRequest) string {
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return r.URL
+	}
+	return u.Path
+}
+
+// redactURL returns u with the key parameter, used for API and feed keys,
+// redacted.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	if q := u.Query(); q.Has("key") {
+		q.Set("key", Redacted)
+		redacted.RawQuery = q.Encode()
+	}
+	return redacted.String()
+}
+
+func (r *Recorder) redactHeader(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+	h = h.Clone()
+	for _, name := range r.headers {
+		if _, ok := h[http.CanonicalHeaderKey(name)]; ok {
+			h.Set(name, Redacted)
+		}
+	}
+	return h
+}
+
+// redactBody returns body as stored in a cassette along with its encoding.
+// Fields to redact are replaced in JSON bodies; other bodies are kept as
+// they are.
+func (r *Recorder) redactBody(body []byte) (string, string) {
+	if len(body) == 0 {
+		return "", ""
+	}
+	if !utf8.Valid(body) {
+		return base64.StdEncoding.EncodeToString(body), "base64"
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if dec.Decode(&v) != nil || !r.redactValue(v) {
+		return string(body), ""
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return string(body), ""
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), ""
+}
+
+// redactValue redacts the fields to redact in a decoded JSON value and
+// reports whether any was found.
+func (r *Recorder) redactValue(v any) bool {
+	found := false
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if containsFold(r.fields, key) {
+				v[key] = Redacted
+				found = true
+			} else if r.redactValue(value) {
+				found = true
+			}
+		}
+	case []any:
+		for _, value := range v {
+			if r.redactValue(value) {
+				found = true
+			}
+		}
+	}
+	return found
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeBody(body, encoding string) ([]byte, error) {
+	switch encoding {
+	case "":
+		return []byte(body), nil
+	case "base64":
+		return base64.StdEncoding.DecodeString(body)
+	default:
+		return nil, errors.New("unknown body encoding " + encoding)
+	}
+}
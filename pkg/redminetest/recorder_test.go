@@ -0,0 +1,240 @@
+package redminetest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// session runs the calls that TestRecorder records and replays.
+func session(t *testing.T, client *redmine.Client, projectID int) (*redmine.IssueResponse, *redmine.IssuesResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	created, err := client.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: projectID, Subject: "Recorded"})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	if err := client.UpdateIssue(ctx, created.Issue.ID, redmine.IssueUpdateRequest{Notes: "Still recorded"}); err != nil {
+		t.Fatalf("UpdateIssue failed: %v", err)
+	}
+	if _, err := client.CreateUser(ctx, redmine.User{Login: "rsmith", Firstname: "Robert", Lastname: "Smith", Mail: "rsmith@example.net", Password: "rsmith-secret"}); err != nil {
+		t.Fatalf("CreateUser failed: %v", err)
+	}
+	if _, err := client.Upload(ctx, "blob.bin", strings.NewReader("\xff\xfe\x00"), 3); err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	issues, err := client.ListIssues(ctx, &redmine.ListIssuesOptions{ProjectID: projectID, Limit: 10})
+	if err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+	return created, issues
+}
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "session.json")
+	srv := NewServer()
+	project := srv.AddProject(redmine.Project{Name: "Recorded", Identifier: "recorded"})
+
+	opts := &RecorderOptions{Mode: ModeRecord, RedactFields: []string{"mail"}}
+	rec, err := NewRecorder(path, opts)
+	if err != nil {
+		t.Fatalf("NewRecorder failed: %v", err)
+	}
+	recordedIssue, recordedIssues := session(t, srv.Client(srv.Admin.APIKey, redmine.WithTransport(rec)), project.ID)
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+	srv.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	for _, secret := range []string{srv.Admin.APIKey, "rsmith-secret", "rsmith@example.net"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("Expected %q to be redacted from the cassette", secret)
+		}
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if len(cassette.Interactions) != 5 {
+		t.Fatalf("Expected 5 interactions, got %d", len(cassette.Interactions))
+	}
+	if got := cassette.Interactions[0].Request.Header.Get("X-Redmine-Api-Key"); got != Redacted {
+		t.Errorf("Expected the API key header to be redacted, got %q", got)
+	}
+	if upload := cassette.Interactions[3].Request; upload.BodyEncoding != "base64" {
+		t.Errorf("Expected a base64 upload body, got %+v", upload)
+	}
+
+	// Replaying needs neither the server nor the API key
+	opts.Mode = ModeReplay
+	rec, err = NewRecorder(path, opts)
+	if err != nil {
+		t.Fatalf("NewRecorder failed: %v", err)
+	}
+	replayedIssue, replayedIssues := session(t, redmine.New("http://redmine.invalid", "other-key", redmine.WithTransport(rec)), project.ID)
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+	if replayedIssue.Issue.ID != recordedIssue.Issue.ID || replayedIssue.Issue.CreatedOn != recordedIssue.Issue.CreatedOn {
+		t.Errorf("Expected %+v, got %+v", recordedIssue.Issue, replayedIssue.Issue)
+	}
+	if replayedIssues.TotalCount != recordedIssues.TotalCount || replayedIssues.Issues[0].Subject != "Recorded" {
+		t.Errorf("Expected %+v, got %+v", recordedIssues, replayedIssues)
+	}
+}
+
+func TestRecorderReplayMismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.json")
+	srv, public, _, _, _ := newTestServer(t)
+
+	rec, err := NewRecorder(path, &RecorderOptions{Mode: ModeRecord})
+	if err != nil {
+		t.Fatalf("NewRecorder failed: %v", err)
+	}
+	client := srv.Client(srv.Admin.APIKey, redmine.WithTransport(rec))
+	ctx := context.Background()
+	if _, err := client.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "First"}); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	if _, err := client.ListIssues(ctx, &redmine.ListIssuesOptions{StatusID: "*"}); err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+
+	rec, err = NewRecorder(path, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder failed: %v", err)
+	}
+	client = redmine.New("http://redmine.invalid", "", redmine.WithTransport(rec))
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{name: "body", call: func() error {
+			_, err := client.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "Second"})
+			return err
+		}},
+		{name: "query", call: func() error {
+			_, err := client.ListIssues(ctx, &redmine.ListIssuesOptions{StatusID: "closed"})
+			return err
+		}},
+		{name: "path", call: func() error {
+			_, err := client.ShowIssue(ctx, 1, nil)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var unmatched *UnmatchedRequestError
+			if err := tt.call(); !errors.As(err, &unmatched) {
+				t.Fatalf("Expected an UnmatchedRequestError, got %v", err)
+			}
+			if unmatched.Cassette != path {
+				t.Errorf("Expected cassette %s, got %s", path, unmatched.Cassette)
+			}
+			if tt.name != "path" && len(unmatched.Similar) != 1 {
+				t.Errorf("Expected 1 similar request, got %+v", unmatched.Similar)
+			}
+		})
+	}
+
+	// The list is replayed once, whatever the order of the query parameters
+	if _, err := client.ListIssues(ctx, &redmine.ListIssuesOptions{StatusID: "*"}); err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+	var unmatched *UnmatchedRequestError
+	if _, err := client.ListIssues(ctx, &redmine.ListIssuesOptions{StatusID: "*"}); !errors.As(err, &unmatched) {
+		t.Errorf("Expected an UnmatchedRequestError on the second replay, got %v", err)
+	}
+
+	err = rec.Stop()
+	if err == nil || !strings.Contains(err.Error(), "1 of 2 interactions") || !strings.Contains(err.Error(), "/issues.json") {
+		t.Errorf("Expected the issue creation to be reported as not replayed, got %v", err)
+	}
+}
+
+func TestRecorderMissingCassette(t *testing.T) {
+	_, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), nil)
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected os.ErrNotExist, got %v", err)
+	}
+}
+
+func TestRecorderFormat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.cassette")
+	srv, _, _, _, _ := newTestServer(t)
+
+	// A compact JSON format stands in for YAML here
+	var marshaled, unmarshaled bool
+	format := Format{
+		Marshal: func(v any) ([]byte, error) {
+			marshaled = true
+			return json.Marshal(v)
+		},
+		Unmarshal: func(data []byte, v any) error {
+			unmarshaled = true
+			return json.Unmarshal(data, v)
+		},
+	}
+
+	rec, err := NewRecorder(path, &RecorderOptions{Mode: ModeRecord, Format: format})
+	if err != nil {
+		t.Fatalf("NewRecorder failed: %v", err)
+	}
+	if _, err := srv.Client("", redmine.WithTransport(rec)).ListProjects(context.Background(), nil); err != nil {
+		t.Fatalf("ListProjects failed: %v", err)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+	if _, err := NewRecorder(path, &RecorderOptions{Format: format}); err != nil {
+		t.Fatalf("NewRecorder failed: %v", err)
+	}
+	if !marshaled || !unmarshaled {
+		t.Errorf("Expected the format to be used, marshaled %v, unmarshaled %v", marshaled, unmarshaled)
+	}
+}
+
+func TestRecorderRedaction(t *testing.T) {
+	rec, err := NewRecorder("", &RecorderOptions{Mode: ModeRecord, RedactFields: []string{"token"}})
+	if err != nil {
+		t.Fatalf("NewRecorder failed: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "empty", body: "", want: ""},
+		{name: "nothing to redact", body: `{"b": 1, "a": "<x>"}`, want: `{"b": 1, "a": "<x>"}`},
+		{name: "nested", body: `{"user":{"login":"jsmith","password":"secret"}}`, want: `{"user":{"login":"jsmith","password":"REDACTED"}}`},
+		{name: "in arrays", body: `[{"token":"t1","id":12345678901234567890}]`, want: `[{"id":12345678901234567890,"token":"REDACTED"}]`},
+		{name: "case insensitive", body: `{"API_KEY":"k"}`, want: `{"API_KEY":"REDACTED"}`},
+		{name: "not JSON", body: "password=secret", want: "password=secret"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, encoding := rec.redactBody([]byte(tt.body))
+			if got != tt.want || encoding != "" {
+				t.Errorf("Expected %q, got %q (%s)", tt.want, got, encoding)
+			}
+		})
+	}
+}
@@ -0,0 +1,711 @@
+// Package redminetest provides an in-memory Redmine server for tests.
+//
+// A Server emulates the REST API for projects, users, memberships, versions,
+// issues, relations, watchers, time entries, wiki pages and uploads, along
+// with the trackers, statuses, enumerations and roles they refer to. State is
+// kept between requests, so code under test can create an issue and find it
+// in a later list. Lists are paginated and filtered like Redmine's, invalid
+// writes are rejected with 422 and the messages Redmine sends, and requests
+// are checked against the permissions of the user's roles.
+//
+//	srv := redminetest.NewServer()
+//	defer srv.Close()
+//	client := srv.Client(srv.Admin.APIKey)
+//
+// Groups, custom fields, issue categories, news, files and queries are not
+// emulated; their endpoints answer 404.
+//
+// A Recorder records the interactions with a real Redmine into a cassette and
+// replays them. Cassettes are JSON; the package has no YAML encoder, so YAML
+// cassettes need one supplied by the caller as a Format.
+package redminetest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// IDs of the roles created with a Server.
+const (
+	RoleManager   = 3
+	RoleDeveloper = 4
+	RoleReporter  = 5
+)
+
+// Permissions checked by the Server. A role grants the permissions it lists.
+var (
+	// ManagerPermissions are granted by the Manager role.
+	ManagerPermissions = []string{
+		"edit_project", "manage_members", "manage_versions",
+		"view_issues", "add_issues", "edit_issues", "add_issue_notes", "delete_issues",
+		"manage_issue_relations", "view_issue_watchers", "add_issue_watchers", "delete_issue_watchers",
+		"view_time_entries", "log_time", "edit_time_entries", "edit_own_time_entries",
+		"view_wiki_pages", "view_wiki_edits", "edit_wiki_pages", "delete_wiki_pages",
+	}
+	// DeveloperPermissions are granted by the Developer role.
+	DeveloperPermissions = []string{
+		"view_issues", "add_issues", "edit_issues", "add_issue_notes",
+		"manage_issue_relations", "view_issue_watchers", "add_issue_watchers",
+		"view_time_entries", "log_time", "edit_own_time_entries",
+		"view_wiki_pages", "view_wiki_edits", "edit_wiki_pages",
+	}
+	// ReporterPermissions are granted by the Reporter role.
+	ReporterPermissions = []string{
+		"view_issues", "add_issues", "add_issue_notes", "view_issue_watchers",
+		"view_time_entries", "view_wiki_pages", "view_wiki_edits",
+	}
+	// NonMemberPermissions are granted to logged-in users on public projects
+	// they are not a member of.
+	NonMemberPermissions = []string{
+		"view_issues", "add_issues", "add_issue_notes",
+		"view_time_entries", "view_wiki_pages", "view_wiki_edits",
+	}
+	// AnonymousPermissions are granted to anonymous requests on public projects.
+	AnonymousPermissions = []string{
+		"view_issues", "view_time_entries", "view_wiki_pages", "view_wiki_edits",
+	}
+)
+
+// Statuses of users and projects.
+const (
+	userActive      = 1
+	userRegistered  = 2
+	userLocked      = 3
+	projectActive   = 1
+	projectArchived = 9
+)
+
+// Pagination defaults of Redmine's list endpoints.
+const (
+	defaultLimit = 25
+	maxLimit     = 100
+)
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithClock sets the clock used for created_on, updated_on and other
+// timestamps. It defaults to time.Now. Timestamps are truncated to seconds,
+// as Redmine stores them.
+func WithClock(now func() time.Time) Option {
+	return func(s *Server) {
+		s.now = now
+	}
+}
+
+// Server is an in-memory Redmine. It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, to be passed to redmine.New.
+	URL string
+	// Admin is the administrator created with the server.
+	Admin redmine.User
+
+	srv *httptest.Server
+	now func() time.Time
+
+	mu          sync.Mutex
+	ids         map[string]int
+	users       []*redmine.User
+	roles       []redmine.Role
+	trackers    []redmine.Tracker
+	statuses    []redmine.IssueStatus
+	priorities  []redmine.Enumeration
+	activities  []redmine.Enumeration
+	projects    []*redmine.Project
+	memberships []*redmine.Membership
+	versions    []*redmine.Version
+	issues      []*redmine.Issue
+	relations   []*redmine.IssueRelation
+	timeEntries []*redmine.TimeEntry
+	wikiPages   []*wikiPage
+	attachments []*attachment
+}
+
+// NewServer starts a Server holding an administrator, the Manager, Developer
+// and Reporter roles, and Redmine's default trackers, statuses, priorities
+// and time entry activities. Close it when done.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		now: time.Now,
+		ids: map[string]int{},
+		roles: []redmine.Role{
+			{ID: RoleManager, Name: "Manager", Assignable: true, Permissions: ManagerPermissions},
+			{ID: RoleDeveloper, Name: "Developer", Assignable: true, Permissions: DeveloperPermissions},
+			{ID: RoleReporter, Name: "Reporter", Permissions: ReporterPermissions},
+		},
+		trackers: []redmine.Tracker{
+			{ID: 1, Name: "Bug", DefaultStatus: redmine.Resource{ID: 1, Name: "New"}},
+			{ID: 2, Name: "Feature", DefaultStatus: redmine.Resource{ID: 1, Name: "New"}},
+			{ID: 3, Name: "Support", DefaultStatus: redmine.Resource{ID: 1, Name: "New"}},
+		},
+		statuses: []redmine.IssueStatus{
+			{ID: 1, Name: "New"},
+			{ID: 2, Name: "In Progress"},
+			{ID: 3, Name: "Resolved"},
+			{ID: 4, Name: "Feedback"},
+			{ID: 5, Name: "Closed", IsClosed: true},
+			{ID: 6, Name: "Rejected", IsClosed: true},
+		},
+		priorities: []redmine.Enumeration{
+			{ID: 1, Name: "Low"},
+			{ID: 2, Name: "Normal", IsDefault: true},
+			{ID: 3, Name: "High"},
+			{ID: 4, Name: "Urgent"},
+			{ID: 5, Name: "Immediate"},
+		},
+		activities: []redmine.Enumeration{
+			{ID: 8, Name: "Design"},
+			{ID: 9, Name: "Development", IsDefault: true},
+		},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.Admin = s.AddUser(redmine.User{Login: "admin", Firstname: "Redmine", Lastname: "Admin", Mail: "admin@example.net", Admin: true})
+
+	s.srv = httptest.NewServer(s.routes())
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a client of the server authenticated with apiKey, or
+// anonymous if apiKey is empty.
+func (s *Server) Client(apiKey string, opts ...redmine.Option) *redmine.Client {
+	return redmine.New(s.URL, apiKey, opts...)
+}
+
+// AddUser adds an active user and returns it with its ID. An API key is
+// generated unless u.APIKey is set; Password, if set, allows basic
+// authentication.
+func (s *Server) AddUser(u redmine.User) redmine.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user := s.addUser(u)
+	return *user
+}
+
+// AddProject adds a project and returns it with its ID. The identifier
+// defaults to the lower-cased name.
+func (s *Server) AddProject(p redmine.Project) redmine.Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p.Identifier == "" {
+		p.Identifier = strings.ReplaceAll(strings.ToLower(p.Name), " ", "-")
+	}
+	now := s.timestamp()
+	p.ID = s.nextID("projects")
+	p.Status = projectActive
+	p.CreatedOn, p.UpdatedOn = now, now
+	s.projects = append(s.projects, &p)
+	return p
+}
+
+// AddMember makes the user a member of the project with the given roles.
+func (s *Server) AddMember(projectID, userID int, roleIDs ...int) redmine.Membership {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m := &redmine.Membership{ID: s.nextID("memberships"), Project: redmine.Resource{ID: projectID}, User: redmine.Resource{ID: userID}}
+	for _, id := range roleIDs {
+		m.Roles = append(m.Roles, redmine.Resource{ID: id})
+	}
+	s.memberships = append(s.memberships, m)
+	return s.membershipJSON(m)
+}
+
+func (s *Server) addUser(u redmine.User) *redmine.User {
+	now := s.timestamp()
+	u.ID = s.nextID("users")
+	if u.Status == 0 {
+		u.Status = userActive
+	}
+	if u.APIKey == "" {
+		u.APIKey = randomHex(20)
+	}
+	u.CreatedOn, u.UpdatedOn = now, now
+	s.users = append(s.users, &u)
+	return &u
+}
+
+// handler handles a request made by user, which is nil for anonymous
+// requests. s.mu is held.
+type handler func(w http.ResponseWriter, r *http.Request, user *redmine.User)
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+	handle := func(pattern string, h handler) {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			user, status := s.authenticate(r)
+			if status != 0 {
+				w.WriteHeader(status)
+				return
+			}
+			h(w, r, user)
+		})
+	}
+
+	handle("GET /trackers.json", s.listTrackers)
+	handle("GET /issue_statuses.json", s.listIssueStatuses)
+	handle("GET /enumerations/issue_priorities.json", s.listIssuePriorities)
+	handle("GET /enumerations/time_entry_activities.json", s.listTimeEntryActivities)
+	handle("GET /roles.json", s.listRoles)
+	handle("GET /roles/{id}", s.showRole)
+
+	handle("GET /users.json", s.listUsers)
+	handle("POST /users.json", s.createUser)
+	handle("GET /users/current.json", s.showCurrentUser)
+	handle("GET /users/{id}", s.showUser)
+	handle("PUT /users/{id}", s.updateUser)
+	handle("DELETE /users/{id}", s.deleteUser)
+
+	handle("GET /projects.json", s.listProjects)
+	handle("POST /projects.json", s.createProject)
+	handle("GET /projects/{project}", s.showProject)
+	handle("PUT /projects/{project}", s.updateProject)
+	handle("DELETE /projects/{project}", s.deleteProject)
+
+	handle("GET /projects/{project}/memberships.json", s.listMemberships)
+	handle("POST /projects/{project}/memberships.json", s.createMembership)
+	handle("GET /memberships/{id}", s.showMembership)
+	handle("PUT /memberships/{id}", s.updateMembership)
+	handle("DELETE /memberships/{id}", s.deleteMembership)
+
+	handle("GET /projects/{project}/versions.json", s.listVersions)
+	handle("POST /projects/{project}/versions.json", s.createVersion)
+	handle("GET /versions/{id}", s.showVersion)
+	handle("PUT /versions/{id}", s.updateVersion)
+	handle("DELETE /versions/{id}", s.deleteVersion)
+
+	handle("GET /issues.json", s.listIssues)
+	handle("POST /issues.json", s.createIssue)
+	handle("GET /issues/{id}", s.showIssue)
+	handle("PUT /issues/{id}", s.updateIssue)
+	handle("DELETE /issues/{id}", s.deleteIssue)
+	handle("POST /issues/{id}/watchers.json", s.addWatcher)
+	handle("DELETE /issues/{id}/watchers/{user}", s.removeWatcher)
+
+	handle("GET /issues/{id}/relations.json", s.listRelations)
+	handle("POST /issues/{id}/relations.json", s.createRelation)
+	handle("GET /relations/{id}", s.showRelation)
+	handle("DELETE /relations/{id}", s.deleteRelation)
+
+	handle("GET /time_entries.json", s.listTimeEntries)
+	handle("POST /time_entries.json", s.createTimeEntry)
+	handle("GET /time_entries/{id}", s.showTimeEntry)
+	handle("PUT /time_entries/{id}", s.updateTimeEntry)
+	handle("DELETE /time_entries/{id}", s.deleteTimeEntry)
+
+	handle("GET /projects/{project}/wiki/index.json", s.listWikiPages)
+	handle("GET /projects/{project}/wiki/{page}", s.showWikiPage)
+	handle("GET /projects/{project}/wiki/{page}/{version}", s.showWikiPage)
+	handle("PUT /projects/{project}/wiki/{page}", s.saveWikiPage)
+	handle("DELETE /projects/{project}/wiki/{page}", s.deleteWikiPage)
+
+	handle("POST /uploads.json", s.upload)
+	handle("GET /attachments/{id}", s.showAttachment)
+	handle("PATCH /attachments/{id}", s.updateAttachment)
+	handle("DELETE /attachments/{id}", s.deleteAttachment)
+	handle("GET /attachments/download/{id}", s.downloadAttachment)
+	handle("GET /attachments/download/{id}/{filename}", s.downloadAttachment)
+
+	return mux
+}
+
+// authenticate returns the user making r, from its API key or basic
+// credentials and X-Redmine-Switch-User, or the status to answer with if
+// the credentials are invalid.
+func (s *Server) authenticate(r *http.Request) (*redmine.User, int) {
+	key := r.Header.Get("X-Redmine-Api-Key")
+	if key == "" {
+		key = r.URL.Query().Get("key")
+	}
+
+	var user *redmine.User
+	if login, password, ok := r.BasicAuth(); ok {
+		// The login may also be an API key, with any password
+		user = s.findUser(func(u *redmine.User) bool {
+			return u.APIKey == login || (u.Password != "" && u.Login == login && u.Password == password)
+		})
+		if user == nil {
+			return nil, http.StatusUnauthorized
+		}
+	} else if key != "" {
+		user = s.findUser(func(u *redmine.User) bool { return u.APIKey == key })
+		if user == nil {
+			return nil, http.StatusUnauthorized
+		}
+	}
+	if user != nil && user.Status != userActive {
+		return nil, http.StatusUnauthorized
+	}
+
+	if login := r.Header.Get("X-Redmine-Switch-User"); login != "" && user != nil && user.Admin {
+		user = s.findUser(func(u *redmine.User) bool { return u.Login == login && u.Status == userActive })
+		if user == nil {
+			return nil, http.StatusPreconditionFailed
+		}
+	}
+	return user, 0
+}
+
+// allowed reports whether user has perm on project.
+func (s *Server) allowed(user *redmine.User, project *redmine.Project, perm string) bool {
+	if user != nil && user.Admin {
+		return true
+	}
+	if project.Status != projectActive && !strings.HasPrefix(perm, "view_") {
+		return false
+	}
+	if user != nil {
+		if m := s.findMembership(project.ID, user.ID); m != nil {
+			for _, r := range m.Roles {
+				if role := s.findRole(r.ID); role != nil && slices.Contains(role.Permissions, perm) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	if !project.IsPublic {
+		return false
+	}
+	if user == nil {
+		return slices.Contains(AnonymousPermissions, perm)
+	}
+	return slices.Contains(NonMemberPermissions, perm)
+}
+
+// authorize answers 401 to anonymous requests and 403 to others unless user
+// has perm on project, and reports whether the request may proceed.
+func (s *Server) authorize(w http.ResponseWriter, user *redmine.User, project *redmine.Project, perm string) bool {
+	if s.allowed(user, project, perm) {
+		return true
+	}
+	deny(w, user)
+	return false
+}
+
+// requireAdmin answers 401 or 403 unless user is an administrator.
+func requireAdmin(w http.ResponseWriter, user *redmine.User) bool {
+	if user != nil && user.Admin {
+		return true
+	}
+	deny(w, user)
+	return false
+}
+
+// requireLogin answers 401 to anonymous requests.
+func requireLogin(w http.ResponseWriter, user *redmine.User) bool {
+	if user != nil {
+		return true
+	}
+	deny(w, user)
+	return false
+}
+
+func deny(w http.ResponseWriter, user *redmine.User) {
+	if user == nil {
+		w.Header().Set("WWW-Authenticate", `Basic realm="Redmine API"`)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	w.WriteHeader(http.StatusForbidden)
+}
+
+func (s *Server) listTrackers(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	writeJSON(w, http.StatusOK, redmine.TrackersResponse{Trackers: s.trackers})
+}
+
+func (s *Server) listIssueStatuses(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	writeJSON(w, http.StatusOK, redmine.IssueStatusesResponse{IssueStatuses: s.statuses})
+}
+
+func (s *Server) listIssuePriorities(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	writeJSON(w, http.StatusOK, map[string]any{"issue_priorities": s.priorities})
+}
+
+func (s *Server) listTimeEntryActivities(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	writeJSON(w, http.StatusOK, map[string]any{"time_entry_activities": s.activities})
+}
+
+func (s *Server) listRoles(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	roles := make([]redmine.Role, 0, len(s.roles))
+	for _, role := range s.roles {
+		roles = append(roles, redmine.Role{ID: role.ID, Name: role.Name})
+	}
+	writeJSON(w, http.StatusOK, redmine.RolesResponse{Roles: roles})
+}
+
+func (s *Server) showRole(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	role := s.findRole(pathID(r, "id"))
+	if role == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, redmine.RoleResponse{Role: *role})
+}
+
+// nextID returns the next ID of a kind of record.
+func (s *Server) nextID(kind string) int {
+	s.ids[kind]++
+	return s.ids[kind]
+}
+
+// timestamp returns the current time as Redmine stores it.
+func (s *Server) timestamp() redmine.Timestamp {
+	return redmine.Timestamp{Time: s.now().UTC().Truncate(time.Second)}
+}
+
+func (s *Server) findUser(match func(*redmine.User) bool) *redmine.User {
+	i := slices.IndexFunc(s.users, match)
+	if i < 0 {
+		return nil
+	}
+	return s.users[i]
+}
+
+func (s *Server) findRole(id int) *redmine.Role {
+	i := slices.IndexFunc(s.roles, func(r redmine.Role) bool { return r.ID == id })
+	if i < 0 {
+		return nil
+	}
+	return &s.roles[i]
+}
+
+func (s *Server) findTracker(id int) *redmine.Tracker {
+	i := slices.IndexFunc(s.trackers, func(t redmine.Tracker) bool { return t.ID == id })
+	if i < 0 {
+		return nil
+	}
+	return &s.trackers[i]
+}
+
+func (s *Server) findStatus(id int) *redmine.IssueStatus {
+	i := slices.IndexFunc(s.statuses, func(st redmine.IssueStatus) bool { return st.ID == id })
+	if i < 0 {
+		return nil
+	}
+	return &s.statuses[i]
+}
+
+func findEnumeration(values []redmine.Enumeration, id int) *redmine.Enumeration {
+	i := slices.IndexFunc(values, func(e redmine.Enumeration) bool { return e.ID == id })
+	if i < 0 {
+		return nil
+	}
+	return &values[i]
+}
+
+func defaultEnumeration(values []redmine.Enumeration) int {
+	for _, e := range values {
+		if e.IsDefault {
+			return e.ID
+		}
+	}
+	return values[0].ID
+}
+
+// userRef returns a reference to the user with the given ID, or the zero
+// Resource for 0.
+func (s *Server) userRef(id int) redmine.Resource {
+	if u := s.findUser(func(u *redmine.User) bool { return u.ID == id }); u != nil {
+		return redmine.Resource{ID: id, Name: strings.TrimSpace(u.Firstname + " " + u.Lastname)}
+	}
+	return redmine.Resource{ID: id}
+}
+
+// writeJSON writes v as the JSON response.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	//nolint:errcheck
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeErrors answers 422 with Redmine's error payload.
+func writeErrors(w http.ResponseWriter, errs ...string) {
+	writeJSON(w, http.StatusUnprocessableEntity, map[string][]string{"errors": errs})
+}
+
+// pathID returns the numeric path value name, ignoring a .json suffix, or 0.
+func pathID(r *http.Request, name string) int {
+	id, err := strconv.Atoi(strings.TrimSuffix(r.PathValue(name), ".json"))
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// pathValue returns the path value name without its .json suffix.
+func pathValue(r *http.Request, name string) string {
+	return strings.TrimSuffix(r.PathValue(name), ".json")
+}
+
+// includes reports whether the include parameter of r lists name.
+func includes(r *http.Request, name string) bool {
+	return slices.Contains(strings.Split(r.URL.Query().Get("include"), ","), name)
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	//nolint:errcheck
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// fields holds the attributes of a request body, such as the object under
+// "issue". Values are decoded leniently, as Redmine does: numbers may be sent
+// as strings, and "" or null unsets a value.
+type fields map[string]json.RawMessage
+
+// decodeFields reads the object under key in the body of r, or the body
+// itself if key is empty. It answers 400 and returns false if the body is
+// not a JSON object.
+func decodeFields(w http.ResponseWriter, r *http.Request, key string) (fields, bool) {
+	var f fields
+	err := json.NewDecoder(r.Body).Decode(&f)
+	if err == nil && key != "" {
+		var nested fields
+		if raw, ok := f[key]; ok {
+			err = json.Unmarshal(raw, &nested)
+		}
+		f = nested
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return nil, false
+	}
+	if f == nil {
+		f = fields{}
+	}
+	return f, true
+}
+
+func (f fields) has(name string) bool {
+	_, ok := f[name]
+	return ok
+}
+
+func (f fields) value(name string) any {
+	var v any
+	//nolint:errcheck
+	json.Unmarshal(f[name], &v)
+	return v
+}
+
+// int returns the integer value of name, 0 if it is unset, and false if it
+// is not a number.
+func (f fields) int(name string) (int, bool) {
+	switch v := f.value(name).(type) {
+	case nil:
+		return 0, true
+	case float64:
+		return int(v), v == float64(int(v))
+	case string:
+		if v == "" {
+			return 0, true
+		}
+		n, err := strconv.Atoi(v)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// float returns the number value of name, 0 if it is unset, and false if it
+// is not a number.
+func (f fields) float(name string) (float64, bool) {
+	switch v := f.value(name).(type) {
+	case nil:
+		return 0, true
+	case float64:
+		return v, true
+	case string:
+		if v == "" {
+			return 0, true
+		}
+		n, err := strconv.ParseFloat(v, 64)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func (f fields) string(name string) string {
+	switch v := f.value(name).(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return ""
+	}
+}
+
+func (f fields) bool(name string) bool {
+	switch v := f.value(name).(type) {
+	case bool:
+		return v
+	case string:
+		return v == "1" || v == "true"
+	case float64:
+		return v == 1
+	default:
+		return false
+	}
+}
+
+// date returns the date value of name, the zero Date if it is unset, and
+// false if it is not a date.
+func (f fields) date(name string) (redmine.Date, bool) {
+	d, err := redmine.ParseDate(f.string(name))
+	return d, err == nil
+}
+
+// ints returns the integers listed in name.
+func (f fields) ints(name string) ([]int, bool) {
+	var values []any
+	if err := json.Unmarshal(f[name], &values); err != nil {
+		return nil, false
+	}
+	ids := make([]int, 0, len(values))
+	for _, v := range values {
+		n, ok := fields{"v": mustMarshal(v)}.int("v")
+		if !ok {
+			return nil, false
+		}
+		ids = append(ids, n)
+	}
+	return ids, true
+}
+
+func mustMarshal(v any) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("redminetest: %v", err))
+	}
+	return data
+}
@@ -0,0 +1,246 @@
+package redminetest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// newTestServer starts a server holding a public and a private project, with
+// jsmith as developer and dlopper as reporter of both.
+func newTestServer(t *testing.T, opts ...Option) (srv *Server, public, private redmine.Project, jsmith, dlopper redmine.User) {
+	t.Helper()
+
+	srv = NewServer(opts...)
+	t.Cleanup(srv.Close)
+
+	public = srv.AddProject(redmine.Project{Name: "eCookbook", IsPublic: true})
+	private = srv.AddProject(redmine.Project{Name: "Private Child", Identifier: "private-child"})
+	jsmith = srv.AddUser(redmine.User{Login: "jsmith", Firstname: "John", Lastname: "Smith", Mail: "jsmith@example.net", Password: "jsmith-secret"})
+	dlopper = srv.AddUser(redmine.User{Login: "dlopper", Firstname: "Dave", Lastname: "Lopper", Mail: "dlopper@example.net"})
+	for _, p := range []redmine.Project{public, private} {
+		srv.AddMember(p.ID, jsmith.ID, RoleDeveloper)
+		srv.AddMember(p.ID, dlopper.ID, RoleReporter)
+	}
+	return srv, public, private, jsmith, dlopper
+}
+
+func TestServerAuthentication(t *testing.T) {
+	srv, _, _, jsmith, _ := newTestServer(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		client    *redmine.Client
+		wantLogin string
+		wantErr   error
+	}{
+		{name: "api key", client: srv.Client(jsmith.APIKey), wantLogin: "jsmith"},
+		{name: "basic auth with api key", client: srv.Client("", redmine.WithAuthenticator(redmine.BasicAuth(jsmith.APIKey, "x"))), wantLogin: "jsmith"},
+		{name: "basic auth with password", client: srv.Client("", redmine.WithAuthenticator(redmine.BasicAuth("jsmith", "jsmith-secret"))), wantLogin: "jsmith"},
+		{name: "wrong password", client: srv.Client("", redmine.WithAuthenticator(redmine.BasicAuth("jsmith", "wrong"))), wantErr: redmine.ErrUnauthorized},
+		{name: "unknown key", client: srv.Client("unknown"), wantErr: redmine.ErrUnauthorized},
+		{name: "anonymous", client: srv.Client(""), wantErr: redmine.ErrUnauthorized},
+		{name: "switch user", client: srv.Client(srv.Admin.APIKey, redmine.WithSwitchUser("jsmith")), wantLogin: "jsmith"},
+		{name: "switch user ignored for non-admins", client: srv.Client(jsmith.APIKey, redmine.WithSwitchUser("admin")), wantLogin: "jsmith"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tt.client.GetCurrentUser(ctx, nil)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetCurrentUser failed: %v", err)
+			}
+			if resp.User.Login != tt.wantLogin {
+				t.Errorf("Expected login %q, got %q", tt.wantLogin, resp.User.Login)
+			}
+		})
+	}
+}
+
+func TestServerSwitchUserUnknownLogin(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	_, err := srv.Client(srv.Admin.APIKey, redmine.WithSwitchUser("nobody")).GetCurrentUser(context.Background(), nil)
+	var apiErr *redmine.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("Expected 412, got %v", err)
+	}
+}
+
+func TestServerLockedUser(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	locked := srv.AddUser(redmine.User{Login: "locked", Firstname: "Locked", Lastname: "User", Mail: "locked@example.net", Status: userLocked})
+	_, err := srv.Client(locked.APIKey).ListProjects(context.Background(), nil)
+	if !errors.Is(err, redmine.ErrUnauthorized) {
+		t.Errorf("Expected ErrUnauthorized, got %v", err)
+	}
+}
+
+func TestServerPermissions(t *testing.T) {
+	srv, public, private, jsmith, dlopper := newTestServer(t)
+	outsider := srv.AddUser(redmine.User{Login: "outsider", Firstname: "Out", Lastname: "Sider", Mail: "outsider@example.net"})
+	ctx := context.Background()
+
+	created, err := srv.Client(jsmith.APIKey).CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: private.ID, Subject: "Private bug"})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	id := created.Issue.ID
+
+	tests := []struct {
+		name    string
+		call    func() error
+		wantErr error
+	}{
+		{
+			name: "reporter cannot edit",
+			call: func() error {
+				return srv.Client(dlopper.APIKey).UpdateIssue(ctx, id, redmine.IssueUpdateRequest{Subject: "Renamed"})
+			},
+			wantErr: redmine.ErrForbidden,
+		},
+		{
+			name: "reporter can add notes",
+			call: func() error {
+				return srv.Client(dlopper.APIKey).UpdateIssue(ctx, id, redmine.IssueUpdateRequest{Notes: "Me too"})
+			},
+		},
+		{
+			name: "non-member cannot see private project",
+			call: func() error {
+				_, err := srv.Client(outsider.APIKey).ShowProject(ctx, private.Identifier, nil)
+				return err
+			},
+			wantErr: redmine.ErrForbidden,
+		},
+		{
+			name: "non-member can report on public project",
+			call: func() error {
+				_, err := srv.Client(outsider.APIKey).CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "Typo"})
+				return err
+			},
+		},
+		{
+			name: "anonymous must log in to report",
+			call: func() error {
+				_, err := srv.Client("").CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: public.ID, Subject: "Typo"})
+				return err
+			},
+			wantErr: redmine.ErrUnauthorized,
+		},
+		{
+			name: "anonymous cannot see private project",
+			call: func() error {
+				_, err := srv.Client("").ShowIssue(ctx, id, nil)
+				return err
+			},
+			wantErr: redmine.ErrUnauthorized,
+		},
+		{
+			name: "developer cannot manage members",
+			call: func() error {
+				_, err := srv.Client(jsmith.APIKey).CreateMembership(ctx, public.Identifier, redmine.MembershipCreateUpdate{UserID: outsider.ID, RoleIDs: []int{RoleReporter}})
+				return err
+			},
+			wantErr: redmine.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestServerMetadata(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	client := srv.Client(srv.Admin.APIKey)
+	ctx := context.Background()
+
+	r := redmine.NewResolver(client)
+	for _, tt := range []struct {
+		resolve func(context.Context, string) (int, error)
+		name    string
+		want    int
+	}{
+		{r.TrackerID, "feature", 2},
+		{r.StatusID, "Closed", 5},
+		{r.PriorityID, "urgent", 4},
+		{r.UserID, "admin", srv.Admin.ID},
+	} {
+		got, err := tt.resolve(ctx, tt.name)
+		if err != nil {
+			t.Fatalf("Resolving %q failed: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("Expected %q to resolve to %d, got %d", tt.name, tt.want, got)
+		}
+	}
+
+	activities, err := client.ListTimeEntryActivities(ctx)
+	if err != nil {
+		t.Fatalf("ListTimeEntryActivities failed: %v", err)
+	}
+	if len(activities.Enumerations) != 2 || !activities.Enumerations[1].IsDefault {
+		t.Errorf("Unexpected activities: %+v", activities.Enumerations)
+	}
+
+	roles, err := client.ListRoles(ctx)
+	if err != nil {
+		t.Fatalf("ListRoles failed: %v", err)
+	}
+	if len(roles.Roles) != 3 {
+		t.Errorf("Expected 3 roles, got %d", len(roles.Roles))
+	}
+	role, err := client.ShowRole(ctx, RoleReporter)
+	if err != nil {
+		t.Fatalf("ShowRole failed: %v", err)
+	}
+	if role.Role.Name != "Reporter" || len(role.Role.Permissions) != len(ReporterPermissions) {
+		t.Errorf("Unexpected role: %+v", role.Role)
+	}
+}
+
+func TestServerClock(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 30, 15, 500, time.UTC)
+	srv := NewServer(WithClock(func() time.Time { return now }))
+	defer srv.Close()
+
+	p := srv.AddProject(redmine.Project{Name: "Clocked"})
+	if !p.CreatedOn.Equal(now.Truncate(time.Second)) {
+		t.Errorf("Expected created_on %v, got %v", now.Truncate(time.Second), p.CreatedOn)
+	}
+}
+
+func TestServerNotEmulated(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	_, err := srv.Client(srv.Admin.APIKey).ListGroups(context.Background(), nil)
+	if !errors.Is(err, redmine.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
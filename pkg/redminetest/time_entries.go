@@ -0,0 +1,259 @@
+package redminetest
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// timeEntryJSON returns e with the names of its references.
+func (s *Server) timeEntryJSON(e *redmine.TimeEntry) redmine.TimeEntry {
+	entry := *e
+	entry.Project = s.projectRef(e.Project.ID)
+	entry.User = s.userRef(e.User.ID)
+	entry.Activity = enumerationRef(s.activities, e.Activity.ID)
+	return entry
+}
+
+// timeEntryByID returns the time entry named by the path value "id" if user
+// can see it, answering 404, 401 or 403 otherwise.
+func (s *Server) timeEntryByID(w http.ResponseWriter, r *http.Request, user *redmine.User) *redmine.TimeEntry {
+	id := pathID(r, "id")
+	i := slices.IndexFunc(s.timeEntries, func(e *redmine.TimeEntry) bool { return e.ID == id })
+	if i < 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return nil
+	}
+	e := s.timeEntries[i]
+	p := s.findProject(strconv.Itoa(e.Project.ID))
+	if !s.visible(user, p) || !s.allowed(user, p, "view_time_entries") {
+		deny(w, user)
+		return nil
+	}
+	return e
+}
+
+// canEditTimeEntry reports whether user may change or delete e.
+func (s *Server) canEditTimeEntry(user *redmine.User, e *redmine.TimeEntry) bool {
+	p := s.findProject(strconv.Itoa(e.Project.ID))
+	return s.allowed(user, p, "edit_time_entries") || (user != nil && user.ID == e.User.ID && s.allowed(user, p, "edit_own_time_entries"))
+}
+
+func (s *Server) listTimeEntries(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	q := r.URL.Query()
+	var projects []int
+	if id := q.Get("project_id"); id != "" {
+		p := s.findProject(id)
+		if p == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if !s.visible(user, p) || !s.allowed(user, p, "view_time_entries") {
+			deny(w, user)
+			return
+		}
+		projects = s.descendants(p)
+	}
+
+	userID := q.Get("user_id")
+	if userID == "me" && user != nil {
+		userID = strconv.Itoa(user.ID)
+	}
+	from, to := q.Get("from"), q.Get("to")
+	if on := q.Get("spent_on"); on != "" {
+		from, to = on, on
+	}
+	for _, d := range []string{from, to} {
+		if _, err := redmine.ParseDate(d); err != nil {
+			writeErrors(w, "Date is invalid")
+			return
+		}
+	}
+
+	var entries []redmine.TimeEntry
+	for _, e := range s.timeEntries {
+		p := s.findProject(strconv.Itoa(e.Project.ID))
+		switch {
+		case projects != nil && !slices.Contains(projects, e.Project.ID),
+			!s.visible(user, p) || !s.allowed(user, p, "view_time_entries"),
+			userID != "" && userID != strconv.Itoa(e.User.ID),
+			q.Has("issue_id") && q.Get("issue_id") != strconv.Itoa(e.Issue.ID),
+			q.Has("activity_id") && q.Get("activity_id") != strconv.Itoa(e.Activity.ID),
+			from != "" && e.SpentOn.String() < from,
+			to != "" && e.SpentOn.String() > to:
+			continue
+		}
+		entries = append(entries, s.timeEntryJSON(e))
+	}
+	// Newest first, as Redmine lists them
+	slices.SortStableFunc(entries, func(a, b redmine.TimeEntry) int {
+		return cmp.Or(b.SpentOn.Compare(a.SpentOn.Time), cmp.Compare(b.ID, a.ID))
+	})
+
+	page, offset, limit := paginate(r, entries)
+	writeJSON(w, http.StatusOK, redmine.TimeEntriesResponse{TimeEntries: page, TotalCount: len(entries), Offset: offset, Limit: limit})
+}
+
+func (s *Server) showTimeEntry(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	e := s.timeEntryByID(w, r, user)
+	if e == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, redmine.TimeEntryResponse{TimeEntry: s.timeEntryJSON(e)})
+}
+
+func (s *Server) createTimeEntry(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	if !requireLogin(w, user) {
+		return
+	}
+	f, ok := decodeFields(w, r, "time_entry")
+	if !ok {
+		return
+	}
+
+	e := &redmine.TimeEntry{
+		User:     redmine.Resource{ID: user.ID},
+		Activity: redmine.Resource{ID: defaultEnumeration(s.activities)},
+		SpentOn:  redmine.DateOf(s.now()),
+	}
+	errs := s.applyTimeEntry(e, f)
+	if len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+	p := s.findProject(strconv.Itoa(e.Project.ID))
+	if !s.visible(user, p) {
+		deny(w, user)
+		return
+	}
+	if !s.authorize(w, user, p, "log_time") {
+		return
+	}
+	if e.User.ID != user.ID && !user.Admin {
+		writeErrors(w, "User is invalid")
+		return
+	}
+
+	now := s.timestamp()
+	e.ID = s.nextID("time_entries")
+	e.CreatedOn, e.UpdatedOn = now, now
+	s.timeEntries = append(s.timeEntries, e)
+	writeJSON(w, http.StatusCreated, redmine.TimeEntryResponse{TimeEntry: s.timeEntryJSON(e)})
+}
+
+func (s *Server) updateTimeEntry(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	e := s.timeEntryByID(w, r, user)
+	if e == nil {
+		return
+	}
+	if !s.canEditTimeEntry(user, e) {
+		deny(w, user)
+		return
+	}
+	f, ok := decodeFields(w, r, "time_entry")
+	if !ok {
+		return
+	}
+
+	updated := *e
+	errs := s.applyTimeEntry(&updated, f)
+	if updated.User.ID != e.User.ID && !user.Admin {
+		errs = append(errs, "User is invalid")
+	}
+	if p := s.findProject(strconv.Itoa(updated.Project.ID)); p != nil && updated.Project.ID != e.Project.ID && !s.allowed(user, p, "log_time") {
+		errs = append(errs, "Project is invalid")
+	}
+	if len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+	updated.UpdatedOn = s.timestamp()
+	*e = updated
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteTimeEntry(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	e := s.timeEntryByID(w, r, user)
+	if e == nil {
+		return
+	}
+	if !s.canEditTimeEntry(user, e) {
+		deny(w, user)
+		return
+	}
+	s.timeEntries = slices.DeleteFunc(s.timeEntries, func(other *redmine.TimeEntry) bool { return other == e })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// applyTimeEntry applies the attributes in f to e and returns the validation
+// errors. The project of an entry logged on an issue is the issue's project.
+func (s *Server) applyTimeEntry(e *redmine.TimeEntry, f fields) []string {
+	var errs []string
+	if f.has("issue_id") {
+		id, _ := f.int("issue_id")
+		e.Issue = redmine.Resource{ID: id}
+	}
+	if f.has("project_id") {
+		id, ok := f.int("project_id")
+		if !ok {
+			if p := s.findProject(f.string("project_id")); p != nil {
+				id = p.ID
+			}
+		}
+		e.Project = redmine.Resource{ID: id}
+	}
+	if e.Issue.ID > 0 {
+		i := s.findIssue(e.Issue.ID)
+		if i == nil {
+			errs = append(errs, "Issue is invalid")
+		} else {
+			e.Project = redmine.Resource{ID: i.Project.ID}
+		}
+	}
+	if s.findProject(strconv.Itoa(e.Project.ID)) == nil {
+		errs = append(errs, "Project cannot be blank")
+	}
+
+	if f.has("hours") {
+		hours, ok := f.float("hours")
+		if !ok {
+			errs = append(errs, "Hours is invalid")
+		}
+		e.Hours = hours
+	}
+	switch {
+	case e.Hours == 0:
+		errs = append(errs, "Hours cannot be blank")
+	case e.Hours < 0 || e.Hours >= 1000:
+		errs = append(errs, "Hours is invalid")
+	}
+	if f.has("activity_id") {
+		e.Activity.ID, _ = f.int("activity_id")
+	}
+	if findEnumeration(s.activities, e.Activity.ID) == nil {
+		errs = append(errs, "Activity is not included in the list")
+	}
+	if f.has("spent_on") {
+		d, ok := f.date("spent_on")
+		if !ok || d.IsZero() {
+			errs = append(errs, "Date is invalid")
+		}
+		e.SpentOn = d
+	}
+	if f.has("comments") {
+		if e.Comments = f.string("comments"); len([]rune(e.Comments)) > 1024 {
+			errs = append(errs, "Comment is too long (maximum is 1024 characters)")
+		}
+	}
+	if f.has("user_id") {
+		id, _ := f.int("user_id")
+		if s.findUser(func(u *redmine.User) bool { return u.ID == id }) == nil {
+			errs = append(errs, "User is invalid")
+		}
+		e.User = redmine.Resource{ID: id}
+	}
+	return errs
+}
@@ -0,0 +1,127 @@
+package redminetest
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+func TestServerTimeEntries(t *testing.T) {
+	c := &clock{t: time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)}
+	srv, public, private, jsmith, dlopper := newTestServer(t, WithClock(c.now))
+	client := srv.Client(jsmith.APIKey)
+	admin := srv.Client(srv.Admin.APIKey)
+	ctx := context.Background()
+
+	issue, err := client.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: private.ID, Subject: "Tracked"})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+
+	created, err := client.CreateTimeEntry(ctx, redmine.TimeEntryCreateRequest{IssueID: issue.Issue.ID, Hours: 2.5, Comments: "Investigation"})
+	if err != nil {
+		t.Fatalf("CreateTimeEntry failed: %v", err)
+	}
+	e := created.TimeEntry
+	if e.Project.ID != private.ID || e.User.ID != jsmith.ID || e.Activity.Name != "Development" || e.SpentOn.String() != "2025-06-02" {
+		t.Errorf("Unexpected time entry: %+v", e)
+	}
+
+	entries := []redmine.TimeEntryCreateRequest{
+		{ProjectID: public.ID, Hours: 1, SpentOn: redmine.NewDate(2025, 5, 30), ActivityID: 8},
+		{ProjectID: public.ID, Hours: 3, SpentOn: redmine.NewDate(2025, 6, 1), UserID: dlopper.ID},
+	}
+	for _, req := range entries {
+		if _, err := admin.CreateTimeEntry(ctx, req); err != nil {
+			t.Fatalf("CreateTimeEntry failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		opts *redmine.ListTimeEntriesOptions
+		want []int
+	}{
+		{name: "newest first", opts: nil, want: []int{1, 3, 2}},
+		{name: "project", opts: &redmine.ListTimeEntriesOptions{ProjectID: public.Identifier}, want: []int{3, 2}},
+		{name: "user", opts: &redmine.ListTimeEntriesOptions{UserID: dlopper.ID}, want: []int{3}},
+		{name: "day", opts: &redmine.ListTimeEntriesOptions{SpentOn: "2025-06-01"}, want: []int{3}},
+		{name: "range", opts: &redmine.ListTimeEntriesOptions{From: "2025-05-31", To: "2025-06-30"}, want: []int{1, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := admin.ListTimeEntries(ctx, tt.opts)
+			if err != nil {
+				t.Fatalf("ListTimeEntries failed: %v", err)
+			}
+			var ids []int
+			for _, e := range resp.TimeEntries {
+				ids = append(ids, e.ID)
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("Expected time entries %v, got %v", tt.want, ids)
+			}
+		})
+	}
+
+	// Spent time is summed up on the issue
+	shown, err := client.ShowIssue(ctx, issue.Issue.ID, nil)
+	if err != nil {
+		t.Fatalf("ShowIssue failed: %v", err)
+	}
+	if shown.Issue.SpentHours != 2.5 {
+		t.Errorf("Expected 2.5 spent hours, got %v", shown.Issue.SpentHours)
+	}
+
+	if err := client.UpdateTimeEntry(ctx, e.ID, redmine.TimeEntryUpdateRequest{Hours: 3}); err != nil {
+		t.Fatalf("UpdateTimeEntry failed: %v", err)
+	}
+	// Developers may only edit their own time
+	if err := client.UpdateTimeEntry(ctx, 3, redmine.TimeEntryUpdateRequest{Hours: 1}); !errors.Is(err, redmine.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+	// Reporters can see the time spent on their projects
+	if _, err := srv.Client(dlopper.APIKey).ShowTimeEntry(ctx, e.ID); err != nil {
+		t.Errorf("Expected reporters to see time entries, got %v", err)
+	}
+	if err := client.DeleteTimeEntry(ctx, e.ID); err != nil {
+		t.Fatalf("DeleteTimeEntry failed: %v", err)
+	}
+	if _, err := client.ShowTimeEntry(ctx, e.ID); !errors.Is(err, redmine.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestServerTimeEntryValidation(t *testing.T) {
+	srv, public, _, jsmith, dlopper := newTestServer(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  redmine.TimeEntryCreateRequest
+		want []string
+	}{
+		{name: "no project", req: redmine.TimeEntryCreateRequest{Hours: 1}, want: []string{"Project cannot be blank"}},
+		{name: "unknown issue", req: redmine.TimeEntryCreateRequest{IssueID: 999, Hours: 1}, want: []string{"Issue is invalid", "Project cannot be blank"}},
+		{name: "blank hours", req: redmine.TimeEntryCreateRequest{ProjectID: public.ID}, want: []string{"Hours cannot be blank"}},
+		{name: "too many hours", req: redmine.TimeEntryCreateRequest{ProjectID: public.ID, Hours: 1000}, want: []string{"Hours is invalid"}},
+		{name: "unknown activity", req: redmine.TimeEntryCreateRequest{ProjectID: public.ID, Hours: 1, ActivityID: 99}, want: []string{"Activity is not included in the list"}},
+		{name: "other user", req: redmine.TimeEntryCreateRequest{ProjectID: public.ID, Hours: 1, UserID: dlopper.ID}, want: []string{"User is invalid"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := srv.Client(jsmith.APIKey).CreateTimeEntry(ctx, tt.req)
+			expectErrors(t, err, tt.want...)
+		})
+	}
+
+	if _, err := srv.Client(dlopper.APIKey).CreateTimeEntry(ctx, redmine.TimeEntryCreateRequest{ProjectID: public.ID, Hours: 1}); !errors.Is(err, redmine.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden for reporters, got %v", err)
+	}
+}
@@ -0,0 +1,195 @@
+package redminetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// attachment is an uploaded file. It is attached to an issue or a wiki page
+// once its token is used, and only visible to its author until then.
+type attachment struct {
+	redmine.Attachment
+	token    string
+	data     []byte
+	issueID  int
+	wikiPage *wikiPage
+}
+
+func (s *Server) attachmentJSON(a *attachment) redmine.Attachment {
+	attachment := a.Attachment
+	attachment.Author = s.userRef(a.Author.ID)
+	return attachment
+}
+
+// canSeeAttachment reports whether user can see a and, if edit is set, change it.
+func (s *Server) canSeeAttachment(user *redmine.User, a *attachment, edit bool) bool {
+	if user != nil && (user.Admin || user.ID == a.Author.ID) {
+		return true
+	}
+	switch {
+	case a.issueID > 0:
+		i := s.findIssue(a.issueID)
+		return s.canView(user, i) && (!edit || s.allowed(user, s.issueProject(i), "edit_issues"))
+	case a.wikiPage != nil:
+		p := s.findProject(strconv.Itoa(a.wikiPage.project))
+		perm := "view_wiki_pages"
+		if edit {
+			perm = "edit_wiki_pages"
+		}
+		return s.visible(user, p) && s.allowed(user, p, perm)
+	default:
+		return false
+	}
+}
+
+// attachmentByID returns the attachment named by the path value "id" if user
+// can see it and, if edit is set, change it. It answers 404, 401 or 403
+// otherwise.
+func (s *Server) attachmentByID(w http.ResponseWriter, r *http.Request, user *redmine.User, edit bool) *attachment {
+	id := pathID(r, "id")
+	i := slices.IndexFunc(s.attachments, func(a *attachment) bool { return a.ID == id })
+	if i < 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return nil
+	}
+	a := s.attachments[i]
+	if !s.canSeeAttachment(user, a, edit) {
+		deny(w, user)
+		return nil
+	}
+	return a
+}
+
+func (s *Server) upload(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	if !requireLogin(w, user) {
+		return
+	}
+	if r.Header.Get("Content-Type") != "application/octet-stream" {
+		w.WriteHeader(http.StatusNotAcceptable)
+		return
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	filename := r.URL.Query().Get("filename")
+	a := &attachment{
+		Attachment: redmine.Attachment{
+			ID:          s.nextID("attachments"),
+			Filename:    filename,
+			Filesize:    len(data),
+			ContentType: mime.TypeByExtension(filepath.Ext(filename)),
+			Author:      redmine.Resource{ID: user.ID},
+			CreatedOn:   s.timestamp(),
+		},
+		data: data,
+	}
+	a.token = fmt.Sprintf("%d.%s", a.ID, randomHex(16))
+	a.ContentURL = fmt.Sprintf("%s/attachments/download/%d/%s", s.URL, a.ID, filename)
+	s.attachments = append(s.attachments, a)
+
+	writeJSON(w, http.StatusCreated, map[string]any{"upload": map[string]any{"id": a.ID, "token": a.token}})
+}
+
+// pendingUploads returns the attachments whose tokens are listed under
+// "uploads" in f, applying the filename, content type and description given
+// with each. The tokens must be unused uploads of user.
+func (s *Server) pendingUploads(f fields, user *redmine.User) ([]*attachment, []string) {
+	if !f.has("uploads") {
+		return nil, nil
+	}
+	var uploads []redmine.Upload
+	if err := json.Unmarshal(f["uploads"], &uploads); err != nil {
+		return nil, []string{"Attachments is invalid"}
+	}
+
+	var pending []*attachment
+	for _, u := range uploads {
+		i := slices.IndexFunc(s.attachments, func(a *attachment) bool {
+			return a.token == u.Token && a.issueID == 0 && a.wikiPage == nil && a.Author.ID == user.ID
+		})
+		if i < 0 {
+			return nil, []string{"Attachments is invalid"}
+		}
+		pending = append(pending, s.attachments[i])
+	}
+	for i, a := range pending {
+		if uploads[i].Filename != "" {
+			a.Filename = uploads[i].Filename
+		}
+		if uploads[i].ContentType != "" {
+			a.ContentType = uploads[i].ContentType
+		}
+		if uploads[i].Description != "" {
+			a.Description = uploads[i].Description
+		}
+	}
+	return pending, nil
+}
+
+func (s *Server) showAttachment(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	a := s.attachmentByID(w, r, user, false)
+	if a == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, redmine.AttachmentResponse{Attachment: s.attachmentJSON(a)})
+}
+
+func (s *Server) updateAttachment(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	a := s.attachmentByID(w, r, user, true)
+	if a == nil {
+		return
+	}
+	f, ok := decodeFields(w, r, "attachment")
+	if !ok {
+		return
+	}
+
+	if f.has("filename") {
+		filename := f.string("filename")
+		if filename == "" {
+			writeErrors(w, "Filename cannot be blank")
+			return
+		}
+		a.Filename = filename
+	}
+	if f.has("description") {
+		a.Description = f.string("description")
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteAttachment(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	a := s.attachmentByID(w, r, user, true)
+	if a == nil {
+		return
+	}
+	if i := s.findIssue(a.issueID); i != nil {
+		detail := redmine.JournalDetail{Property: redmine.JournalPropertyAttachment, Name: strconv.Itoa(a.ID), OldValue: a.Filename}
+		s.addJournal(i, user.ID, "", []redmine.JournalDetail{detail}, s.timestamp())
+	}
+	s.attachments = slices.DeleteFunc(s.attachments, func(other *attachment) bool { return other == a })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) downloadAttachment(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	a := s.attachmentByID(w, r, user, false)
+	if a == nil {
+		return
+	}
+	if a.ContentType != "" {
+		w.Header().Set("Content-Type", a.ContentType)
+	}
+	http.ServeContent(w, r, a.Filename, a.CreatedOn.Time, bytes.NewReader(a.data))
+}
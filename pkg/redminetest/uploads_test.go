@@ -0,0 +1,118 @@
+package redminetest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+func TestServerUploads(t *testing.T) {
+	srv, public, private, jsmith, _ := newTestServer(t)
+	client := srv.Client(jsmith.APIKey)
+	ctx := context.Background()
+
+	content := "timestamp,level,message\n2025-01-01,ERROR,boom\n"
+	upload, err := client.Upload(ctx, "error.csv", strings.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	upload.Description, upload.ContentType = "Server log", "text/csv"
+
+	created, err := client.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: private.ID, Subject: "Crash", Uploads: []redmine.Upload{*upload}})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	shown, err := client.ShowIssue(ctx, created.Issue.ID, &redmine.ShowIssueOptions{Include: "attachments"})
+	if err != nil {
+		t.Fatalf("ShowIssue failed: %v", err)
+	}
+	if len(shown.Issue.Attachments) != 1 {
+		t.Fatalf("Expected 1 attachment, got %+v", shown.Issue.Attachments)
+	}
+	a := shown.Issue.Attachments[0]
+	if a.Filename != "error.csv" || a.Filesize != len(content) || a.Description != "Server log" || a.ContentType != "text/csv" || a.Author.Name != "John Smith" {
+		t.Errorf("Unexpected attachment: %+v", a)
+	}
+
+	var buf bytes.Buffer
+	if _, err := client.DownloadAttachment(ctx, a.ID, &buf); err != nil {
+		t.Fatalf("DownloadAttachment failed: %v", err)
+	}
+	if buf.String() != content {
+		t.Errorf("Expected %q, got %q", content, buf.String())
+	}
+	// Downloads resume with a Range request
+	buf.Reset()
+	if _, err := client.DownloadAttachmentWithOptions(ctx, a.ID, &buf, &redmine.DownloadOptions{Offset: 24}); err != nil {
+		t.Fatalf("DownloadAttachmentWithOptions failed: %v", err)
+	}
+	if buf.String() != content[24:] {
+		t.Errorf("Expected %q, got %q", content[24:], buf.String())
+	}
+
+	// A token can only be used once
+	_, err = client.CreateIssue(ctx, redmine.IssueCreateRequest{ProjectID: private.ID, Subject: "Again", Uploads: []redmine.Upload{*upload}})
+	expectErrors(t, err, "Attachments is invalid")
+
+	// Attachments of private projects are hidden from outsiders
+	outsider := srv.AddUser(redmine.User{Login: "outsider", Firstname: "Out", Lastname: "Sider", Mail: "outsider@example.net"})
+	if _, err := srv.Client(outsider.APIKey).ShowAttachment(ctx, a.ID); !errors.Is(err, redmine.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+	if _, err := srv.Client("").DownloadAttachment(ctx, a.ID, &buf); !errors.Is(err, redmine.ErrUnauthorized) {
+		t.Errorf("Expected ErrUnauthorized, got %v", err)
+	}
+
+	if err := client.UpdateAttachment(ctx, a.ID, redmine.Attachment{Filename: "crash.csv"}); err != nil {
+		t.Fatalf("UpdateAttachment failed: %v", err)
+	}
+	renamed, err := client.ShowAttachment(ctx, a.ID)
+	if err != nil {
+		t.Fatalf("ShowAttachment failed: %v", err)
+	}
+	if renamed.Attachment.Filename != "crash.csv" {
+		t.Errorf("Expected crash.csv, got %q", renamed.Attachment.Filename)
+	}
+
+	// Deleting an attachment is recorded on the issue
+	if err := client.DeleteAttachment(ctx, a.ID); err != nil {
+		t.Fatalf("DeleteAttachment failed: %v", err)
+	}
+	shown, err = client.ShowIssue(ctx, created.Issue.ID, &redmine.ShowIssueOptions{Include: "attachments,journals"})
+	if err != nil {
+		t.Fatalf("ShowIssue failed: %v", err)
+	}
+	if len(shown.Issue.Attachments) != 0 || len(shown.Issue.Journals) != 1 || shown.Issue.Journals[0].Details[0].OldValue != "crash.csv" {
+		t.Errorf("Expected the attachment to be removed with a journal, got %+v", shown.Issue)
+	}
+
+	// Uploads can be attached to wiki pages too
+	upload, err = client.Upload(ctx, "diagram.png", strings.NewReader("png"), 3)
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	if err := client.CreateOrUpdateWikiPage(ctx, public.Identifier, "Architecture", redmine.WikiPageUpdate{Text: "!diagram.png!", Uploads: []redmine.Upload{*upload}}); err != nil {
+		t.Fatalf("CreateOrUpdateWikiPage failed: %v", err)
+	}
+	page, err := srv.Client("").GetWikiPage(ctx, public.Identifier, "Architecture", &redmine.GetWikiPageOptions{Include: "attachments"})
+	if err != nil {
+		t.Fatalf("GetWikiPage failed: %v", err)
+	}
+	if len(page.WikiPage.Attachments) != 1 || page.WikiPage.Attachments[0].ContentType != "image/png" {
+		t.Errorf("Unexpected attachments: %+v", page.WikiPage.Attachments)
+	}
+}
+
+func TestServerUploadRequiresLogin(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	_, err := srv.Client("").Upload(context.Background(), "a.txt", strings.NewReader("a"), 1)
+	if !errors.Is(err, redmine.ErrUnauthorized) {
+		t.Errorf("Expected ErrUnauthorized, got %v", err)
+	}
+}
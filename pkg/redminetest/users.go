@@ -0,0 +1,186 @@
+package redminetest
+
+import (
+	"net/http"
+	"net/mail"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// userJSON returns u as shown to viewer. The API key and password are only
+// shown to administrators and the user.
+func userJSON(u *redmine.User, viewer *redmine.User) redmine.User {
+	user := *u
+	user.Password = ""
+	if viewer == nil || (!viewer.Admin && viewer.ID != u.ID) {
+		user.APIKey = ""
+	}
+	return user
+}
+
+// userByID returns the user named by the path value "id", answering 404 if
+// there is none.
+func (s *Server) userByID(w http.ResponseWriter, r *http.Request) *redmine.User {
+	id := pathID(r, "id")
+	u := s.findUser(func(u *redmine.User) bool { return u.ID == id })
+	if u == nil {
+		w.WriteHeader(http.StatusNotFound)
+	}
+	return u
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	if !requireAdmin(w, user) {
+		return
+	}
+
+	q := r.URL.Query()
+	status := userActive
+	if q.Has("status") {
+		status, _ = strconv.Atoi(q.Get("status"))
+	}
+	name := strings.ToLower(q.Get("name"))
+
+	var users []redmine.User
+	for _, u := range s.users {
+		if status > 0 && u.Status != status {
+			continue
+		}
+		if name != "" && !slices.ContainsFunc([]string{u.Login, u.Firstname, u.Lastname, u.Mail, u.Firstname + " " + u.Lastname, u.Lastname + " " + u.Firstname}, func(v string) bool {
+			return strings.Contains(strings.ToLower(v), name)
+		}) {
+			continue
+		}
+		users = append(users, userJSON(u, user))
+	}
+
+	page, offset, limit := paginate(r, users)
+	writeJSON(w, http.StatusOK, redmine.UsersResponse{Users: page, TotalCount: len(users), Offset: offset, Limit: limit})
+}
+
+func (s *Server) showCurrentUser(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	if !requireLogin(w, user) {
+		return
+	}
+	writeJSON(w, http.StatusOK, redmine.UserResponse{User: userJSON(user, user)})
+}
+
+func (s *Server) showUser(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	if !requireLogin(w, user) {
+		return
+	}
+	u := s.userByID(w, r)
+	if u == nil {
+		return
+	}
+	if u.Status != userActive && !user.Admin {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, redmine.UserResponse{User: userJSON(u, user)})
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	if !requireAdmin(w, user) {
+		return
+	}
+	f, ok := decodeFields(w, r, "user")
+	if !ok {
+		return
+	}
+
+	u := redmine.User{}
+	if errs := s.applyUser(&u, f); len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+	created := s.addUser(u)
+	writeJSON(w, http.StatusCreated, redmine.UserResponse{User: userJSON(created, user)})
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	if !requireAdmin(w, user) {
+		return
+	}
+	u := s.userByID(w, r)
+	if u == nil {
+		return
+	}
+	f, ok := decodeFields(w, r, "user")
+	if !ok {
+		return
+	}
+
+	updated := *u
+	if errs := s.applyUser(&updated, f); len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+	updated.UpdatedOn = s.timestamp()
+	*u = updated
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	if !requireAdmin(w, user) {
+		return
+	}
+	u := s.userByID(w, r)
+	if u == nil {
+		return
+	}
+	s.users = slices.DeleteFunc(s.users, func(other *redmine.User) bool { return other == u })
+	s.memberships = slices.DeleteFunc(s.memberships, func(m *redmine.Membership) bool { return m.User.ID == u.ID })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// applyUser applies the attributes in f to u and returns the validation errors.
+func (s *Server) applyUser(u *redmine.User, f fields) []string {
+	for name, field := range map[string]*string{"login": &u.Login, "firstname": &u.Firstname, "lastname": &u.Lastname, "mail": &u.Mail, "password": &u.Password} {
+		if f.has(name) {
+			*field = f.string(name)
+		}
+	}
+	if f.has("admin") {
+		u.Admin = f.bool("admin")
+	}
+	if f.has("status") {
+		u.Status, _ = f.int("status")
+	}
+
+	taken := func(match func(*redmine.User) bool) bool {
+		other := s.findUser(match)
+		return other != nil && other.ID != u.ID
+	}
+	var errs []string
+	switch {
+	case u.Login == "":
+		errs = append(errs, "Login cannot be blank")
+	case taken(func(other *redmine.User) bool { return strings.EqualFold(other.Login, u.Login) }):
+		errs = append(errs, "Login has already been taken")
+	}
+	if u.Firstname == "" {
+		errs = append(errs, "First name cannot be blank")
+	}
+	if u.Lastname == "" {
+		errs = append(errs, "Last name cannot be blank")
+	}
+	switch _, err := mail.ParseAddress(u.Mail); {
+	case u.Mail == "":
+		errs = append(errs, "Email cannot be blank")
+	case err != nil:
+		errs = append(errs, "Email is invalid")
+	case taken(func(other *redmine.User) bool { return strings.EqualFold(other.Mail, u.Mail) }):
+		errs = append(errs, "Email has already been taken")
+	}
+	if f.has("password") && len(u.Password) < 8 {
+		errs = append(errs, "Password is too short (minimum is 8 characters)")
+	}
+	if f.has("status") && !slices.Contains([]int{userActive, userRegistered, userLocked}, u.Status) {
+		errs = append(errs, "Status is not included in the list")
+	}
+	return errs
+}
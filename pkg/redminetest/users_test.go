@@ -0,0 +1,119 @@
+package redminetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+func TestServerUsers(t *testing.T) {
+	srv, _, _, jsmith, dlopper := newTestServer(t)
+	admin := srv.Client(srv.Admin.APIKey)
+	ctx := context.Background()
+
+	created, err := admin.CreateUser(ctx, redmine.User{Login: "rhill", Firstname: "Robert", Lastname: "Hill", Mail: "rhill@example.net", Password: "secret123"})
+	if err != nil {
+		t.Fatalf("CreateUser failed: %v", err)
+	}
+	u := created.User
+	if u.ID == 0 || u.Password != "" || u.APIKey == "" {
+		t.Errorf("Unexpected user: %+v", u)
+	}
+	// The new user can log in with the password
+	if _, err := srv.Client("", redmine.WithAuthenticator(redmine.BasicAuth("rhill", "secret123"))).GetCurrentUser(ctx, nil); err != nil {
+		t.Errorf("Expected password login to work, got %v", err)
+	}
+
+	tests := []struct {
+		name string
+		opts *redmine.ListUsersOptions
+		want []string
+	}{
+		{name: "active", opts: nil, want: []string{"admin", "jsmith", "dlopper", "rhill"}},
+		{name: "login", opts: &redmine.ListUsersOptions{Name: "lopp"}, want: []string{"dlopper"}},
+		{name: "full name", opts: &redmine.ListUsersOptions{Name: "john smith"}, want: []string{"jsmith"}},
+		{name: "last name first", opts: &redmine.ListUsersOptions{Name: "Hill Robert"}, want: []string{"rhill"}},
+		{name: "mail", opts: &redmine.ListUsersOptions{Name: "@example.net", Limit: 2}, want: []string{"admin", "jsmith"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := admin.ListUsers(ctx, tt.opts)
+			if err != nil {
+				t.Fatalf("ListUsers failed: %v", err)
+			}
+			var logins []string
+			for _, u := range resp.Users {
+				logins = append(logins, u.Login)
+			}
+			if len(logins) != len(tt.want) {
+				t.Fatalf("Expected %q, got %q", tt.want, logins)
+			}
+			for i := range logins {
+				if logins[i] != tt.want[i] {
+					t.Errorf("Expected %q, got %q", tt.want, logins)
+					break
+				}
+			}
+		})
+	}
+
+	if _, err := srv.Client(jsmith.APIKey).ListUsers(ctx, nil); !errors.Is(err, redmine.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden for non-admins, got %v", err)
+	}
+
+	// Other users' API keys are hidden
+	shown, err := srv.Client(jsmith.APIKey).ShowUser(ctx, dlopper.ID, nil)
+	if err != nil {
+		t.Fatalf("ShowUser failed: %v", err)
+	}
+	if shown.User.Login != "dlopper" || shown.User.APIKey != "" {
+		t.Errorf("Unexpected user: %+v", shown.User)
+	}
+
+	// Locking a user hides them from the default list and refuses their key
+	if err := admin.UpdateUser(ctx, dlopper.ID, redmine.User{Status: userLocked}); err != nil {
+		t.Fatalf("UpdateUser failed: %v", err)
+	}
+	if _, err := srv.Client(dlopper.APIKey).GetCurrentUser(ctx, nil); !errors.Is(err, redmine.ErrUnauthorized) {
+		t.Errorf("Expected ErrUnauthorized for a locked user, got %v", err)
+	}
+	locked, err := admin.ListUsers(ctx, &redmine.ListUsersOptions{Status: "3"})
+	if err != nil {
+		t.Fatalf("ListUsers failed: %v", err)
+	}
+	if len(locked.Users) != 1 || locked.Users[0].ID != dlopper.ID {
+		t.Errorf("Expected dlopper to be locked, got %+v", locked.Users)
+	}
+
+	if err := admin.DeleteUser(ctx, u.ID); err != nil {
+		t.Fatalf("DeleteUser failed: %v", err)
+	}
+	if _, err := admin.ShowUser(ctx, u.ID, nil); !errors.Is(err, redmine.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestServerUserValidation(t *testing.T) {
+	srv, _, _, _, _ := newTestServer(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		user redmine.User
+		want []string
+	}{
+		{name: "blank", user: redmine.User{}, want: []string{"Login cannot be blank", "First name cannot be blank", "Last name cannot be blank", "Email cannot be blank"}},
+		{name: "taken", user: redmine.User{Login: "JSmith", Firstname: "J", Lastname: "S", Mail: "jsmith@example.net"}, want: []string{"Login has already been taken", "Email has already been taken"}},
+		{name: "invalid", user: redmine.User{Login: "new", Firstname: "N", Lastname: "U", Mail: "not an address", Password: "short"}, want: []string{"Email is invalid", "Password is too short (minimum is 8 characters)"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := srv.Client(srv.Admin.APIKey).CreateUser(ctx, tt.user)
+			expectErrors(t, err, tt.want...)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package redminetest
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// wikiPage is a wiki page with its history. versions[n-1] holds version n.
+type wikiPage struct {
+	project   int
+	title     string
+	parent    string
+	createdOn redmine.Timestamp
+	versions  []redmine.WikiPage
+}
+
+func (p *wikiPage) current() redmine.WikiPage {
+	return p.versions[len(p.versions)-1]
+}
+
+// wikiPageJSON is a wiki page as Redmine encodes it, with the parent
+// referenced by title.
+type wikiPageJSON struct {
+	Title       string               `json:"title"`
+	Parent      *wikiParentJSON      `json:"parent,omitempty"`
+	Text        string               `json:"text,omitempty"`
+	Version     int                  `json:"version"`
+	Author      *redmine.Resource    `json:"author,omitempty"`
+	Comments    string               `json:"comments,omitempty"`
+	CreatedOn   redmine.Timestamp    `json:"created_on,omitzero"`
+	UpdatedOn   redmine.Timestamp    `json:"updated_on,omitzero"`
+	Attachments []redmine.Attachment `json:"attachments,omitempty"`
+}
+
+type wikiParentJSON struct {
+	Title string `json:"title"`
+}
+
+// normalizeTitle returns a wiki page title as Redmine stores it.
+func normalizeTitle(title string) string {
+	return strings.ReplaceAll(strings.TrimSpace(title), " ", "_")
+}
+
+func (s *Server) findWikiPage(projectID int, title string) *wikiPage {
+	title = normalizeTitle(title)
+	i := slices.IndexFunc(s.wikiPages, func(p *wikiPage) bool {
+		return p.project == projectID && strings.EqualFold(p.title, title)
+	})
+	if i < 0 {
+		return nil
+	}
+	return s.wikiPages[i]
+}
+
+func (s *Server) wikiPageJSON(p *wikiPage, v redmine.WikiPage, withContent bool) wikiPageJSON {
+	page := wikiPageJSON{Title: p.title, Version: v.Version, CreatedOn: p.createdOn, UpdatedOn: v.UpdatedOn}
+	if p.parent != "" {
+		page.Parent = &wikiParentJSON{Title: p.parent}
+	}
+	if withContent {
+		author := s.userRef(v.Author.ID)
+		page.Text, page.Author, page.Comments = v.Text, &author, v.Comments
+	}
+	return page
+}
+
+func (s *Server) listWikiPages(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	project := s.visibleProject(w, r, user, "project")
+	if project == nil || !s.authorize(w, user, project, "view_wiki_pages") {
+		return
+	}
+
+	pages := []wikiPageJSON{}
+	for _, p := range s.wikiPages {
+		if p.project == project.ID {
+			pages = append(pages, s.wikiPageJSON(p, p.current(), false))
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"wiki_pages": pages})
+}
+
+func (s *Server) showWikiPage(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	project := s.visibleProject(w, r, user, "project")
+	if project == nil || !s.authorize(w, user, project, "view_wiki_pages") {
+		return
+	}
+	p := s.findWikiPage(project.ID, pathValue(r, "page"))
+	if p == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	v := p.current()
+	if r.PathValue("version") != "" {
+		if !s.authorize(w, user, project, "view_wiki_edits") {
+			return
+		}
+		n := pathID(r, "version")
+		if n < 1 || n > len(p.versions) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		v = p.versions[n-1]
+	}
+
+	page := s.wikiPageJSON(p, v, true)
+	if includes(r, "attachments") {
+		for _, a := range s.attachments {
+			if a.wikiPage == p {
+				page.Attachments = append(page.Attachments, s.attachmentJSON(a))
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"wiki_page": page})
+}
+
+func (s *Server) saveWikiPage(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	project := s.visibleProject(w, r, user, "project")
+	if project == nil || !s.authorize(w, user, project, "edit_wiki_pages") {
+		return
+	}
+	f, ok := decodeFields(w, r, "wiki_page")
+	if !ok {
+		return
+	}
+
+	title := normalizeTitle(pathValue(r, "page"))
+	p := s.findWikiPage(project.ID, title)
+	if p != nil && f.has("version") {
+		if version, _ := f.int("version"); version > 0 && version != len(p.versions) {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+	}
+
+	var errs []string
+	if title == "" || strings.ContainsAny(title, ",./?;|:") {
+		errs = append(errs, "Title is invalid")
+	}
+	parent := ""
+	if p != nil {
+		parent = p.parent
+	}
+	if f.has("parent_title") {
+		parent = normalizeTitle(f.string("parent_title"))
+		if parentPage := s.findWikiPage(project.ID, parent); parent != "" && (parentPage == nil || parentPage == p || s.wikiDescendant(parentPage, p)) {
+			errs = append(errs, "Parent page is invalid")
+		} else if parentPage != nil {
+			parent = parentPage.title
+		}
+	}
+	if len([]rune(f.string("comments"))) > 1024 {
+		errs = append(errs, "Comment is too long (maximum is 1024 characters)")
+	}
+	uploads, uploadErrs := s.pendingUploads(f, user)
+	errs = append(errs, uploadErrs...)
+	if len(errs) > 0 {
+		writeErrors(w, errs...)
+		return
+	}
+
+	now := s.timestamp()
+	created := p == nil
+	if created {
+		p = &wikiPage{project: project.ID, title: title, createdOn: now}
+		s.wikiPages = append(s.wikiPages, p)
+	}
+	p.parent = parent
+	for _, a := range uploads {
+		a.wikiPage = p
+	}
+	text := f.string("text")
+	if !created && !f.has("text") {
+		text = p.current().Text
+	}
+	if created || text != p.current().Text {
+		p.versions = append(p.versions, redmine.WikiPage{
+			Title:     p.title,
+			Text:      text,
+			Version:   len(p.versions) + 1,
+			Author:    redmine.Resource{ID: user.ID},
+			Comments:  f.string("comments"),
+			UpdatedOn: now,
+		})
+	}
+
+	if created {
+		writeJSON(w, http.StatusCreated, map[string]any{"wiki_page": s.wikiPageJSON(p, p.current(), true)})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// wikiDescendant reports whether p is a child of ancestor, at any depth.
+func (s *Server) wikiDescendant(p, ancestor *wikiPage) bool {
+	if ancestor == nil {
+		return false
+	}
+	for p != nil && p.parent != "" {
+		p = s.findWikiPage(p.project, p.parent)
+		if p == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) deleteWikiPage(w http.ResponseWriter, r *http.Request, user *redmine.User) {
+	project := s.visibleProject(w, r, user, "project")
+	if project == nil || !s.authorize(w, user, project, "delete_wiki_pages") {
+		return
+	}
+	p := s.findWikiPage(project.ID, pathValue(r, "page"))
+	if p == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// Children become root pages
+	for _, child := range s.wikiPages {
+		if child.project == p.project && child.parent == p.title {
+			child.parent = ""
+		}
+	}
+	s.wikiPages = slices.DeleteFunc(s.wikiPages, func(other *wikiPage) bool { return other == p })
+	s.attachments = slices.DeleteFunc(s.attachments, func(a *attachment) bool { return a.wikiPage == p })
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,118 @@
+package redminetest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+func TestServerWikiPages(t *testing.T) {
+	srv, public, _, jsmith, dlopper := newTestServer(t)
+	client := srv.Client(jsmith.APIKey)
+	ctx := context.Background()
+
+	if err := client.CreateOrUpdateWikiPage(ctx, public.Identifier, "Guide", redmine.WikiPageUpdate{Text: "h1. Guide\n\nStep one"}); err != nil {
+		t.Fatalf("CreateOrUpdateWikiPage failed: %v", err)
+	}
+	if err := client.CreateOrUpdateWikiPage(ctx, public.Identifier, "Guide", redmine.WikiPageUpdate{Text: "h1. Guide\n\nStep one\nStep two", Comments: "Add step two", Version: 1}); err != nil {
+		t.Fatalf("CreateOrUpdateWikiPage failed: %v", err)
+	}
+	if err := client.CreateOrUpdateWikiPage(ctx, public.Identifier, "Install Notes", redmine.WikiPageUpdate{Text: "Run make"}); err != nil {
+		t.Fatalf("CreateOrUpdateWikiPage failed: %v", err)
+	}
+	// Moving a page without sending text does not add a version
+	if err := client.CreateOrUpdateWikiPage(ctx, public.Identifier, "Install_Notes", redmine.WikiPageUpdate{ParentTitle: "Guide"}); err != nil {
+		t.Fatalf("CreateOrUpdateWikiPage failed: %v", err)
+	}
+
+	page, err := client.GetWikiPage(ctx, public.Identifier, "guide", nil)
+	if err != nil {
+		t.Fatalf("GetWikiPage failed: %v", err)
+	}
+	if page.WikiPage.Title != "Guide" || page.WikiPage.Version != 2 || page.WikiPage.Author.Name != "John Smith" || page.WikiPage.Comments != "Add step two" {
+		t.Errorf("Unexpected page: %+v", page.WikiPage)
+	}
+
+	first, err := client.GetWikiPageVersion(ctx, public.Identifier, "Guide", 1)
+	if err != nil {
+		t.Fatalf("GetWikiPageVersion failed: %v", err)
+	}
+	if first.Text != "h1. Guide\n\nStep one" {
+		t.Errorf("Unexpected text of version 1: %q", first.Text)
+	}
+	if _, err := client.GetWikiPageVersion(ctx, public.Identifier, "Guide", 3); !errors.Is(err, redmine.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a missing version, got %v", err)
+	}
+
+	versions, err := client.ListWikiPageVersions(ctx, public.Identifier, "Guide", nil)
+	if err != nil {
+		t.Fatalf("ListWikiPageVersions failed: %v", err)
+	}
+	if len(versions) != 2 || versions[0].Version != 2 {
+		t.Errorf("Unexpected versions: %+v", versions)
+	}
+	diff, err := client.DiffWikiPageVersions(ctx, public.Identifier, "Guide", 1, 2)
+	if err != nil {
+		t.Fatalf("DiffWikiPageVersions failed: %v", err)
+	}
+	if !strings.Contains(diff, "+Step two") {
+		t.Errorf("Expected the diff to add step two, got:\n%s", diff)
+	}
+
+	index, err := client.ListWikiPages(ctx, public.Identifier)
+	if err != nil {
+		t.Fatalf("ListWikiPages failed: %v", err)
+	}
+	if len(index.WikiPages) != 2 || index.WikiPages[1].Title != "Install_Notes" || index.WikiPages[1].Version != 1 {
+		t.Errorf("Unexpected index: %+v", index.WikiPages)
+	}
+	if notes := srv.findWikiPage(public.ID, "Install_Notes"); notes.parent != "Guide" {
+		t.Errorf("Expected parent Guide, got %q", notes.parent)
+	}
+
+	// A page cannot become the parent of its parent
+	err = client.CreateOrUpdateWikiPage(ctx, public.Identifier, "Guide", redmine.WikiPageUpdate{ParentTitle: "Install_Notes"})
+	expectErrors(t, err, "Parent page is invalid")
+
+	if err := srv.Client(dlopper.APIKey).CreateOrUpdateWikiPage(ctx, public.Identifier, "Guide", redmine.WikiPageUpdate{Text: "Vandalism"}); !errors.Is(err, redmine.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden for reporters, got %v", err)
+	}
+	if err := client.DeleteWikiPage(ctx, public.Identifier, "Guide"); !errors.Is(err, redmine.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden for developers, got %v", err)
+	}
+
+	// Deleting a page makes its children root pages
+	if err := srv.Client(srv.Admin.APIKey).DeleteWikiPage(ctx, public.Identifier, "Guide"); err != nil {
+		t.Fatalf("DeleteWikiPage failed: %v", err)
+	}
+	if notes := srv.findWikiPage(public.ID, "Install_Notes"); notes.parent != "" {
+		t.Errorf("Expected a root page, got parent %q", notes.parent)
+	}
+}
+
+func TestServerWikiPageConflict(t *testing.T) {
+	srv, public, _, jsmith, _ := newTestServer(t)
+	client := srv.Client(jsmith.APIKey)
+	ctx := context.Background()
+
+	for _, text := range []string{"One", "Two"} {
+		if err := client.CreateOrUpdateWikiPage(ctx, public.Identifier, "Notes", redmine.WikiPageUpdate{Text: text}); err != nil {
+			t.Fatalf("CreateOrUpdateWikiPage failed: %v", err)
+		}
+	}
+
+	err := client.CreateOrUpdateWikiPage(ctx, public.Identifier, "Notes", redmine.WikiPageUpdate{Text: "Three", Version: 1})
+	var conflict *redmine.ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("Expected a conflict, got %v", err)
+	}
+	if conflict.Version != 2 {
+		t.Errorf("Expected current version 2, got %d", conflict.Version)
+	}
+
+	err = client.CreateOrUpdateWikiPage(ctx, public.Identifier, "Bad,Title", redmine.WikiPageUpdate{Text: "Text"})
+	expectErrors(t, err, "Title is invalid")
+}
@@ -0,0 +1,137 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// Duration is a time.Duration written in JSON as a string such as "30s".
+type Duration time.Duration
+
+// UnmarshalJSON parses a duration string.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// MarshalJSON writes the duration as a string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// Config describes what a relay daemon watches and where it sends events.
+type Config struct {
+	// Interval is the time between polls of Redmine.
+	Interval Duration `json:"interval,omitempty"`
+	// Checkpoint is the file in which the change feed saves its checkpoint.
+	Checkpoint string `json:"checkpoint,omitempty"`
+	// DeadLetter is the file to which failed deliveries are appended.
+	DeadLetter  string   `json:"dead_letter,omitempty"`
+	MaxAttempts int      `json:"max_attempts,omitempty"`
+	Backoff     Duration `json:"backoff,omitempty"`
+
+	Watch WatchConfig `json:"watch"`
+	Rules []Rule      `json:"rules"`
+}
+
+// WatchConfig lists the sources polled by a relay daemon. All issues are
+// watched if it is empty.
+type WatchConfig struct {
+	Issues      []IssueWatch     `json:"issues,omitempty"`
+	TimeEntries []TimeEntryWatch `json:"time_entries,omitempty"`
+	// WikiPages and News list project IDs or identifiers. An empty string
+	// watches the news of all projects.
+	WikiPages []string `json:"wiki_pages,omitempty"`
+	News      []string `json:"news,omitempty"`
+}
+
+// IssueWatch selects issues to watch.
+type IssueWatch struct {
+	ProjectID    int    `json:"project_id,omitempty"`
+	TrackerID    int    `json:"tracker_id,omitempty"`
+	StatusID     string `json:"status_id,omitempty"`
+	AssignedToID string `json:"assigned_to_id,omitempty"`
+	QueryID      int    `json:"query_id,omitempty"`
+}
+
+// TimeEntryWatch selects time entries to watch.
+type TimeEntryWatch struct {
+	ProjectID string `json:"project_id,omitempty"`
+	UserID    int    `json:"user_id,omitempty"`
+	From      string `json:"from,omitempty"`
+}
+
+// LoadConfig reads and validates a JSON config file.
+func LoadConfig(path string) (*Config, error) {
+	//nolint:gosec // The config file is chosen by the user running the daemon
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// Validate checks that the config has valid rules.
+func (c *Config) Validate() error {
+	if len(c.Rules) == 0 {
+		return errors.New("webhook: config has no rules")
+	}
+	for i := range c.Rules {
+		if err := c.Rules[i].Validate(); err != nil {
+			return fmt.Errorf("rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Sources returns the change feed sources of the config.
+func (c *Config) Sources() []redmine.WatchSource {
+	var sources []redmine.WatchSource
+	for _, w := range c.Watch.Issues {
+		sources = append(sources, redmine.WatchIssues(&redmine.ListIssuesOptions{
+			ProjectID:    w.ProjectID,
+			TrackerID:    w.TrackerID,
+			StatusID:     w.StatusID,
+			AssignedToID: w.AssignedToID,
+			QueryID:      w.QueryID,
+		}))
+	}
+	for _, w := range c.Watch.TimeEntries {
+		sources = append(sources, redmine.WatchTimeEntries(&redmine.ListTimeEntriesOptions{
+			ProjectID: w.ProjectID,
+			UserID:    w.UserID,
+			From:      w.From,
+		}))
+	}
+	for _, project := range c.Watch.WikiPages {
+		sources = append(sources, redmine.WatchWikiPages(project))
+	}
+	for _, project := range c.Watch.News {
+		sources = append(sources, redmine.WatchNews(project))
+	}
+	if len(sources) == 0 {
+		sources = append(sources, redmine.WatchIssues(nil))
+	}
+	return sources
+}
@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hooks.json")
+	data := `{
+		"interval": "30s",
+		"backoff": "1m",
+		"watch": {
+			"issues": [{"project_id": 1}, {"project_id": 2, "status_id": "open"}],
+			"wiki_pages": ["docs"],
+			"news": [""]
+		},
+		"rules": [{"name": "chat", "url": "https://chat.example.com/hook", "format": "slack", "status_to": [5]}]
+	}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if time.Duration(cfg.Interval) != 30*time.Second || time.Duration(cfg.Backoff) != time.Minute {
+		t.Errorf("Unexpected durations: %v, %v", cfg.Interval, cfg.Backoff)
+	}
+	if len(cfg.Rules) != 1 || cfg.Rules[0].StatusTo[0] != 5 {
+		t.Errorf("Unexpected rules: %+v", cfg.Rules)
+	}
+
+	sources := cfg.Sources()
+	if len(sources) != 4 {
+		t.Fatalf("Expected 4 sources, got %d", len(sources))
+	}
+	if key := sources[1].Key(); key != "issues?project_id=2&status_id=open" {
+		t.Errorf("Unexpected source key: %s", key)
+	}
+	if key := sources[3].Key(); key != "news?project_id=" {
+		t.Errorf("Unexpected source key: %s", key)
+	}
+
+	if sources := (&Config{}).Sources(); len(sources) != 1 || sources[0].Key() != "issues?status_id=%2A" {
+		t.Errorf("Expected all issues to be watched by default, got %v", sources)
+	}
+}
+
+func TestLoadConfigInvalid(t *testing.T) {
+	dir := t.TempDir()
+	for name, data := range map[string]string{
+		"no-rules.json": `{"watch": {}}`,
+		"bad-url.json":  `{"rules": [{"url": "mailto:team@example.com"}]}`,
+		"interval.json": `{"interval": "soon", "rules": [{"url": "https://example.com"}]}`,
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadConfig(path); err == nil {
+			t.Errorf("Expected error for %s", name)
+		}
+	}
+
+	if _, err := LoadConfig(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
@@ -0,0 +1,129 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// Headers sent with each delivery.
+const (
+	// HeaderEvent holds the resource and type of the event, such as "issue.updated".
+	HeaderEvent = "X-Redmine-Event"
+	// HeaderDelivery holds the Payload ID, which is kept across retries.
+	HeaderDelivery = "X-Redmine-Delivery"
+	// HeaderSignature holds the signature of the body if the rule has a Secret.
+	HeaderSignature = "X-Redmine-Signature-256"
+)
+
+// Payload is the body posted in FormatJSON.
+type Payload struct {
+	// ID identifies the delivery. Receivers can use it to drop duplicates.
+	ID   string `json:"id"`
+	Rule string `json:"rule"`
+	// URL links to the item in Redmine, if Options.RedmineURL is set.
+	URL   string        `json:"url,omitempty"`
+	Event redmine.Event `json:"event"`
+}
+
+// SlackMessage is the body posted in FormatSlack.
+type SlackMessage struct {
+	Text string `json:"text"`
+}
+
+// Sign returns the signature of body sent in HeaderSignature:
+// "sha256=" followed by the hex-encoded HMAC-SHA256 of body keyed with secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the signature of body, in constant time.
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, body)))
+}
+
+var resourceLabels = map[string]string{
+	redmine.ResourceIssue:     "Issue",
+	redmine.ResourceTimeEntry: "Time entry",
+	redmine.ResourceWikiPage:  "Wiki page",
+	redmine.ResourceNews:      "News",
+}
+
+// link returns the URL of the item of e in the Redmine at base, or "" if there
+// is none.
+func link(base string, e redmine.Event) string {
+	if base == "" || e.Type == redmine.EventDeleted {
+		return ""
+	}
+	base = strings.TrimSuffix(base, "/")
+	switch e.Resource {
+	case redmine.ResourceIssue:
+		return base + "/issues/" + e.Key
+	case redmine.ResourceTimeEntry:
+		return base + "/time_entries/" + e.Key + "/edit"
+	case redmine.ResourceWikiPage:
+		project, title, _ := strings.Cut(e.Key, "/")
+		return base + "/projects/" + url.PathEscape(project) + "/wiki/" + url.PathEscape(title)
+	case redmine.ResourceNews:
+		return base + "/news/" + e.Key
+	default:
+		return ""
+	}
+}
+
+// title names the item of e, such as "[Web] Bug #12: Login fails".
+func title(e redmine.Event) string {
+	switch {
+	case e.Issue != nil:
+		return fmt.Sprintf("[%s] %s #%d: %s", e.Issue.Project.Name, e.Issue.Tracker.Name, e.Issue.ID, e.Issue.Subject)
+	case e.TimeEntry != nil:
+		return fmt.Sprintf("[%s] %.2f hours by %s on %s", e.TimeEntry.Project.Name, e.TimeEntry.Hours, e.TimeEntry.User.Name, e.TimeEntry.SpentOn)
+	case e.WikiPage != nil:
+		return fmt.Sprintf("%s (version %d)", e.Key, e.WikiPage.Version)
+	case e.News != nil:
+		return fmt.Sprintf("[%s] %s", e.News.Project.Name, e.News.Title)
+	default:
+		return "#" + e.Key
+	}
+}
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// slackMessage renders e as a Slack message, describing changes with resolver
+// if it is not nil.
+func slackMessage(ctx context.Context, resolver *redmine.ChangelogResolver, e redmine.Event, link string) SlackMessage {
+	label, ok := resourceLabels[e.Resource]
+	if !ok {
+		label = e.Resource
+	}
+
+	var b strings.Builder
+	item := slackEscaper.Replace(title(e))
+	if link != "" {
+		item = "<" + link + "|" + item + ">"
+	}
+	fmt.Fprintf(&b, "%s %s: %s", label, e.Type, item)
+
+	for _, c := range e.Changes {
+		// Closing is already told by the event type
+		if c.Field == "closed_on" {
+			continue
+		}
+		line := fmt.Sprintf("%s: %s → %s", c.Field, c.OldValue, c.NewValue)
+		if resolver != nil {
+			if s, err := resolver.DescribeDetail(ctx, c.JournalDetail()); err == nil {
+				line = s
+			}
+		}
+		b.WriteString("\n• " + slackEscaper.Replace(line))
+	}
+	return SlackMessage{Text: b.String()}
+}
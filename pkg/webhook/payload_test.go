@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+	signature := Sign("secret", body)
+	if signature != "sha256=6146142a2ce0159e84c0767881e4ec80bc397da62526e7d19f70795eb79460c0" {
+		t.Errorf("Unexpected signature: %s", signature)
+	}
+	if !Verify("secret", body, signature) {
+		t.Error("Expected signature to verify")
+	}
+	if Verify("other", body, signature) || Verify("secret", []byte(`{"id":"2"}`), signature) {
+		t.Error("Expected signature not to verify")
+	}
+}
+
+func TestLink(t *testing.T) {
+	tests := []struct {
+		event redmine.Event
+		want  string
+	}{
+		{redmine.Event{Resource: redmine.ResourceIssue, Key: "12"}, "https://redmine.example.com/issues/12"},
+		{redmine.Event{Resource: redmine.ResourceWikiPage, Key: "docs/Release notes"}, "https://redmine.example.com/projects/docs/wiki/Release%20notes"},
+		{redmine.Event{Resource: redmine.ResourceNews, Key: "3"}, "https://redmine.example.com/news/3"},
+		{redmine.Event{Type: redmine.EventDeleted, Resource: redmine.ResourceIssue, Key: "12"}, ""},
+	}
+	for _, tt := range tests {
+		if got := link("https://redmine.example.com/", tt.event); got != tt.want {
+			t.Errorf("Expected %q, got %q", tt.want, got)
+		}
+	}
+	if got := link("", tests[0].event); got != "" {
+		t.Errorf("Expected no link without a Redmine URL, got %q", got)
+	}
+}
+
+func TestSlackMessage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/issue_statuses.json":
+			//nolint:errcheck
+			w.Write([]byte(`{"issue_statuses":[{"id":2,"name":"In Progress"},{"id":5,"name":"Closed"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	e := redmine.Event{
+		Type:     redmine.EventClosed,
+		Resource: redmine.ResourceIssue,
+		Key:      "12",
+		Changes: []redmine.Change{
+			{Field: "closed_on", NewValue: "2024-05-02T10:00:00Z"},
+			{Field: "status_id", OldValue: "2", NewValue: "5"},
+		},
+		Issue: &redmine.Issue{
+			ID:      12,
+			Project: redmine.Resource{Name: "Web"},
+			Tracker: redmine.Resource{Name: "Bug"},
+			Subject: "Login fails with <script>",
+		},
+	}
+
+	msg := slackMessage(context.Background(), nil, e, "")
+	want := "Issue closed: [Web] Bug #12: Login fails with &lt;script&gt;\n• status_id: 2 → 5"
+	if msg.Text != want {
+		t.Errorf("Expected %q, got %q", want, msg.Text)
+	}
+
+	resolver := redmine.NewChangelogResolver(redmine.New(server.URL, "test-api-key"))
+	msg = slackMessage(context.Background(), resolver, e, "https://redmine.example.com/issues/12")
+	want = "Issue closed: <https://redmine.example.com/issues/12|[Web] Bug #12: Login fails with &lt;script&gt;>\n• Status changed from In Progress to Closed"
+	if msg.Text != want {
+		t.Errorf("Expected %q, got %q", want, msg.Text)
+	}
+}
@@ -0,0 +1,361 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// Options configures a Relay.
+type Options struct {
+	Rules []Rule
+	// HTTPClient sends the deliveries. It defaults to a client with a 10 second timeout.
+	HTTPClient *http.Client
+	// MaxAttempts is the number of attempts of a delivery before it is
+	// dead-lettered. It defaults to 5.
+	MaxAttempts int
+	// Backoff is the delay before the first retry, doubled for each further
+	// retry. It defaults to 10 seconds.
+	Backoff time.Duration
+	// DeadLetter receives the deliveries that failed, as JSON lines of
+	// DeadLetter. They are dropped if it is nil.
+	DeadLetter io.Writer
+	// RedmineURL is the address of Redmine used to link items in payloads.
+	RedmineURL string
+	// Resolver, if set, describes changes in Slack messages by name, such as
+	// "Status changed from New to Closed", instead of by ID.
+	Resolver *redmine.ChangelogResolver
+	// Logger receives a record for each delivery. It defaults to discarding them.
+	Logger *slog.Logger
+}
+
+// DeadLetter is a delivery given up by a Relay. URL holds only the scheme and
+// host of the endpoint, as its path and query may hold a token.
+type DeadLetter struct {
+	ID       string          `json:"id"`
+	Rule     string          `json:"rule"`
+	URL      string          `json:"url"`
+	Event    string          `json:"event"`
+	Attempts int             `json:"attempts"`
+	Error    string          `json:"error"`
+	FailedAt time.Time       `json:"failed_at"`
+	Body     json.RawMessage `json:"body"`
+}
+
+// Relay posts events to the endpoints of the rules that match them.
+type Relay struct {
+	opts  Options
+	queue []*delivery
+	mu    sync.Mutex // guards writes to opts.DeadLetter
+}
+
+// delivery is a payload to post to the endpoint of a rule.
+type delivery struct {
+	id       string
+	rule     *Rule
+	event    string
+	body     []byte
+	attempts int
+	next     time.Time
+	err      error
+}
+
+// deliveryError is a failed attempt, which may be retried if temporary.
+type deliveryError struct {
+	err       error
+	temporary bool
+}
+
+func (e *deliveryError) Error() string {
+	return e.err.Error()
+}
+
+func (e *deliveryError) Unwrap() error {
+	return e.err
+}
+
+// New returns a Relay for opts, or an error if a rule is invalid.
+func New(opts *Options) (*Relay, error) {
+	r := &Relay{}
+	if opts != nil {
+		r.opts = *opts
+	}
+	r.opts.Rules = slices.Clone(r.opts.Rules)
+	for i := range r.opts.Rules {
+		if err := r.opts.Rules[i].Validate(); err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		if r.opts.Rules[i].Name == "" {
+			r.opts.Rules[i].Name = fmt.Sprintf("rule[%d]", i)
+		}
+	}
+	if r.opts.HTTPClient == nil {
+		r.opts.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	if r.opts.MaxAttempts <= 0 {
+		r.opts.MaxAttempts = 5
+	}
+	if r.opts.Backoff <= 0 {
+		r.opts.Backoff = 10 * time.Second
+	}
+	if r.opts.Logger == nil {
+		r.opts.Logger = slog.New(slog.DiscardHandler)
+	}
+	return r, nil
+}
+
+// Run delivers the events received from events until ctx is done or events
+// is closed. Each event is posted to every matching rule before the next one
+// is received, but a ChangeFeed sending to events saves its checkpoint once it
+// has sent the last event of a poll, before that event is posted; use RunFeed
+// to save it only after the first attempts. Failed deliveries are retried in
+// the background.
+//
+// When events is closed, Run returns nil once the retries have completed.
+// When ctx is done, the pending retries are dead-lettered and Run returns
+// ctx.Err().
+func (r *Relay) Run(ctx context.Context, events <-chan redmine.Event) error {
+	return r.run(ctx, events, nil)
+}
+
+// batch is the events of a poll, with done closed once they are dispatched.
+type batch struct {
+	events []redmine.Event
+	done   chan struct{}
+}
+
+// RunFeed runs feed and delivers its events until ctx is done. The checkpoint
+// of feed is saved only once the events of a poll have been posted to every
+// matching rule, so an event whose first attempts were cut short by a stop is
+// found again on the next start. Failed deliveries are retried in the
+// background and dead-lettered when ctx is done, as with Run.
+//
+// It returns ctx.Err() when ctx is done, or the error that stopped feed if
+// its OnError is not set.
+func (r *Relay) RunFeed(ctx context.Context, feed *redmine.ChangeFeed) error {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	batches := make(chan batch)
+	feedErr := make(chan error, 1)
+	go func() {
+		feedErr <- feed.RunFunc(runCtx, func(ctx context.Context, events []redmine.Event) error {
+			b := batch{events: events, done: make(chan struct{})}
+			select {
+			case batches <- b:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			select {
+			case <-b.done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		cancel()
+	}()
+
+	err := r.run(runCtx, nil, batches)
+	cancel()
+	if ferr := <-feedErr; ctx.Err() == nil {
+		return ferr
+	}
+	return err
+}
+
+// run delivers the events received from events and batches. It returns nil
+// once both are nil and the retries have completed.
+func (r *Relay) run(ctx context.Context, events <-chan redmine.Event, batches <-chan batch) error {
+	for {
+		if len(r.queue) == 0 && events == nil && batches == nil {
+			return nil
+		}
+		timer := r.retryTimer()
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			for _, d := range r.queue {
+				r.deadLetter(d, fmt.Errorf("relay stopped: %w", d.err))
+			}
+			r.queue = nil
+			return ctx.Err()
+		case e, ok := <-events:
+			if !ok {
+				events = nil
+			} else {
+				r.Dispatch(ctx, e)
+			}
+		case b := <-batches:
+			for _, e := range b.events {
+				r.Dispatch(ctx, e)
+			}
+			// A batch cut short by a stop is not acknowledged, so it is polled again
+			if ctx.Err() == nil {
+				close(b.done)
+			}
+		case <-timer.C:
+			d := r.queue[0]
+			r.queue = r.queue[1:]
+			r.attempt(ctx, d)
+		}
+		timer.Stop()
+	}
+}
+
+// retryTimer returns a timer firing when the first queued delivery is due,
+// or never if the queue is empty.
+func (r *Relay) retryTimer() *time.Timer {
+	if len(r.queue) == 0 {
+		timer := time.NewTimer(time.Hour)
+		timer.Stop()
+		return timer
+	}
+	return time.NewTimer(time.Until(r.queue[0].next))
+}
+
+// Dispatch posts e to the endpoints of the matching rules. Deliveries that
+// fail temporarily are queued for Run to retry.
+func (r *Relay) Dispatch(ctx context.Context, e redmine.Event) {
+	for i := range r.opts.Rules {
+		rule := &r.opts.Rules[i]
+		if !rule.Match(e) {
+			continue
+		}
+		d, err := r.newDelivery(ctx, rule, e)
+		if err != nil {
+			r.opts.Logger.ErrorContext(ctx, "webhook payload failed", slog.String("rule", rule.Name), slog.String("error", err.Error()))
+			continue
+		}
+		r.attempt(ctx, d)
+	}
+}
+
+func (r *Relay) newDelivery(ctx context.Context, rule *Rule, e redmine.Event) (*delivery, error) {
+	d := &delivery{
+		id:    rand.Text(),
+		rule:  rule,
+		event: e.Resource + "." + string(e.Type),
+	}
+
+	var body any
+	switch rule.Format {
+	case FormatSlack:
+		body = slackMessage(ctx, r.opts.Resolver, e, link(r.opts.RedmineURL, e))
+	default:
+		body = Payload{ID: d.id, Rule: rule.Name, URL: link(r.opts.RedmineURL, e), Event: e}
+	}
+
+	var err error
+	d.body, err = json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	return d, nil
+}
+
+// attempt posts d, then queues it for a retry or dead-letters it if it failed.
+func (r *Relay) attempt(ctx context.Context, d *delivery) {
+	d.attempts++
+	err := r.post(ctx, d)
+	attrs := []slog.Attr{
+		slog.String("rule", d.rule.Name),
+		slog.String("event", d.event),
+		slog.String("delivery", d.id),
+		slog.Int("attempt", d.attempts),
+	}
+	if err == nil {
+		r.opts.Logger.LogAttrs(ctx, slog.LevelInfo, "webhook delivered", attrs...)
+		return
+	}
+
+	d.err = err
+	attrs = append(attrs, slog.String("error", err.Error()))
+	var de *deliveryError
+	temporary := !errors.As(err, &de) || de.temporary
+	if !temporary || d.attempts >= r.opts.MaxAttempts {
+		r.opts.Logger.LogAttrs(ctx, slog.LevelError, "webhook failed", attrs...)
+		r.deadLetter(d, err)
+		return
+	}
+
+	r.opts.Logger.LogAttrs(ctx, slog.LevelWarn, "webhook failed, retrying", attrs...)
+	d.next = time.Now().Add(r.opts.Backoff << (d.attempts - 1))
+	i, _ := slices.BinarySearchFunc(r.queue, d, func(a, b *delivery) int { return a.next.Compare(b.next) })
+	r.queue = slices.Insert(r.queue, i, d)
+}
+
+// post sends d once. Network errors, timeouts and 408, 429 and 5xx responses
+// are temporary.
+func (r *Relay) post(ctx context.Context, d *delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.rule.URL, bytes.NewReader(d.body))
+	if err != nil {
+		return &deliveryError{err: err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "redmine-hooks")
+	req.Header.Set(HeaderEvent, d.event)
+	req.Header.Set(HeaderDelivery, d.id)
+	if d.rule.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(d.rule.Secret, d.body))
+	}
+
+	resp, err := r.opts.HTTPClient.Do(req)
+	if err != nil {
+		// The error would otherwise carry the full URL into logs and dead letters
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			urlErr.URL = d.rule.endpoint()
+		}
+		return &deliveryError{err: err, temporary: true}
+	}
+	//nolint:errcheck
+	defer resp.Body.Close()
+	//nolint:errcheck
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	return &deliveryError{
+		err: fmt.Errorf("unexpected status: %s", resp.Status),
+		temporary: resp.StatusCode == http.StatusRequestTimeout ||
+			resp.StatusCode == http.StatusTooManyRequests ||
+			resp.StatusCode >= 500,
+	}
+}
+
+func (r *Relay) deadLetter(d *delivery, err error) {
+	if r.opts.DeadLetter == nil {
+		return
+	}
+	line, _ := json.Marshal(DeadLetter{
+		ID:       d.id,
+		Rule:     d.rule.Name,
+		URL:      d.rule.endpoint(),
+		Event:    d.event,
+		Attempts: d.attempts,
+		Error:    err.Error(),
+		FailedAt: time.Now(),
+		Body:     d.body,
+	})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := r.opts.DeadLetter.Write(append(line, '\n')); err != nil {
+		r.opts.Logger.Error("webhook dead letter failed", slog.String("delivery", d.id), slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,379 @@
+package webhook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// receiver is a webhook endpoint recording the requests it receives. It
+// answers with the statuses in order, then with 200 OK.
+type receiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newReceiver(statuses ...int) *receiver {
+	rv := &receiver{statuses: statuses}
+	rv.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		rv.mu.Lock()
+		defer rv.mu.Unlock()
+		rv.requests = append(rv.requests, r)
+		rv.bodies = append(rv.bodies, body)
+		if len(rv.statuses) > 0 {
+			w.WriteHeader(rv.statuses[0])
+			rv.statuses = rv.statuses[1:]
+		}
+	}))
+	return rv
+}
+
+func (rv *receiver) received() ([]*http.Request, [][]byte) {
+	rv.mu.Lock()
+	defer rv.mu.Unlock()
+	return rv.requests, rv.bodies
+}
+
+func deadLetters(t *testing.T, buf *bytes.Buffer) []DeadLetter {
+	t.Helper()
+	var letters []DeadLetter
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		var l DeadLetter
+		if err := json.Unmarshal(scanner.Bytes(), &l); err != nil {
+			t.Fatalf("Invalid dead letter %q: %v", scanner.Text(), err)
+		}
+		letters = append(letters, l)
+	}
+	return letters
+}
+
+func TestRelayEndToEnd(t *testing.T) {
+	var mu sync.Mutex
+	issues := []redmine.Issue{
+		{ID: 1, Project: redmine.Resource{ID: 1, Name: "Web"}, Tracker: redmine.Resource{ID: 1, Name: "Bug"}, Status: redmine.Resource{ID: 2}, Subject: "Login fails"},
+		{ID: 2, Project: redmine.Resource{ID: 2, Name: "API"}, Tracker: redmine.Resource{ID: 2, Name: "Feature"}, Status: redmine.Resource{ID: 1}, Subject: "Add export"},
+	}
+	redmineServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(redmine.IssuesResponse{Issues: issues, TotalCount: len(issues), Limit: 25})
+	}))
+	defer redmineServer.Close()
+
+	generic := newReceiver()
+	defer generic.Close()
+	chat := newReceiver()
+	defer chat.Close()
+	flaky := newReceiver(http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	defer flaky.Close()
+	broken := newReceiver(http.StatusBadRequest)
+	defer broken.Close()
+
+	var dead bytes.Buffer
+	relay, err := New(&Options{
+		Rules: []Rule{
+			{Name: "generic", URL: generic.URL, Secret: "s3cret"},
+			{Name: "chat", URL: chat.URL, Format: FormatSlack, StatusTo: []int{5}},
+			{Name: "flaky", URL: flaky.URL, Resources: []string{redmine.ResourceIssue}},
+			{Name: "broken", URL: broken.URL, ProjectIDs: []int{1}},
+		},
+		Backoff:    10 * time.Millisecond,
+		DeadLetter: &dead,
+		RedmineURL: "https://redmine.example.com",
+	})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	client := redmine.New(redmineServer.URL, "test-api-key")
+	feed := redmine.NewChangeFeed(client, nil, redmine.WatchIssues(nil))
+	ctx := context.Background()
+	if _, err := feed.Poll(ctx); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+
+	mu.Lock()
+	issues[0].Status.ID, issues[0].ClosedOn = 5, redmine.Timestamp{Time: time.Now().UTC()}
+	issues[1].Subject = "Add CSV export"
+	mu.Unlock()
+
+	events, err := feed.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	ch := make(chan redmine.Event, len(events))
+	for _, e := range events {
+		ch <- e
+	}
+	close(ch)
+
+	if err := relay.Run(ctx, ch); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	// Generic JSON payloads are signed
+	requests, bodies := generic.received()
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 generic deliveries, got %d", len(requests))
+	}
+	for i, r := range requests {
+		if !Verify("s3cret", bodies[i], r.Header.Get(HeaderSignature)) {
+			t.Errorf("Expected a valid signature, got %q", r.Header.Get(HeaderSignature))
+		}
+	}
+	if event := requests[0].Header.Get(HeaderEvent); event != "issue.closed" {
+		t.Errorf("Expected issue.closed, got %s", event)
+	}
+	var payload Payload
+	if err := json.Unmarshal(bodies[0], &payload); err != nil {
+		t.Fatalf("Invalid payload: %v", err)
+	}
+	if payload.ID != requests[0].Header.Get(HeaderDelivery) || payload.Rule != "generic" ||
+		payload.URL != "https://redmine.example.com/issues/1" || payload.Event.Issue.ID != 1 {
+		t.Errorf("Unexpected payload: %+v", payload)
+	}
+	if c, ok := payload.Event.Change("status_id"); !ok || c.NewValue != "5" {
+		t.Errorf("Expected status change in payload, got %+v", payload.Event.Changes)
+	}
+
+	// Only the transition to status 5 is sent to chat, unsigned
+	requests, bodies = chat.received()
+	if len(requests) != 1 {
+		t.Fatalf("Expected 1 chat delivery, got %d", len(requests))
+	}
+	if requests[0].Header.Get(HeaderSignature) != "" {
+		t.Error("Expected no signature without a secret")
+	}
+	var msg SlackMessage
+	if err := json.Unmarshal(bodies[0], &msg); err != nil {
+		t.Fatalf("Invalid message: %v", err)
+	}
+	if !strings.HasPrefix(msg.Text, "Issue closed: <https://redmine.example.com/issues/1|[Web] Bug #1: Login fails>") {
+		t.Errorf("Unexpected message: %q", msg.Text)
+	}
+
+	// Temporary failures are retried with the same delivery ID
+	requests, _ = flaky.received()
+	if len(requests) != 4 {
+		t.Fatalf("Expected 4 flaky requests, got %d", len(requests))
+	}
+	ids := map[string]int{}
+	for _, r := range requests {
+		ids[r.Header.Get(HeaderDelivery)]++
+	}
+	if len(ids) != 2 {
+		t.Errorf("Expected 2 deliveries, got %v", ids)
+	}
+
+	// Permanent failures are dead-lettered at once
+	letters := deadLetters(t, &dead)
+	if len(letters) != 1 {
+		t.Fatalf("Expected 1 dead letter, got %d", len(letters))
+	}
+	if l := letters[0]; l.Rule != "broken" || l.Attempts != 1 || l.Event != "issue.closed" || !strings.Contains(l.Error, "400") {
+		t.Errorf("Unexpected dead letter: %+v", l)
+	}
+}
+
+// notifyingStore is a checkpoint store calling saved after each save.
+type notifyingStore struct {
+	*redmine.MemoryCheckpointStore
+	saved func()
+}
+
+func (s *notifyingStore) Save(ctx context.Context, cp *redmine.Checkpoint) error {
+	if err := s.MemoryCheckpointStore.Save(ctx, cp); err != nil {
+		return err
+	}
+	s.saved()
+	return nil
+}
+
+func TestRelayRunFeed(t *testing.T) {
+	tests := []struct {
+		name string
+		// stop stops the relay during the first attempt
+		stop bool
+		// events is the number of events found again after the relay stopped
+		events int
+	}{
+		{name: "delivered"},
+		{name: "stopped", stop: true, events: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			issues := []redmine.Issue{{ID: 1, Status: redmine.Resource{ID: 1}, Subject: "Add export"}}
+			redmineServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(redmine.IssuesResponse{Issues: issues, TotalCount: len(issues), Limit: 25})
+			}))
+			defer redmineServer.Close()
+
+			client := redmine.New(redmineServer.URL, "test-api-key")
+			store := redmine.NewMemoryCheckpointStore()
+			if _, err := redmine.NewChangeFeed(client, &redmine.ChangeFeedOptions{Store: store}, redmine.WatchIssues(nil)).Poll(context.Background()); err != nil {
+				t.Fatalf("Poll failed: %v", err)
+			}
+			mu.Lock()
+			issues[0].Subject = "Add CSV export"
+			mu.Unlock()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var posted atomic.Bool
+			endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				posted.Store(true)
+				if tt.stop {
+					cancel()
+				}
+			}))
+			defer endpoint.Close()
+
+			relay, err := New(&Options{Rules: []Rule{{URL: endpoint.URL}}, Backoff: time.Hour})
+			if err != nil {
+				t.Fatalf("New failed: %v", err)
+			}
+			feed := redmine.NewChangeFeed(client, &redmine.ChangeFeedOptions{
+				Store: &notifyingStore{MemoryCheckpointStore: store, saved: func() {
+					if !posted.Load() {
+						t.Error("Expected the checkpoint to be saved after the delivery")
+					}
+					cancel()
+				}},
+				Interval: time.Millisecond,
+			}, redmine.WatchIssues(nil))
+
+			if err := relay.RunFeed(ctx, feed); !errors.Is(err, context.Canceled) {
+				t.Errorf("Expected context.Canceled, got %v", err)
+			}
+			if !posted.Load() {
+				t.Error("Expected the event to be posted")
+			}
+
+			// An event whose delivery was cut short is found again on the next start
+			events, err := redmine.NewChangeFeed(client, &redmine.ChangeFeedOptions{Store: store}, redmine.WatchIssues(nil)).Poll(context.Background())
+			if err != nil {
+				t.Fatalf("Poll failed: %v", err)
+			}
+			if len(events) != tt.events {
+				t.Errorf("Expected %d events, got %+v", tt.events, events)
+			}
+		})
+	}
+}
+
+func TestRelayMaxAttempts(t *testing.T) {
+	failing := newReceiver(http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway)
+	defer failing.Close()
+
+	var dead bytes.Buffer
+	relay, err := New(&Options{
+		Rules:       []Rule{{URL: failing.URL}},
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+		DeadLetter:  &dead,
+	})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	ch := make(chan redmine.Event, 1)
+	ch <- issueEvent(redmine.EventCreated, map[string]string{"status_id": "1"})
+	close(ch)
+	if err := relay.Run(context.Background(), ch); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	if requests, _ := failing.received(); len(requests) != 3 {
+		t.Errorf("Expected 3 attempts, got %d", len(requests))
+	}
+	letters := deadLetters(t, &dead)
+	if len(letters) != 1 || letters[0].Attempts != 3 || letters[0].Rule != "rule[0]" {
+		t.Fatalf("Unexpected dead letters: %+v", letters)
+	}
+	var payload Payload
+	if err := json.Unmarshal(letters[0].Body, &payload); err != nil || payload.ID != letters[0].ID {
+		t.Errorf("Expected the payload in the dead letter, got %s", letters[0].Body)
+	}
+}
+
+func TestRelayHidesURL(t *testing.T) {
+	// Incoming webhook URLs of Slack and Mattermost carry a token in their path
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	const token = "/services/T000/B000/XXXXXXXX"
+
+	var dead, logs bytes.Buffer
+	relay, err := New(&Options{
+		Rules:       []Rule{{Name: "named", URL: closed.URL, Events: []redmine.EventType{redmine.EventClosed}}, {URL: closed.URL + token}},
+		MaxAttempts: 1,
+		DeadLetter:  &dead,
+		Logger:      slog.New(slog.NewTextHandler(&logs, nil)),
+	})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	relay.Dispatch(context.Background(), issueEvent(redmine.EventCreated, nil))
+
+	if strings.Contains(dead.String(), token) || strings.Contains(logs.String(), token) {
+		t.Errorf("Expected the URL path to be hidden, got dead letters %s and logs %s", dead.String(), logs.String())
+	}
+	letters := deadLetters(t, &dead)
+	if len(letters) != 1 || letters[0].Rule != "rule[1]" || letters[0].URL != closed.URL {
+		t.Fatalf("Unexpected dead letters: %+v", letters)
+	}
+	var payload Payload
+	if err := json.Unmarshal(letters[0].Body, &payload); err != nil || payload.Rule != "rule[1]" {
+		t.Errorf("Expected the rule name in the payload, got %s", letters[0].Body)
+	}
+}
+
+func TestRelayStop(t *testing.T) {
+	failing := newReceiver(http.StatusInternalServerError)
+	defer failing.Close()
+
+	var dead bytes.Buffer
+	relay, err := New(&Options{Rules: []Rule{{URL: failing.URL}}, Backoff: time.Hour, DeadLetter: &dead})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	relay.Dispatch(ctx, issueEvent(redmine.EventCreated, nil))
+	cancel()
+
+	if err := relay.Run(ctx, make(chan redmine.Event)); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	letters := deadLetters(t, &dead)
+	if len(letters) != 1 || !strings.HasPrefix(letters[0].Error, "relay stopped") {
+		t.Errorf("Expected the pending delivery to be dead-lettered, got %+v", letters)
+	}
+}
@@ -0,0 +1,122 @@
+// Package webhook relays the events of a redmine.ChangeFeed to HTTP endpoints.
+// Rules select the events sent to each endpoint, payloads are generic JSON or
+// Slack messages signed with HMAC-SHA256, and failed deliveries are retried
+// with backoff before they are written to a dead-letter log.
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+// Payload formats.
+const (
+	// FormatJSON posts a Payload.
+	FormatJSON = "json"
+	// FormatSlack posts a SlackMessage, accepted by Slack incoming webhooks and
+	// compatible services such as Mattermost.
+	FormatSlack = "slack"
+)
+
+// Rule sends the events it matches to URL. Each filter left empty matches
+// every event; a filter that is set must match, so the filters of a Rule are
+// combined with AND and the values of a filter with OR.
+type Rule struct {
+	// Name identifies the rule in payloads and logs. It defaults to "rule[i]",
+	// i being the index of the rule in Options.Rules, rather than to URL,
+	// which may hold a token, as Slack and Mattermost URLs do.
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url"`
+	// Format is FormatJSON (the default) or FormatSlack.
+	Format string `json:"format,omitempty"`
+	// Secret, if set, signs each payload; see Sign.
+	Secret string `json:"secret,omitempty"`
+
+	// Resources filters by Event.Resource, such as redmine.ResourceIssue.
+	Resources []string `json:"resources,omitempty"`
+	// Events filters by Event.Type.
+	Events []redmine.EventType `json:"events,omitempty"`
+	// ProjectIDs, TrackerIDs and AssignedToIDs filter by the current values of
+	// "project_id", "tracker_id" and "assigned_to_id" in Event.Fields. Events
+	// without the field, such as wiki pages, do not match.
+	ProjectIDs    []int `json:"project_ids,omitempty"`
+	TrackerIDs    []int `json:"tracker_ids,omitempty"`
+	AssignedToIDs []int `json:"assigned_to_ids,omitempty"`
+	// StatusFrom and StatusTo filter by a status transition: an update whose
+	// "status_id" changed from one of StatusFrom to one of StatusTo. A created
+	// issue matches StatusTo alone with its initial status.
+	StatusFrom []int `json:"status_from,omitempty"`
+	StatusTo   []int `json:"status_to,omitempty"`
+}
+
+// Validate checks that the rule can be used.
+func (r *Rule) Validate() error {
+	if r.URL == "" {
+		return errors.New("webhook: rule has no url")
+	}
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return fmt.Errorf("webhook: invalid url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("webhook: unsupported url scheme %q", u.Scheme)
+	}
+	switch r.Format {
+	case "", FormatJSON, FormatSlack:
+	default:
+		return fmt.Errorf("webhook: unknown format %q", r.Format)
+	}
+	return nil
+}
+
+// Match reports whether e passes the filters of the rule.
+func (r *Rule) Match(e redmine.Event) bool {
+	if len(r.Resources) > 0 && !slices.Contains(r.Resources, e.Resource) {
+		return false
+	}
+	if len(r.Events) > 0 && !slices.Contains(r.Events, e.Type) {
+		return false
+	}
+	if !matchID(r.ProjectIDs, e.Fields["project_id"]) ||
+		!matchID(r.TrackerIDs, e.Fields["tracker_id"]) ||
+		!matchID(r.AssignedToIDs, e.Fields["assigned_to_id"]) {
+		return false
+	}
+	if len(r.StatusFrom) == 0 && len(r.StatusTo) == 0 {
+		return true
+	}
+
+	switch e.Type {
+	case redmine.EventCreated:
+		return len(r.StatusFrom) == 0 && matchID(r.StatusTo, e.Fields["status_id"])
+	case redmine.EventUpdated, redmine.EventClosed:
+		c, ok := e.Change("status_id")
+		return ok && matchID(r.StatusFrom, c.OldValue) && matchID(r.StatusTo, c.NewValue)
+	default:
+		return false
+	}
+}
+
+// endpoint returns the scheme and host of URL, which are safe to log unlike
+// its path and query.
+func (r *Rule) endpoint() string {
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return ""
+	}
+	return (&url.URL{Scheme: u.Scheme, Host: u.Host}).String()
+}
+
+// matchID reports whether value is one of ids, or ids is empty.
+func matchID(ids []int, value string) bool {
+	if len(ids) == 0 {
+		return true
+	}
+	id, err := strconv.Atoi(value)
+	return err == nil && slices.Contains(ids, id)
+}
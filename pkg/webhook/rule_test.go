@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/kqns91/redmine-go/pkg/redmine"
+)
+
+func issueEvent(typ redmine.EventType, fields map[string]string, changes ...redmine.Change) redmine.Event {
+	return redmine.Event{Type: typ, Resource: redmine.ResourceIssue, Key: "12", Fields: fields, Changes: changes}
+}
+
+func TestRuleMatch(t *testing.T) {
+	fields := map[string]string{"project_id": "1", "tracker_id": "2", "status_id": "5", "assigned_to_id": "7"}
+	resolved := issueEvent(redmine.EventClosed, fields, redmine.Change{Field: "status_id", OldValue: "2", NewValue: "5"})
+
+	tests := []struct {
+		name  string
+		rule  Rule
+		event redmine.Event
+		want  bool
+	}{
+		{"no filters", Rule{}, resolved, true},
+		{"resource", Rule{Resources: []string{redmine.ResourceWikiPage}}, resolved, false},
+		{"event type", Rule{Events: []redmine.EventType{redmine.EventUpdated, redmine.EventClosed}}, resolved, true},
+		{"project", Rule{ProjectIDs: []int{1, 3}}, resolved, true},
+		{"other project", Rule{ProjectIDs: []int{3}}, resolved, false},
+		{"tracker", Rule{TrackerIDs: []int{2}}, resolved, true},
+		{"assignee", Rule{AssignedToIDs: []int{8}}, resolved, false},
+		{"no assignee", Rule{AssignedToIDs: []int{7}}, issueEvent(redmine.EventUpdated, map[string]string{"assigned_to_id": ""}), false},
+		{"transition", Rule{StatusFrom: []int{2}, StatusTo: []int{5}}, resolved, true},
+		{"transition to", Rule{StatusTo: []int{5, 6}}, resolved, true},
+		{"transition from other", Rule{StatusFrom: []int{1}}, resolved, false},
+		{"status unchanged", Rule{StatusTo: []int{5}}, issueEvent(redmine.EventUpdated, fields, redmine.Change{Field: "subject"}), false},
+		{"created with status", Rule{StatusTo: []int{5}}, issueEvent(redmine.EventCreated, fields), true},
+		{"created without from", Rule{StatusFrom: []int{1}, StatusTo: []int{5}}, issueEvent(redmine.EventCreated, fields), false},
+		{"deleted", Rule{StatusTo: []int{5}}, issueEvent(redmine.EventDeleted, fields), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.Match(tt.event); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRuleValidate(t *testing.T) {
+	valid := Rule{URL: "https://hooks.example.com/redmine", Format: FormatSlack}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Expected valid rule, got %v", err)
+	}
+
+	for _, rule := range []Rule{
+		{},
+		{URL: "ftp://example.com"},
+		{URL: "https://example.com", Format: "xml"},
+	} {
+		if err := rule.Validate(); err == nil {
+			t.Errorf("Expected error for %+v", rule)
+		}
+	}
+}